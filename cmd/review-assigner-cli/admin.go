@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Traunin/review-assigner/pkg/client"
+)
+
+// importTypes maps file extensions to the Content-Type of /admin/import
+var importTypes = map[string]string{
+	".json": "application/json",
+	".yaml": "application/yaml",
+	".yml":  "application/yaml",
+	".csv":  "text/csv",
+}
+
+func adminImport(ctx context.Context, app *app, args []string) error {
+	fs := subcommand("admin import")
+	dryRun := fs.Bool("dry-run", false, "only show the changes")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return errUsage
+	}
+
+	path := fs.Arg(0)
+	contentType, ok := importTypes[strings.ToLower(filepath.Ext(path))]
+	if !ok {
+		return fmt.Errorf("%s: file must be .json, .yaml, .yml or .csv", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	resp, err := app.api.PostAdminImportWithBodyWithResponse(
+		ctx,
+		&client.PostAdminImportParams{DryRun: dryRun},
+		contentType,
+		bytes.NewReader(data),
+	)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		changes := resp.JSON200.Changes
+		if len(changes) == 0 {
+			fmt.Fprintln(w, "nothing to change")
+			return
+		}
+		if resp.JSON200.DryRun {
+			fmt.Fprintf(w, "dry run, %d changes not applied\n\n", len(changes))
+		}
+		fmt.Fprintln(w, "ENTITY\tACTION\tID\tDETAIL")
+		for _, c := range changes {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Entity, c.Action, c.Id, orDash(c.Detail))
+		}
+	})
+}
+
+// adminExport writes the file as the server sends it, -o doesn't apply
+func adminExport(ctx context.Context, app *app, args []string) error {
+	fs := subcommand("admin export")
+	format := fs.String("format", "yaml", "json, yaml or csv")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return errUsage
+	}
+
+	f := client.GetAdminExportParamsFormat(*format)
+	resp, err := app.api.GetAdminExportWithResponse(ctx, &client.GetAdminExportParams{Format: &f})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	_, err = app.out.w.Write(resp.Body)
+	return err
+}
@@ -0,0 +1,176 @@
+// Command review-assigner-cli administers a running review-assigner
+// through its REST API.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/Traunin/review-assigner/pkg/client"
+)
+
+const usage = `usage: review-assigner-cli [flags] command
+
+commands:
+  team create NAME [USER_ID=USERNAME ...]
+                         create a team, members start active
+  team get NAME          show a team and its members
+  team import FILE       create the teams listed in a YAML or JSON file
+  user activate USER_ID
+  user deactivate USER_ID
+  user set-max-reviews USER_ID N|none
+                         cap the open pull requests a user is picked to
+                         review, none removes the cap
+  user away USER_ID RFC3339|none
+                         skip a user in automatic picks until then, none
+                         brings them back
+  pr create --id ID --name NAME --author USER_ID
+                         create a pull request and assign reviewers
+  pr preview --author USER_ID [--seed SEED]
+                         show who would review a pull request by the author
+  pr merge ID            merge a pull request
+  pr reassign ID USER_ID [NEW_USER_ID]
+                         replace a reviewer, with a random team member
+                         unless NEW_USER_ID is given
+  pr add-reviewer ID USER_ID
+                         assign one more reviewer to a pull request
+  pr remove-reviewer ID USER_ID
+                         unassign a reviewer without a replacement
+  pr fill                add reviewers to open pull requests that have
+                         fewer than a new one would get
+  pr list [--status OPEN|MERGED] [--reviewer USER_ID]
+                         list pull requests, newest first
+  stats reviewers [--user USER_ID]
+  stats prs
+  stats sla [--from RFC3339] [--to RFC3339]
+  stats fairness [--from RFC3339] [--to RFC3339] TEAM
+  admin import [--dry-run] FILE
+                         import teams, users and open pull requests from
+                         a .json, .yaml or .csv file
+  admin export [--format json|yaml|csv]
+                         write teams, users and open pull requests to stdout
+
+flags:
+`
+
+// errUsage makes main print the usage and exit with 2
+var errUsage = errors.New("invalid usage")
+
+type command func(ctx context.Context, app *app, args []string) error
+
+var commands = map[string]map[string]command{
+	"team": {
+		"create": teamCreate,
+		"get":    teamGet,
+		"import": teamImport,
+	},
+	"user": {
+		"activate":        userSetActive(true),
+		"deactivate":      userSetActive(false),
+		"set-max-reviews": userSetMaxReviews,
+		"away":            userSetAway,
+	},
+	"pr": {
+		"create":          prCreate,
+		"preview":         prPreview,
+		"merge":           prMerge,
+		"reassign":        prReassign,
+		"add-reviewer":    prAddReviewer,
+		"remove-reviewer": prRemoveReviewer,
+		"fill":            prFill,
+		"list":            prList,
+	},
+	"stats": {
+		"reviewers": statsReviewers,
+		"prs":       statsPullRequests,
+		"sla":       statsSLA,
+		"fairness":  statsFairness,
+	},
+	"admin": {
+		"import": adminImport,
+		"export": adminExport,
+	},
+}
+
+type app struct {
+	api *client.ClientWithResponses
+	out *output
+}
+
+func main() {
+	fs := flag.NewFlagSet("review-assigner-cli", flag.ContinueOnError)
+	fs.Usage = func() { printUsage(fs, os.Stderr) }
+	url := fs.String("url", envOr("REVIEW_ASSIGNER_URL", "http://localhost:8080"), "API base URL, env REVIEW_ASSIGNER_URL")
+	token := fs.String("token", os.Getenv("REVIEW_ASSIGNER_TOKEN"), "API token, env REVIEW_ASSIGNER_TOKEN")
+	org := fs.Int("org", 0, "organization for tokens not bound to one")
+	format := fs.String("o", "table", "output format: table or json")
+	timeout := fs.Duration("timeout", 30*time.Second, "request timeout")
+	retries := fs.Int("retries", 3, "attempts for idempotent requests")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(0)
+		}
+		os.Exit(2)
+	}
+
+	args := fs.Args()
+	if len(args) < 2 {
+		printUsage(fs, os.Stderr)
+		os.Exit(2)
+	}
+	run, ok := commands[args[0]][args[1]]
+	if !ok {
+		printUsage(fs, os.Stderr)
+		os.Exit(2)
+	}
+
+	out, err := newOutput(os.Stdout, *format)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	opts := []client.ClientOption{client.WithRetry(max(*retries, 1), 500*time.Millisecond)}
+	if *token != "" {
+		opts = append(opts, client.WithToken(*token))
+	}
+	if *org != 0 {
+		opts = append(opts, client.WithOrg(*org))
+	}
+	api, err := client.NewClientWithResponses(*url, opts...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	err = run(ctx, &app{api: api, out: out}, args[2:])
+	if errors.Is(err, errUsage) {
+		printUsage(fs, os.Stderr)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func printUsage(fs *flag.FlagSet, w io.Writer) {
+	fmt.Fprint(w, usage)
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Traunin/review-assigner/pkg/client"
+)
+
+// output prints responses either as tables or as the API's JSON
+type output struct {
+	w    io.Writer
+	json bool
+}
+
+func newOutput(w io.Writer, format string) (*output, error) {
+	switch format {
+	case "table":
+		return &output{w: w}, nil
+	case "json":
+		return &output{w: w, json: true}, nil
+	default:
+		return nil, fmt.Errorf("output format must be table or json, got %q", format)
+	}
+}
+
+// print writes body as indented JSON in json mode, otherwise calls table
+// with a tabwriter
+func (o *output) print(body []byte, table func(w io.Writer)) error {
+	if o.json {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, body, "", "  "); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		_, err := buf.WriteTo(o.w)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(o.w, 0, 0, 2, ' ', 0)
+	table(tw)
+	return tw.Flush()
+}
+
+func printTeam(w io.Writer, team client.Team) {
+	fmt.Fprintf(w, "team: %s\n\n", team.TeamName)
+	fmt.Fprintln(w, "USER_ID\tUSERNAME\tACTIVE")
+	for _, m := range team.Members {
+		fmt.Fprintf(w, "%s\t%s\t%t\n", m.UserId, m.Username, m.IsActive)
+	}
+}
+
+func printPullRequests(w io.Writer, prs []client.PullRequest) {
+	fmt.Fprintln(w, "ID\tNAME\tAUTHOR\tSTATUS\tREVIEWERS\tCREATED")
+	for _, pr := range prs {
+		fmt.Fprintf(
+			w,
+			"%s\t%s\t%s\t%s\t%s\t%s\n",
+			pr.PullRequestId,
+			pr.PullRequestName,
+			pr.AuthorId,
+			pr.Status,
+			orDash(strings.Join(pr.AssignedReviewers, ",")),
+			formatTime(pr.CreatedAt),
+		)
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
+
+// formatSeconds prints a latency percentile, "-" without samples
+func formatSeconds(s *float32) string {
+	if s == nil {
+		return "-"
+	}
+	return time.Duration(float64(*s) * float64(time.Second)).Round(time.Second).String()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
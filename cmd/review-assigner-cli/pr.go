@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/Traunin/review-assigner/pkg/client"
+)
+
+func prCreate(ctx context.Context, app *app, args []string) error {
+	fs := subcommand("pr create")
+	id := fs.String("id", "", "pull request id")
+	name := fs.String("name", "", "pull request name")
+	author := fs.String("author", "", "author user_id")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 || *id == "" || *name == "" || *author == "" {
+		return errUsage
+	}
+
+	resp, err := app.api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   *id,
+		PullRequestName: *name,
+		AuthorId:        *author,
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		printPullRequests(w, []client.PullRequest{*resp.JSON201.Pr})
+	})
+}
+
+func prPreview(ctx context.Context, app *app, args []string) error {
+	fs := subcommand("pr preview")
+	author := fs.String("author", "", "author user_id")
+	seed := fs.String("seed", "", "repeat the pick made with this seed")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 || *author == "" {
+		return errUsage
+	}
+
+	body := client.PostPullRequestPreviewAssignmentJSONRequestBody{AuthorId: *author}
+	if *seed != "" {
+		body.Seed = seed
+	}
+	resp, err := app.api.PostPullRequestPreviewAssignmentWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		preview := resp.JSON200
+		fmt.Fprintf(w, "team %s, seed %s\n\n", preview.TeamName, preview.Seed)
+		fmt.Fprintln(w, "USER_ID\tSTATUS")
+		for _, id := range preview.Candidates {
+			status := "candidate"
+			if slices.Contains(preview.Reviewers, id) {
+				status = "picked"
+			}
+			fmt.Fprintf(w, "%s\t%s\n", id, status)
+		}
+		for _, e := range preview.Excluded {
+			fmt.Fprintf(w, "%s\texcluded: %s\n", e.UserId, e.Reason)
+		}
+	})
+}
+
+func prMerge(ctx context.Context, app *app, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	resp, err := app.api.PostPullRequestMergeWithResponse(ctx, client.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: args[0],
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		printPullRequests(w, []client.PullRequest{*resp.JSON200.Pr})
+	})
+}
+
+func prReassign(ctx context.Context, app *app, args []string) error {
+	if len(args) != 2 && len(args) != 3 {
+		return errUsage
+	}
+
+	body := client.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: args[0],
+		OldUserId:     args[1],
+	}
+	if len(args) == 3 {
+		body.NewUserId = &args[2]
+	}
+	resp, err := app.api.PostPullRequestReassignWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		fmt.Fprintf(w, "%s replaced by %s\n\n", args[1], resp.JSON200.ReplacedBy)
+		printPullRequests(w, []client.PullRequest{resp.JSON200.Pr})
+	})
+}
+
+func prAddReviewer(ctx context.Context, app *app, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	resp, err := app.api.PostPullRequestAddReviewerWithResponse(ctx, client.PostPullRequestAddReviewerJSONRequestBody{
+		PullRequestId: args[0],
+		UserId:        args[1],
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		printPullRequests(w, []client.PullRequest{resp.JSON200.Pr})
+	})
+}
+
+func prRemoveReviewer(ctx context.Context, app *app, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	resp, err := app.api.PostPullRequestRemoveReviewerWithResponse(ctx, client.PostPullRequestRemoveReviewerJSONRequestBody{
+		PullRequestId: args[0],
+		UserId:        args[1],
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		printPullRequests(w, []client.PullRequest{resp.JSON200.Pr})
+	})
+}
+
+func prFill(ctx context.Context, app *app, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	resp, err := app.api.PostPullRequestFillReviewersWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		prs := make([]client.PullRequest, len(resp.JSON200.Filled))
+		for i, f := range resp.JSON200.Filled {
+			prs[i] = f.Pr
+		}
+		printPullRequests(w, prs)
+	})
+}
+
+func prList(ctx context.Context, app *app, args []string) error {
+	fs := subcommand("pr list")
+	status := fs.String("status", "", "OPEN or MERGED, all when empty")
+	reviewer := fs.String("reviewer", "", "only pull requests reviewed by this user_id")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return errUsage
+	}
+
+	if *reviewer != "" {
+		return prListForReviewer(ctx, app, *reviewer, *status)
+	}
+
+	params := &client.GetPullRequestListParams{}
+	if *status != "" {
+		s := client.GetPullRequestListParamsStatus(*status)
+		params.Status = &s
+	}
+	resp, err := app.api.GetPullRequestListWithResponse(ctx, params)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		printPullRequests(w, resp.JSON200.PullRequests)
+	})
+}
+
+// prListForReviewer goes through /users/getReview, which only knows short
+// pull requests, so the status is filtered here
+func prListForReviewer(ctx context.Context, app *app, reviewer, status string) error {
+	resp, err := app.api.GetUsersGetReviewWithResponse(ctx, &client.GetUsersGetReviewParams{UserId: reviewer})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	review := resp.JSON200
+	if status != "" {
+		review.PullRequests = slices.DeleteFunc(review.PullRequests, func(pr client.PullRequestShort) bool {
+			return string(pr.Status) != status
+		})
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		return err
+	}
+
+	return app.out.print(body, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tNAME\tAUTHOR\tSTATUS")
+		for _, pr := range review.PullRequests {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", pr.PullRequestId, pr.PullRequestName, pr.AuthorId, pr.Status)
+		}
+	})
+}
+
+// subcommand returns a flag set for command flags, errors are reported
+// through errUsage
+func subcommand(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
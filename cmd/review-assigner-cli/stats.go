@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Traunin/review-assigner/pkg/client"
+)
+
+func statsReviewers(ctx context.Context, app *app, args []string) error {
+	fs := subcommand("stats reviewers")
+	user := fs.String("user", "", "only this user_id")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return errUsage
+	}
+
+	params := &client.GetStatsReviewersParams{}
+	if *user != "" {
+		params.UserId = user
+	}
+	resp, err := app.api.GetStatsReviewersWithResponse(ctx, params)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	// the response is a single user when one is asked for, a list otherwise
+	var users []client.ReviewerStats
+	if *user != "" {
+		var one client.ReviewerStats
+		if err := json.Unmarshal(resp.Body, &one); err != nil {
+			return err
+		}
+		users = append(users, one)
+	} else {
+		var all struct {
+			Users []client.ReviewerStats `json:"users"`
+		}
+		if err := json.Unmarshal(resp.Body, &all); err != nil {
+			return err
+		}
+		users = all.Users
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		fmt.Fprintln(w, "USER_ID\tUSERNAME\tTEAM\tACTIVE\tOPEN\tMERGED\tTOTAL")
+		for _, u := range users {
+			fmt.Fprintf(
+				w,
+				"%s\t%s\t%s\t%t\t%d\t%d\t%d\n",
+				u.UserId,
+				u.Username,
+				orDash(u.TeamName),
+				u.IsActive,
+				u.OpenAssignments,
+				u.MergedAssignments,
+				u.TotalAssignments,
+			)
+		}
+	})
+}
+
+func statsPullRequests(ctx context.Context, app *app, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	resp, err := app.api.GetStatsPullRequestsWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		s := resp.JSON200
+		fmt.Fprintln(w, "TEAM\tOPEN\tMERGED\tTOTAL\tREVIEWERS\tAVG_PER_PR")
+		if s.ByTeam != nil {
+			for _, t := range *s.ByTeam {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%.2f\n", t.TeamName, t.OpenPullRequests, t.MergedPullRequests, t.TotalPullRequests, t.TotalReviewers, t.AvgReviewersPerPr)
+			}
+		}
+		fmt.Fprintf(w, "(all)\t%d\t%d\t%d\t%d\t%.2f\n", s.OpenPullRequests, s.MergedPullRequests, s.TotalPullRequests, s.TotalReviewers, s.AvgReviewersPerPr)
+	})
+}
+
+func statsSLA(ctx context.Context, app *app, args []string) error {
+	fs := subcommand("stats sla")
+	from, to := periodFlags(fs)
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return errUsage
+	}
+
+	resp, err := app.api.GetStatsSlaWithResponse(ctx, &client.GetStatsSlaParams{From: from.t, To: to.t})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		s := resp.JSON200
+		fmt.Fprintln(w, "METRIC\tP50\tP90\tP99\tSAMPLES")
+		for _, m := range []struct {
+			name string
+			p    client.Percentiles
+		}{
+			{"time to first review", s.TimeToFirstReview.Overall},
+			{"assignment to verdict", s.AssignmentToVerdict.Overall},
+			{"time to merge", s.TimeToMerge.Overall},
+		} {
+			fmt.Fprintf(
+				w,
+				"%s\t%s\t%s\t%s\t%d\n",
+				m.name,
+				formatSeconds(m.p.P50Seconds),
+				formatSeconds(m.p.P90Seconds),
+				formatSeconds(m.p.P99Seconds),
+				m.p.Samples,
+			)
+		}
+	})
+}
+
+func statsFairness(ctx context.Context, app *app, args []string) error {
+	fs := subcommand("stats fairness")
+	from, to := periodFlags(fs)
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return errUsage
+	}
+
+	resp, err := app.api.GetStatsFairnessWithResponse(ctx, &client.GetStatsFairnessParams{
+		TeamName: fs.Arg(0),
+		From:     from.t,
+		To:       to.t,
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		s := resp.JSON200
+		fmt.Fprintf(w, "team: %s, assignments: %d, gini: %.3f\n\n", s.TeamName, s.TotalAssignments, s.Gini)
+		fmt.Fprintln(w, "USER_ID\tUSERNAME\tACTIVE\tASSIGNMENTS\tEXPECTED\tDEVIATION")
+		for _, m := range s.Members {
+			fmt.Fprintf(
+				w,
+				"%s\t%s\t%t\t%d\t%.2f\t%+.2f\n",
+				m.UserId,
+				m.Username,
+				m.IsActive,
+				m.Assignments,
+				m.ExpectedAssignments,
+				m.Deviation,
+			)
+		}
+	})
+}
+
+// timeFlag is an optional RFC 3339 flag, nil until set
+type timeFlag struct {
+	t *time.Time
+}
+
+func (f *timeFlag) String() string {
+	if f.t == nil {
+		return ""
+	}
+	return f.t.Format(time.RFC3339)
+}
+
+func (f *timeFlag) Set(raw string) error {
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return err
+	}
+	f.t = &t
+	return nil
+}
+
+func periodFlags(fs *flag.FlagSet) (from, to *timeFlag) {
+	from, to = &timeFlag{}, &timeFlag{}
+	fs.Var(from, "from", "start of the period, RFC 3339")
+	fs.Var(to, "to", "end of the period, RFC 3339")
+	return from, to
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Traunin/review-assigner/pkg/client"
+)
+
+// teamFile is one team in a file read by team import. JSON is valid YAML, so
+// both formats go through the same decoder.
+type teamFile struct {
+	TeamName string `yaml:"team_name"`
+	Members  []struct {
+		UserID   string `yaml:"user_id"`
+		Username string `yaml:"username"`
+		IsActive *bool  `yaml:"is_active"`
+	} `yaml:"members"`
+}
+
+func teamCreate(ctx context.Context, app *app, args []string) error {
+	if len(args) < 1 {
+		return errUsage
+	}
+
+	team := client.Team{TeamName: args[0], Members: []client.TeamMember{}}
+	for _, arg := range args[1:] {
+		id, name, ok := strings.Cut(arg, "=")
+		if !ok || id == "" || name == "" {
+			return fmt.Errorf("member must look like USER_ID=USERNAME, got %q", arg)
+		}
+		team.Members = append(team.Members, client.TeamMember{UserId: id, Username: name, IsActive: true})
+	}
+
+	return addTeam(ctx, app, team)
+}
+
+func teamGet(ctx context.Context, app *app, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	resp, err := app.api.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: args[0]})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		printTeam(w, *resp.JSON200)
+	})
+}
+
+func teamImport(ctx context.Context, app *app, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	var teams []teamFile
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&teams); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", args[0], err)
+	}
+
+	// teams are created one by one, a failure leaves the earlier ones in place
+	for _, t := range teams {
+		team := client.Team{TeamName: t.TeamName, Members: []client.TeamMember{}}
+		for _, m := range t.Members {
+			active := true
+			if m.IsActive != nil {
+				active = *m.IsActive
+			}
+			team.Members = append(team.Members, client.TeamMember{UserId: m.UserID, Username: m.Username, IsActive: active})
+		}
+		if err := addTeam(ctx, app, team); err != nil {
+			return fmt.Errorf("team %q: %w", t.TeamName, err)
+		}
+	}
+
+	return nil
+}
+
+func addTeam(ctx context.Context, app *app, team client.Team) error {
+	resp, err := app.api.PostTeamAddWithResponse(ctx, team)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		printTeam(w, *resp.JSON201.Team)
+	})
+}
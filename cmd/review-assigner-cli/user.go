@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Traunin/review-assigner/pkg/client"
+)
+
+func userSetActive(active bool) command {
+	return func(ctx context.Context, app *app, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+
+		resp, err := app.api.PostUsersSetIsActiveWithResponse(ctx, client.PostUsersSetIsActiveJSONRequestBody{
+			UserId:   args[0],
+			IsActive: active,
+		})
+		if err != nil {
+			return err
+		}
+		if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+			return err
+		}
+
+		return app.out.print(resp.Body, func(w io.Writer) {
+			printUser(w, resp.JSON200.User)
+		})
+	}
+}
+
+func userSetMaxReviews(ctx context.Context, app *app, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	var max *int
+	if args[1] != "none" {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid limit %q, want a number or none", args[1])
+		}
+		max = &n
+	}
+
+	resp, err := app.api.PostUsersSetMaxOpenReviewsWithResponse(ctx, client.PostUsersSetMaxOpenReviewsJSONRequestBody{
+		UserId:         args[0],
+		MaxOpenReviews: max,
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		printUser(w, resp.JSON200.User)
+	})
+}
+
+func userSetAway(ctx context.Context, app *app, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	var until *time.Time
+	if args[1] != "none" {
+		t, err := time.Parse(time.RFC3339, args[1])
+		if err != nil {
+			return fmt.Errorf("invalid time %q, want RFC3339 or none", args[1])
+		}
+		until = &t
+	}
+
+	resp, err := app.api.PostUsersSetOutOfOfficeWithResponse(ctx, client.PostUsersSetOutOfOfficeJSONRequestBody{
+		UserId: args[0],
+		Until:  until,
+	})
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	return app.out.print(resp.Body, func(w io.Writer) {
+		printUser(w, resp.JSON200.User)
+	})
+}
+
+func printUser(w io.Writer, u *client.User) {
+	if u == nil {
+		return
+	}
+	reviews := "-"
+	if u.OpenReviews != nil {
+		reviews = strconv.Itoa(*u.OpenReviews)
+		if u.MaxOpenReviews != nil {
+			reviews += "/" + strconv.Itoa(*u.MaxOpenReviews)
+		}
+	}
+	away := "-"
+	if u.OutOfOfficeUntil != nil {
+		away = u.OutOfOfficeUntil.Format(time.RFC3339)
+	}
+	fmt.Fprintln(w, "USER_ID\tUSERNAME\tTEAM\tACTIVE\tREVIEWS\tAWAY_UNTIL")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t%s\n", u.UserId, u.Username, orDash(u.TeamName), u.IsActive, reviews, away)
+}
@@ -1,96 +1,116 @@
 package main
 
 import (
-	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
+	"os"
 
-	"github.com/Traunin/review-assigner/internal/api/handlers"
-	"github.com/Traunin/review-assigner/internal/application/services"
 	"github.com/Traunin/review-assigner/internal/config"
-	domainservices "github.com/Traunin/review-assigner/internal/domain/services"
-	"github.com/Traunin/review-assigner/internal/infrastructure/db/postgres"
-	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
-	_ "github.com/lib/pq"
+	"github.com/Traunin/review-assigner/internal/logging"
+	"gopkg.in/yaml.v3"
 )
 
-func main() {
-	config := config.Load()
-
-	dbURL := fmt.Sprintf(
-		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
-		config.DBUser(),
-		config.DBPassword(),
-		config.DBHost(),
-		config.DBPort(),
-		config.DBName(),
-	)
-
-	ctx := context.Background()
-	pool, err := pgxpool.New(ctx, dbURL)
-	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
-	}
-	defer pool.Close()
+const usage = `usage: review-assigner [flags] [command]
 
-	db := postgres.NewDB(pool)
-	log.Println("Successfully connected to database")
+commands:
+  serve                  run the HTTP server (default)
+  migrate up             apply all pending migrations
+  migrate down [N]       roll back N migrations (default 1)
+  migrate status         show applied and pending migrations
+  config print           print the effective configuration, secrets masked
+  org create NAME        create an organization
+  org list               list organizations
+  token create --name N --role admin|bot|member [--org ID] [--user ID]
+                         mint an API token, the secret is printed once
+  token revoke ID        revoke an API token
+  token list             list API tokens
 
-	userRepo := postgres.NewUserRepository(db)
-	teamRepo := postgres.NewTeamRepository(db)
-	prRepo := postgres.NewPullRequestRepository(db)
+configuration is layered: defaults < YAML file < environment < flags
 
-	assignmentService := domainservices.NewReviewerAssignmentService(
-		userRepo,
-		prRepo,
-		teamRepo,
-	)
+flags:
+`
 
-	teamService := services.NewTeamService(teamRepo, userRepo)
-	prService := services.NewPullRequestService(prRepo, assignmentService)
-
-	server := handlers.NewServer(
-		teamService,
-		prService,
-		userRepo,
-		teamRepo,
-		prRepo,
-	)
-
-	e := echo.New()
+func main() {
+	cfg, args, err := config.Load(os.Args[1:])
+	if len(args) == 0 {
+		args = []string{"serve"}
+	}
 
-	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
-	e.Use(middleware.CORS())
+	// config print is meant for debugging, so it shows invalid configs too
+	if args[0] == "config" {
+		runConfig(cfg, err, args[1:])
+		return
+	}
 
-	registerRoutes(e, server)
+	if err != nil {
+		printUsageOnHelp(err)
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%v\n", err)
+		os.Exit(2)
+	}
 
-	port := config.Port()
-	log.Printf("Starting server on :%s", port)
-	if err := e.Start(fmt.Sprintf(":%s", port)); err != nil {
-		log.Fatal(err)
+	level, err := logging.ParseLevel(cfg.Log.Level)
+	if err != nil {
+		fatal(slog.Default(), "invalid log level", err)
+	}
+	logger := logging.New(os.Stdout, level)
+	slog.SetDefault(logger)
+
+	switch args[0] {
+	case "serve":
+		runServe(cfg, logger)
+	case "migrate":
+		runMigrate(cfg, logger, args[1:])
+	case "org":
+		runOrg(cfg, logger, args[1:])
+	case "token":
+		runToken(cfg, logger, args[1:])
+	case "help":
+		printUsage(os.Stdout)
+	default:
+		printUsage(os.Stderr)
+		os.Exit(2)
 	}
 }
 
-func registerRoutes(e *echo.Echo, server *handlers.Server) {
-	e.POST("/team/add", server.PostTeamAdd)
-	e.GET("/team/get", server.GetTeamGet)
+func runConfig(cfg *config.Config, loadErr error, args []string) {
+	if cfg == nil {
+		printUsageOnHelp(loadErr)
+		fmt.Fprintln(os.Stderr, loadErr)
+		os.Exit(2)
+	}
+	if len(args) != 1 || args[0] != "print" {
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
 
-	e.POST("/users/setIsActive", server.PostUsersSetIsActive)
-	e.GET("/users/getReview", server.GetUsersGetReview)
+	out, err := yaml.Marshal(cfg.Masked())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Print(string(out))
 
-	e.POST("/pullRequest/create", server.PostPullRequestCreate)
-	e.POST("/pullRequest/merge", server.PostPullRequestMerge)
-	e.POST("/pullRequest/reassign", server.PostPullRequestReassign)
+	if loadErr != nil {
+		fmt.Fprintf(os.Stderr, "\ninvalid configuration:\n%v\n", loadErr)
+		os.Exit(1)
+	}
+}
+
+func printUsage(w *os.File) {
+	fmt.Fprint(w, usage)
+	config.Usage(w)
+}
 
-	// statistics endpoints
-	e.GET("/stats/reviewers", server.GetStatsReviewers)
-	e.GET("/stats/pullRequests", server.GetStatsPullRequests)
+func printUsageOnHelp(err error) {
+	if errors.Is(err, flag.ErrHelp) {
+		printUsage(os.Stdout)
+		os.Exit(0)
+	}
+}
 
-	// healthcheck
-	e.GET("/health", func(c echo.Context) error {
-		return c.JSON(200, map[string]string{"status": "ok"})
-	})
+func fatal(logger *slog.Logger, msg string, err error) {
+	logger.Error(msg, "error", err)
+	os.Exit(1)
 }
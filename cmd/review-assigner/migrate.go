@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/Traunin/review-assigner/internal/config"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/migrator"
+)
+
+func runMigrate(cfg *config.Config, logger *slog.Logger, args []string) {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	m, err := migrator.New(cfg.DB.URL())
+	if err != nil {
+		fatal(logger, "failed to open migrator", err)
+	}
+	defer func() {
+		if err := m.Close(); err != nil {
+			logger.Error("failed to close migrator", "error", err)
+		}
+	}()
+
+	switch args[0] {
+	case "up":
+		if err := m.Up(); err != nil {
+			fatal(logger, "migrate up failed", err)
+		}
+		printStatus(m, logger)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil {
+				fatal(logger, "invalid number of steps", err)
+			}
+		}
+		if err := m.Down(steps); err != nil {
+			fatal(logger, "migrate down failed", err)
+		}
+		printStatus(m, logger)
+	case "status":
+		printStatus(m, logger)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+func printStatus(m *migrator.Migrator, logger *slog.Logger) {
+	status, err := m.Status()
+	if err != nil {
+		fatal(logger, "failed to read migration status", err)
+	}
+
+	fmt.Printf("version: %d", status.Version)
+	if status.Dirty {
+		fmt.Print(" (dirty)")
+	}
+	fmt.Println()
+
+	for _, mig := range status.Migrations {
+		state := "pending"
+		if mig.Applied {
+			state = "applied"
+		}
+		fmt.Printf("  %06d  %s\n", mig.Version, state)
+	}
+}
+
+// migrateUp is used by serve when AUTO_MIGRATE is on
+func migrateUp(dsn string, logger *slog.Logger) error {
+	m, err := migrator.New(dsn)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.Close(); err != nil {
+			logger.Error("failed to close migrator", "error", err)
+		}
+	}()
+
+	if err := m.Up(); err != nil {
+		return err
+	}
+
+	status, err := m.Status()
+	if err != nil {
+		return err
+	}
+	logger.Info("migrations applied", "version", status.Version)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/config"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/postgres"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func runOrg(cfg *config.Config, logger *slog.Logger, args []string) {
+	if len(args) == 0 {
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, cfg.DB.URL())
+	if err != nil {
+		fatal(logger, "failed to connect to database", err)
+	}
+	defer pool.Close()
+
+	orgService := services.NewOrganizationService(
+		postgres.NewOrganizationRepository(postgres.NewDB(pool)),
+	)
+
+	switch args[0] {
+	case "create":
+		if len(args) != 2 {
+			printUsage(os.Stderr)
+			os.Exit(2)
+		}
+		org, err := orgService.CreateOrganization(ctx, args[1])
+		if err != nil {
+			fatal(logger, "failed to create organization", err)
+		}
+		fmt.Printf("organization %q created with id %d\n", org.Name, org.ID)
+	case "list":
+		orgs, err := orgService.ListOrganizations(ctx)
+		if err != nil {
+			fatal(logger, "failed to list organizations", err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tCREATED")
+		for _, org := range orgs {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", org.ID, org.Name, org.CreatedAt.Format(time.RFC3339))
+		}
+		_ = w.Flush()
+	default:
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/api/auth"
+	"github.com/Traunin/review-assigner/internal/api/grpcapi"
+	"github.com/Traunin/review-assigner/internal/api/grpcapi/pb"
+	"github.com/Traunin/review-assigner/internal/api/handlers"
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/config"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	domainservices "github.com/Traunin/review-assigner/internal/domain/services"
+	"github.com/Traunin/review-assigner/internal/events"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/postgres"
+	"github.com/Traunin/review-assigner/internal/infrastructure/metrics"
+	"github.com/Traunin/review-assigner/internal/infrastructure/tracing"
+	"github.com/Traunin/review-assigner/internal/logging"
+	"github.com/Traunin/review-assigner/internal/notify"
+	"github.com/Traunin/review-assigner/internal/ratelimit"
+	"github.com/Traunin/review-assigner/internal/scheduler"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	_ "github.com/lib/pq"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+func runServe(cfg *config.Config, logger *slog.Logger) {
+	dbURL := cfg.DB.URL()
+
+	if cfg.DB.AutoMigrate {
+		if err := migrateUp(dbURL, logger); err != nil {
+			fatal(logger, "auto-migrate failed", err)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(
+		context.Background(),
+		syscall.SIGINT,
+		syscall.SIGTERM,
+	)
+	defer stop()
+
+	shutdownTracing, err := tracing.Setup(
+		ctx,
+		cfg.Tracing.Exporter,
+		cfg.Tracing.OTLPEndpoint,
+	)
+	if err != nil {
+		fatal(logger, "failed to set up tracing", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Error("failed to flush traces", "error", err)
+		}
+	}()
+
+	poolConfig, err := pgxpool.ParseConfig(dbURL)
+	if err != nil {
+		fatal(logger, "invalid database config", err)
+	}
+	poolConfig.ConnConfig.Tracer = tracing.NewQueryTracer()
+	poolConfig.MaxConns = cfg.DB.MaxConns
+	poolConfig.MinConns = cfg.DB.MinConns
+	poolConfig.MaxConnLifetime = cfg.DB.MaxConnLifetime
+	poolConfig.MaxConnIdleTime = cfg.DB.MaxConnIdleTime
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		fatal(logger, "failed to connect to database", err)
+	}
+	defer pool.Close()
+
+	db := postgres.NewDB(pool)
+	logger.Info("connected to database")
+
+	userRepo := postgres.NewUserRepository(db)
+	teamRepo := postgres.NewTeamRepository(db)
+	prRepo := postgres.NewPullRequestRepository(db)
+	tokenRepo := postgres.NewTokenRepository(db)
+	orgRepo := postgres.NewOrganizationRepository(db)
+	reminderRepo := postgres.NewReviewReminderRepository(db)
+	prefRepo := postgres.NewNotificationPreferenceRepository(db)
+
+	notifier, closeNotifier := notifications(cfg.Notify, prefRepo, logger)
+
+	var assignmentService domainservices.ReviewerAssignmentService
+	assignmentService = domainservices.NewReviewerAssignmentService(
+		userRepo,
+		prRepo,
+		teamRepo,
+		cfg.Assignment.ReviewersPerPR,
+		nil,
+	)
+	assignmentService = notify.NotifyAssignment(assignmentService, prRepo, notifier)
+
+	// subscribers always read from the local bus, the postgres broker only
+	// changes how events get there
+	bus := events.NewBus(cfg.Events.Buffer)
+	var publisher events.Publisher = bus
+	if cfg.Events.Broker == "postgres" {
+		broker := postgres.NewEventBroker(db, bus, logger)
+		go broker.Listen(ctx)
+		publisher = broker
+	}
+	assignmentService = events.PublishAssignment(assignmentService, prRepo, teamRepo, publisher)
+
+	var m *metrics.Metrics
+	if cfg.Features.Metrics {
+		m = metrics.New(pool, orgRepo, prRepo)
+		assignmentService = metrics.InstrumentAssignment(
+			assignmentService,
+			m,
+			cfg.Assignment.ReviewersPerPR,
+		)
+	}
+	assignmentService = tracing.TraceAssignment(assignmentService)
+
+	teamService := services.NewTeamService(teamRepo, userRepo, reminderRepo)
+	prService := tracing.TracePullRequests(
+		services.NewPullRequestService(prRepo, assignmentService, cfg.Assignment.ReviewersPerPR),
+	)
+
+	statsService := services.NewStatsService(postgres.NewStatsRepository(db))
+	digestService := services.NewDigestService(userRepo, prRepo, notifier)
+	notificationService := services.NewNotificationService(userRepo, prefRepo)
+	bulkService := services.NewBulkService(teamRepo, userRepo, prRepo, postgres.NewBulkRepository(db))
+
+	server := handlers.NewServer(
+		teamService,
+		prService,
+		statsService,
+		digestService,
+		notificationService,
+		bulkService,
+		bus,
+		userRepo,
+		teamRepo,
+		prRepo,
+		db,
+	)
+
+	e := echo.New()
+	e.HideBanner = true
+	e.Server.ReadTimeout = cfg.Server.ReadTimeout
+	e.Server.WriteTimeout = cfg.Server.WriteTimeout
+	e.Server.IdleTimeout = cfg.Server.IdleTimeout
+	// rate limits key anonymous clients by IP, a spoofed X-Forwarded-For
+	// mustn't give them a fresh bucket
+	e.IPExtractor = ipExtractor(cfg.Server.TrustedProxies)
+	// event streams never finish on their own, end them so Shutdown can
+	e.Server.RegisterOnShutdown(bus.Close)
+
+	e.Use(otelecho.Middleware(tracing.ServiceName))
+	e.Use(logging.RequestID())
+	e.Use(logging.Middleware(logger))
+	e.Use(middleware.Recover())
+	e.Use(middleware.CORS())
+	if m != nil {
+		e.Use(m.Middleware())
+	}
+
+	// the tenant is resolved from the token, so auth has to run first. The
+	// per-IP limit runs before it, the token lookup hits the database
+	var authService services.AuthService
+	var apiMiddleware []echo.MiddlewareFunc
+	var clientLimit echo.MiddlewareFunc
+	if cfg.RateLimit.Enabled {
+		var ipLimit echo.MiddlewareFunc
+		ipLimit, clientLimit = rateLimiters(ctx, cfg.RateLimit, db)
+		apiMiddleware = append(apiMiddleware, ipLimit)
+	}
+	if cfg.Features.Auth {
+		authService = services.NewAuthService(tokenRepo, userRepo, orgRepo)
+		apiMiddleware = append(apiMiddleware, auth.Middleware(authService))
+	} else {
+		logger.Warn("API authentication is disabled")
+	}
+	apiMiddleware = append(apiMiddleware, auth.Tenant(orgRepo))
+	if clientLimit != nil {
+		apiMiddleware = append(apiMiddleware, clientLimit)
+	}
+	if cfg.Assignment.AllowSeed {
+		logger.Warn("requests may fix reviewer picks with X-Assignment-Seed")
+		apiMiddleware = append(apiMiddleware, assignmentSeed())
+	}
+
+	// closed once background jobs have returned, they use the pool until then
+	jobsDone := make(chan struct{})
+	if cfg.Scheduler.Enabled {
+		jobs := scheduler.New(cfg.Scheduler.Interval, db, logger)
+		jobs.Add(scheduler.NewReminders(
+			entities.ReviewSLA{
+				RemindAfter:   cfg.Scheduler.RemindAfter,
+				ReassignAfter: cfg.Scheduler.ReassignAfter,
+			},
+			orgRepo,
+			reminderRepo,
+			prService,
+			scheduler.NewNotifySink(notifier),
+			logger,
+		).Job())
+		jobs.Add(scheduler.NewFillReviewers(orgRepo, prService, logger).Job())
+		if cfg.Digest.Enabled {
+			jobs.Add(scheduler.NewDigests(
+				cfg.Digest.SendAtOffset(),
+				orgRepo,
+				postgres.NewDigestRepository(db),
+				digestService,
+				logger,
+			).Job())
+		}
+		go func() {
+			defer close(jobsDone)
+			jobs.Run(ctx)
+		}()
+	} else {
+		close(jobsDone)
+	}
+
+	handlers.RegisterRoutes(e, server, apiMiddleware)
+	if m != nil {
+		e.GET("/metrics", echo.WrapHandler(m.Handler()))
+	}
+
+	port := cfg.Server.Port
+	serverErr := make(chan error, 2)
+	go func() {
+		logger.Info("starting server", "port", port)
+		serverErr <- e.Start(fmt.Sprintf(":%s", port))
+	}()
+
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		grpcServer = newGRPCServer(
+			logger,
+			authService,
+			orgRepo,
+			grpcapi.NewServer(teamService, prService, statsService, userRepo, teamRepo, prRepo),
+			cfg.GRPC.Reflection,
+		)
+		lis, err := net.Listen("tcp", ":"+cfg.GRPC.Port)
+		if err != nil {
+			fatal(logger, "failed to listen for gRPC", err)
+		}
+		go func() {
+			logger.Info("starting gRPC server", "port", cfg.GRPC.Port)
+			if err := grpcServer.Serve(lis); err != nil {
+				serverErr <- err
+			}
+		}()
+	}
+
+	select {
+	case err := <-serverErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("server stopped", "error", err)
+		}
+	case <-ctx.Done():
+		logger.Info("shutting down", "drain_timeout", cfg.Server.ShutdownTimeout)
+	}
+
+	// in-flight requests finish before the deferred pool.Close runs
+	shutdownCtx, cancel := context.WithTimeout(
+		context.Background(),
+		cfg.Server.ShutdownTimeout,
+	)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		logger.Error("graceful shutdown failed", "error", err)
+	}
+	if grpcServer != nil {
+		stopGRPC(shutdownCtx, grpcServer)
+	}
+
+	stop()
+	select {
+	case <-jobsDone:
+	case <-shutdownCtx.Done():
+		logger.Warn("background jobs did not stop in time")
+	}
+	closeNotifier(shutdownCtx)
+	logger.Info("server stopped")
+}
+
+// notifications routes messages by user preference over per-channel
+// queues, the returned func drains the queues on shutdown
+func notifications(
+	cfg config.NotifyConfig,
+	prefs repositories.NotificationPreferenceRepository,
+	logger *slog.Logger,
+) (notify.Notifier, func(context.Context)) {
+	queueCfg := notify.QueueConfig{
+		Workers:     cfg.Workers,
+		Size:        cfg.QueueSize,
+		MaxAttempts: cfg.MaxAttempts,
+		Backoff:     cfg.RetryBackoff,
+	}
+
+	var queues []*notify.Queue
+	queued := func(name string, next notify.Notifier) notify.Notifier {
+		q := notify.NewQueue(name, next, queueCfg, logger)
+		queues = append(queues, q)
+		return q
+	}
+
+	// users may bring their own chat webhook, so chat works without a default
+	channels := map[entities.NotificationChannel]notify.Notifier{
+		entities.ChannelChat: queued("chat", notify.NewChatNotifier(cfg.ChatWebhookURL)),
+	}
+	if cfg.SMTP.Addr != "" {
+		channels[entities.ChannelEmail] = queued(
+			"email",
+			notify.NewSMTPNotifier(notify.SMTPConfig(cfg.SMTP)),
+		)
+	}
+
+	var fallback notify.Notifier
+	switch cfg.Channel {
+	case "chat":
+		fallback = channels[entities.ChannelChat]
+	case "email":
+		fallback = channels[entities.ChannelEmail]
+	case "webhook":
+		fallback = queued("webhook", notify.NewWebhookNotifier(cfg.WebhookURL))
+	case "file":
+		fallback = queued("file", notify.NewFileNotifier(cfg.File))
+	case "none":
+		fallback = notify.Nop()
+	default:
+		fallback = notify.NewLogNotifier(logger)
+	}
+
+	closeAll := func(ctx context.Context) {
+		for _, q := range queues {
+			if err := q.Close(ctx); err != nil {
+				logger.Warn("pending notifications dropped", "error", err)
+			}
+		}
+	}
+
+	return notify.NewRouter(prefs, channels, fallback), closeAll
+}
+
+// newGRPCServer serves the API with the same auth, tenant and role rules as
+// REST, plus the standard health and, optionally, reflection services
+func newGRPCServer(
+	logger *slog.Logger,
+	authService services.AuthService,
+	orgs repositories.OrganizationRepository,
+	api *grpcapi.Server,
+	reflect bool,
+) *grpc.Server {
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		logging.UnaryServerInterceptor(logger),
+		auth.UnaryInterceptor(authService, orgs, grpcapi.MethodRoles),
+	))
+
+	pb.RegisterReviewAssignerServer(srv, api)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus(pb.ReviewAssigner_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, healthServer)
+
+	if reflect {
+		reflection.Register(srv)
+	}
+
+	return srv
+}
+
+// stopGRPC waits for in-flight calls until ctx is done, then cuts them off
+func stopGRPC(ctx context.Context, srv *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		srv.Stop()
+	}
+}
+
+// ipExtractor believes X-Forwarded-For only when the request came through
+// one of the proxies, the config is validated so the CIDRs parse
+func ipExtractor(proxies []string) echo.IPExtractor {
+	if len(proxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, cidr := range proxies {
+		_, ipNet, _ := net.ParseCIDR(cidr)
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
+// limits are applied after auth so clients are keyed by their token
+// rateLimiters returns the per-IP limit to run before authentication and
+// the per-client one to run after it, sharing one store
+func rateLimiters(
+	ctx context.Context,
+	cfg config.RateLimitConfig,
+	db *postgres.DB,
+) (ip, client echo.MiddlewareFunc) {
+	var store ratelimit.Store
+	switch cfg.Store {
+	case "postgres":
+		pgStore := postgres.NewRateLimitStore(db)
+		go pgStore.Cleanup(ctx, 10*time.Minute)
+		store = pgStore
+	default:
+		store = ratelimit.NewMemoryStore()
+	}
+
+	routes := make(map[string]ratelimit.Limit, len(cfg.Routes))
+	for route, limit := range cfg.Routes {
+		routes[route] = ratelimit.Limit(limit)
+	}
+
+	return ratelimit.IPMiddleware(store, ratelimit.Limit(cfg.PerIP)),
+		ratelimit.Middleware(store, ratelimit.Limit(cfg.Default), routes)
+}
+
+// assignmentSeed passes the X-Assignment-Seed header on to every reviewer
+// pick of the request, a recorded seed replays the pick as long as the
+// candidates are unchanged
+func assignmentSeed() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			raw := c.Request().Header.Get("X-Assignment-Seed")
+			if raw == "" {
+				return next(c)
+			}
+
+			seed, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]any{
+					"error": map[string]string{
+						"code":    "INVALID_REQUEST",
+						"message": "X-Assignment-Seed must be an unsigned 64-bit integer",
+					},
+				})
+			}
+
+			ctx := domainservices.WithSeed(c.Request().Context(), seed)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/config"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/postgres"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func runToken(cfg *config.Config, logger *slog.Logger, args []string) {
+	if len(args) == 0 {
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, cfg.DB.URL())
+	if err != nil {
+		fatal(logger, "failed to connect to database", err)
+	}
+	defer pool.Close()
+
+	db := postgres.NewDB(pool)
+	authService := services.NewAuthService(
+		postgres.NewTokenRepository(db),
+		postgres.NewUserRepository(db),
+		postgres.NewOrganizationRepository(db),
+	)
+
+	switch args[0] {
+	case "create":
+		createToken(ctx, authService, logger, args[1:])
+	case "revoke":
+		if len(args) != 2 {
+			printUsage(os.Stderr)
+			os.Exit(2)
+		}
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			fatal(logger, "invalid token id", err)
+		}
+		if err := authService.RevokeToken(ctx, entities.TokenID(id)); err != nil {
+			fatal(logger, "failed to revoke token", err)
+		}
+		fmt.Printf("token %d revoked\n", id)
+	case "list":
+		listTokens(ctx, authService, logger)
+	default:
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
+}
+
+func createToken(
+	ctx context.Context,
+	authService services.AuthService,
+	logger *slog.Logger,
+	args []string,
+) {
+	fs := flag.NewFlagSet("token create", flag.ExitOnError)
+	name := fs.String("name", "", "what the token is for, e.g. ci-bot")
+	role := fs.String("role", "", "admin, bot or member")
+	user := fs.String("user", "", "user_id the token acts as, required for member")
+	org := fs.Int("org", 0, "organization the token is bound to, instance-wide if unset")
+	_ = fs.Parse(args)
+
+	cmd := dto.CreateTokenCmd{
+		Name: *name,
+		Role: entities.Role(*role),
+	}
+	if *user != "" {
+		userID := entities.UserID(*user)
+		cmd.UserID = &userID
+	}
+	if *org != 0 {
+		orgID := entities.OrgID(*org)
+		cmd.OrgID = &orgID
+	}
+
+	created, err := authService.CreateToken(ctx, cmd)
+	if err != nil {
+		fatal(logger, "failed to create token", err)
+	}
+
+	fmt.Printf("id:     %d\n", created.ID)
+	fmt.Printf("role:   %s\n", created.Role)
+	if created.OrgID != nil {
+		fmt.Printf("org:    %d\n", *created.OrgID)
+	}
+	fmt.Printf("secret: %s\n", created.Secret)
+	fmt.Fprintln(os.Stderr, "store the secret now, it can't be shown again")
+}
+
+func listTokens(
+	ctx context.Context,
+	authService services.AuthService,
+	logger *slog.Logger,
+) {
+	tokens, err := authService.ListTokens(ctx)
+	if err != nil {
+		fatal(logger, "failed to list tokens", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tROLE\tORG\tUSER\tCREATED\tREVOKED")
+	for _, t := range tokens {
+		org, user, revoked := "*", "-", "-"
+		if t.OrgID != nil {
+			org = strconv.Itoa(int(*t.OrgID))
+		}
+		if t.UserID != nil {
+			user = t.UserID.String()
+		}
+		if t.RevokedAt != nil {
+			revoked = t.RevokedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(
+			w,
+			"%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			t.ID,
+			t.Name,
+			t.Role,
+			org,
+			user,
+			t.CreatedAt.Format(time.RFC3339),
+			revoked,
+		)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	ds "github.com/Traunin/review-assigner/internal/domain/services"
+	"github.com/Traunin/review-assigner/internal/logging"
+	"github.com/labstack/echo/v4"
+)
+
+type ctxKey struct{}
+
+// WithToken stores the authenticated token in the context
+func WithToken(ctx context.Context, token *entities.APIToken) context.Context {
+	return context.WithValue(ctx, ctxKey{}, token)
+}
+
+// FromContext returns the authenticated token, nil when auth is disabled
+func FromContext(ctx context.Context) *entities.APIToken {
+	token, _ := ctx.Value(ctxKey{}).(*entities.APIToken)
+	return token
+}
+
+// Middleware resolves the bearer token of every request it wraps and
+// rejects requests without a valid one
+func Middleware(authService services.AuthService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			secret, ok := bearerToken(c.Request().Header.Get(echo.HeaderAuthorization))
+			if !ok {
+				return unauthorized(c, "missing bearer token")
+			}
+
+			ctx := c.Request().Context()
+			token, err := authService.Authenticate(ctx, secret)
+			if errors.Is(err, services.ErrInvalidToken) {
+				return unauthorized(c, err.Error())
+			}
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]any{
+					"error": map[string]string{
+						"code":    "INTERNAL_ERROR",
+						"message": err.Error(),
+					},
+				})
+			}
+
+			logger := logging.FromContext(ctx).With(
+				"token_id", int(token.ID()),
+				"role", token.Role().String(),
+			)
+			ctx = logging.WithLogger(WithToken(ds.WithActor(ctx, token.Actor()), token), logger)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// Require lets through tokens with one of the roles, admins always pass.
+// Without a token in the context, i.e. with auth disabled, it's a no-op.
+func Require(roles ...entities.Role) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token := FromContext(c.Request().Context())
+			if token != nil && !token.HasRole(roles...) {
+				return forbidden(c, "token role "+token.Role().String()+" can't access this endpoint")
+			}
+			return next(c)
+		}
+	}
+}
+
+// RequireSelf restricts member tokens to the user named by the query
+// parameter, other roles pass
+func RequireSelf(param string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token := FromContext(c.Request().Context())
+			if token != nil && !token.ActsAs(entities.UserID(c.QueryParam(param))) {
+				return forbidden(c, "member tokens can only access their own data")
+			}
+			return next(c)
+		}
+	}
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func unauthorized(c echo.Context, message string) error {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="review-assigner"`)
+	return c.JSON(http.StatusUnauthorized, map[string]any{
+		"error": map[string]string{
+			"code":    "UNAUTHORIZED",
+			"message": message,
+		},
+	})
+}
+
+func forbidden(c echo.Context, message string) error {
+	return c.JSON(http.StatusForbidden, map[string]any{
+		"error": map[string]string{
+			"code":    "FORBIDDEN",
+			"message": message,
+		},
+	})
+}
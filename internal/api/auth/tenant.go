@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/logging"
+	"github.com/Traunin/review-assigner/internal/tenant"
+	"github.com/labstack/echo/v4"
+)
+
+const HeaderOrgID = "X-Org-ID"
+
+var ErrForeignOrg = errors.New("token belongs to another organization")
+
+// Tenant scopes the request to an organization. Tokens bound to an org
+// always act in it; instance-wide tokens, and requests with auth disabled,
+// pick one with the X-Org-ID header and fall back to the default org.
+// It must run after Middleware.
+func Tenant(orgs repositories.OrganizationRepository) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			var requested *entities.OrgID
+			if raw := c.Request().Header.Get(HeaderOrgID); raw != "" {
+				id, err := strconv.Atoi(raw)
+				if err != nil || id <= 0 {
+					return c.JSON(http.StatusBadRequest, map[string]any{
+						"error": map[string]string{
+							"code":    "INVALID_REQUEST",
+							"message": HeaderOrgID + " must be a positive integer",
+						},
+					})
+				}
+				orgID := entities.OrgID(id)
+				requested = &orgID
+			}
+
+			orgID, err := ResolveOrg(ctx, orgs, FromContext(ctx), requested)
+			if errors.Is(err, ErrForeignOrg) {
+				return forbidden(c, err.Error())
+			}
+			if errors.Is(err, services.ErrOrgNotFound) {
+				return c.JSON(http.StatusNotFound, map[string]any{
+					"error": map[string]string{
+						"code":    "NOT_FOUND",
+						"message": "organization not found",
+					},
+				})
+			}
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]any{
+					"error": map[string]string{
+						"code":    "INTERNAL_ERROR",
+						"message": err.Error(),
+					},
+				})
+			}
+
+			logger := logging.FromContext(ctx).With("org_id", int(orgID))
+			ctx = logging.WithLogger(tenant.WithOrg(ctx, orgID), logger)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// ResolveOrg picks the organization a call acts in from the token and the
+// organization the caller asked for, if any
+func ResolveOrg(
+	ctx context.Context,
+	orgs repositories.OrganizationRepository,
+	token *entities.APIToken,
+	requested *entities.OrgID,
+) (entities.OrgID, error) {
+	switch {
+	case token != nil && token.OrgID() != nil:
+		if requested != nil && *requested != *token.OrgID() {
+			return 0, ErrForeignOrg
+		}
+		return *token.OrgID(), nil
+	case requested != nil:
+		org, err := orgs.FindByID(ctx, *requested)
+		if err != nil {
+			return 0, err
+		}
+		if org == nil {
+			return 0, services.ErrOrgNotFound
+		}
+		return *requested, nil
+	default:
+		return entities.DefaultOrgID, nil
+	}
+}
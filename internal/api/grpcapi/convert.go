@@ -0,0 +1,90 @@
+package grpcapi
+
+import (
+	"github.com/Traunin/review-assigner/internal/api/grpcapi/pb"
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	statuses = map[entities.PRStatus]pb.PullRequestStatus{
+		entities.StatusOpen:   pb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN,
+		entities.StatusMerged: pb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED,
+	}
+	verdicts = map[entities.Verdict]pb.Verdict{
+		entities.VerdictApproved:         pb.Verdict_VERDICT_APPROVED,
+		entities.VerdictChangesRequested: pb.Verdict_VERDICT_CHANGES_REQUESTED,
+	}
+)
+
+// fromVerdict returns "" for unknown verdicts, which the domain rejects
+func fromVerdict(v pb.Verdict) entities.Verdict {
+	for verdict, value := range verdicts {
+		if value == v {
+			return verdict
+		}
+	}
+	return ""
+}
+
+func toMembers(users []*entities.User) []*pb.TeamMember {
+	members := make([]*pb.TeamMember, len(users))
+	for i, u := range users {
+		members[i] = &pb.TeamMember{
+			UserId:   string(u.ID()),
+			Username: u.Username(),
+			IsActive: u.IsActive(),
+		}
+	}
+	return members
+}
+
+func toPullRequest(pr dto.PullRequestDTO) *pb.PullRequest {
+	out := &pb.PullRequest{
+		PullRequestId:   string(pr.PullRequestID),
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        string(pr.AuthorID),
+		Status:          statuses[entities.PRStatus(pr.Status)],
+		Reviews:         make([]*pb.Review, len(pr.Reviewers)),
+		CreatedAt:       timestamppb.New(pr.CreatedAt),
+	}
+	if pr.MergedAt != nil {
+		out.MergedAt = timestamppb.New(*pr.MergedAt)
+	}
+
+	for i, r := range pr.Reviewers {
+		out.Reviews[i] = &pb.Review{
+			UserId:     string(r.UserID),
+			AssignedAt: timestamppb.New(r.AssignedAt),
+			Verdict:    verdicts[r.Verdict],
+		}
+		if r.VerdictAt != nil {
+			out.Reviews[i].VerdictAt = timestamppb.New(*r.VerdictAt)
+		}
+	}
+
+	return out
+}
+
+func toReviewerStats(r dto.ReviewerStatsDTO) *pb.ReviewerStats {
+	return &pb.ReviewerStats{
+		UserId:            string(r.UserID),
+		Username:          r.Username,
+		TeamName:          r.TeamName,
+		IsActive:          r.IsActive,
+		TotalAssignments:  int64(r.TotalAssignments),
+		OpenAssignments:   int64(r.OpenAssignments),
+		MergedAssignments: int64(r.MergedAssignments),
+	}
+}
+
+func toPullRequestCounts(c dto.PullRequestCountsDTO) *pb.PullRequestCounts {
+	return &pb.PullRequestCounts{
+		TotalPullRequests:  int64(c.TotalPullRequests),
+		OpenPullRequests:   int64(c.OpenPullRequests),
+		MergedPullRequests: int64(c.MergedPullRequests),
+		TotalReviewers:     int64(c.TotalReviewers),
+		AvgReviewersPerPr:  c.AvgReviewersPerPR,
+	}
+}
@@ -0,0 +1,64 @@
+package grpcapi
+
+import (
+	"errors"
+
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	ds "github.com/Traunin/review-assigner/internal/domain/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the same sentinel errors the REST handlers map to HTTP statuses
+var errorCodes = []struct {
+	code codes.Code
+	errs []error
+}{
+	{codes.InvalidArgument, []error{
+		entities.ErrUserNoID,
+		entities.ErrUserNoUsername,
+		entities.ErrTeamNoName,
+		entities.ErrPRNoID,
+		entities.ErrPRNoName,
+		entities.ErrPRNoAuthor,
+		entities.ErrInvalidVerdict,
+		entities.ErrAuthorIsReviewer,
+	}},
+	{codes.NotFound, []error{
+		services.ErrNotFound,
+		services.ErrTeamNotFound,
+		services.ErrUserNotFound,
+		ds.ErrPRNotFound,
+		ds.ErrAuthorNotFound,
+		ds.ErrTeamNotFound,
+		ds.ErrUserNotFound,
+	}},
+	{codes.AlreadyExists, []error{
+		services.ErrTeamExists,
+		ds.ErrPRAlreadyExists,
+		entities.ErrReviewerAssigned,
+	}},
+	{codes.FailedPrecondition, []error{
+		ds.ErrPRAlreadyMerged,
+		ds.ErrUserNotReviewer,
+		ds.ErrNoCandidate,
+		entities.ErrPRMerged,
+		entities.ErrReviewerNotAssigned,
+		entities.ErrPRTooManyReviewers,
+		ds.ErrUserInactive,
+		ds.ErrUserNotInTeam,
+	}},
+}
+
+// toStatus turns a service error into a gRPC status error
+func toStatus(err error) error {
+	for _, c := range errorCodes {
+		for _, target := range c.errs {
+			if errors.Is(err, target) {
+				return status.Error(c.code, err.Error())
+			}
+		}
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,83 @@
+package grpcapi
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	ds "github.com/Traunin/review-assigner/internal/domain/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{entities.ErrUserNoID, codes.InvalidArgument},
+		{entities.ErrTeamNoName, codes.InvalidArgument},
+		{entities.ErrPRNoAuthor, codes.InvalidArgument},
+		{entities.ErrInvalidVerdict, codes.InvalidArgument},
+		{entities.ErrAuthorIsReviewer, codes.InvalidArgument},
+		{services.ErrNotFound, codes.NotFound},
+		{services.ErrTeamNotFound, codes.NotFound},
+		{services.ErrUserNotFound, codes.NotFound},
+		{ds.ErrPRNotFound, codes.NotFound},
+		{ds.ErrAuthorNotFound, codes.NotFound},
+		{ds.ErrTeamNotFound, codes.NotFound},
+		{ds.ErrUserNotFound, codes.NotFound},
+		{services.ErrTeamExists, codes.AlreadyExists},
+		{ds.ErrPRAlreadyExists, codes.AlreadyExists},
+		{entities.ErrReviewerAssigned, codes.AlreadyExists},
+		{ds.ErrPRAlreadyMerged, codes.FailedPrecondition},
+		{ds.ErrUserNotReviewer, codes.FailedPrecondition},
+		{ds.ErrNoCandidate, codes.FailedPrecondition},
+		{ds.ErrAtCapacity, codes.FailedPrecondition},
+		{entities.ErrPRMerged, codes.FailedPrecondition},
+		{entities.ErrReviewerNotAssigned, codes.FailedPrecondition},
+		{entities.ErrPRTooManyReviewers, codes.FailedPrecondition},
+		{ds.ErrUserInactive, codes.FailedPrecondition},
+		{ds.ErrUserNotInTeam, codes.FailedPrecondition},
+		{errors.New("connection reset"), codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			got := status.Convert(toStatus(tt.err))
+			if got.Code() != tt.want {
+				t.Errorf("code = %s, want %s", got.Code(), tt.want)
+			}
+			if got.Message() != tt.err.Error() {
+				t.Errorf("message = %q, want %q", got.Message(), tt.err.Error())
+			}
+		})
+	}
+}
+
+func TestToStatusUnwraps(t *testing.T) {
+	err := fmt.Errorf("reassign pr-1: %w", ds.ErrUserNotReviewer)
+
+	got := status.Convert(toStatus(err))
+	if got.Code() != codes.FailedPrecondition {
+		t.Errorf("code = %s, want %s", got.Code(), codes.FailedPrecondition)
+	}
+	if got.Message() != err.Error() {
+		t.Errorf("message = %q, want the whole chain", got.Message())
+	}
+}
+
+// every sentinel maps to one code, a later group must not shadow an
+// earlier one
+func TestErrorCodesAreDistinct(t *testing.T) {
+	seen := map[error]codes.Code{}
+	for _, c := range errorCodes {
+		for _, err := range c.errs {
+			if prev, ok := seen[err]; ok {
+				t.Errorf("%v maps to both %s and %s", err, prev, c.code)
+			}
+			seen[err] = c.code
+		}
+	}
+}
@@ -0,0 +1,1676 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: reviewassigner/v1/review_assigner.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PullRequestStatus int32
+
+const (
+	PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED PullRequestStatus = 0
+	PullRequestStatus_PULL_REQUEST_STATUS_OPEN        PullRequestStatus = 1
+	PullRequestStatus_PULL_REQUEST_STATUS_MERGED      PullRequestStatus = 2
+)
+
+// Enum value maps for PullRequestStatus.
+var (
+	PullRequestStatus_name = map[int32]string{
+		0: "PULL_REQUEST_STATUS_UNSPECIFIED",
+		1: "PULL_REQUEST_STATUS_OPEN",
+		2: "PULL_REQUEST_STATUS_MERGED",
+	}
+	PullRequestStatus_value = map[string]int32{
+		"PULL_REQUEST_STATUS_UNSPECIFIED": 0,
+		"PULL_REQUEST_STATUS_OPEN":        1,
+		"PULL_REQUEST_STATUS_MERGED":      2,
+	}
+)
+
+func (x PullRequestStatus) Enum() *PullRequestStatus {
+	p := new(PullRequestStatus)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewassigner_v1_review_assigner_proto_enumTypes[0].Descriptor()
+}
+
+func (PullRequestStatus) Type() protoreflect.EnumType {
+	return &file_reviewassigner_v1_review_assigner_proto_enumTypes[0]
+}
+
+func (x PullRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus.Descriptor instead.
+func (PullRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{0}
+}
+
+type Verdict int32
+
+const (
+	Verdict_VERDICT_UNSPECIFIED       Verdict = 0
+	Verdict_VERDICT_APPROVED          Verdict = 1
+	Verdict_VERDICT_CHANGES_REQUESTED Verdict = 2
+)
+
+// Enum value maps for Verdict.
+var (
+	Verdict_name = map[int32]string{
+		0: "VERDICT_UNSPECIFIED",
+		1: "VERDICT_APPROVED",
+		2: "VERDICT_CHANGES_REQUESTED",
+	}
+	Verdict_value = map[string]int32{
+		"VERDICT_UNSPECIFIED":       0,
+		"VERDICT_APPROVED":          1,
+		"VERDICT_CHANGES_REQUESTED": 2,
+	}
+)
+
+func (x Verdict) Enum() *Verdict {
+	p := new(Verdict)
+	*p = x
+	return p
+}
+
+func (x Verdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Verdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewassigner_v1_review_assigner_proto_enumTypes[1].Descriptor()
+}
+
+func (Verdict) Type() protoreflect.EnumType {
+	return &file_reviewassigner_v1_review_assigner_proto_enumTypes[1]
+}
+
+func (x Verdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Verdict.Descriptor instead.
+func (Verdict) EnumDescriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{1}
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type User struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// empty when the user is in no team
+	TeamName      string `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type Review struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AssignedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	// unspecified while the review is pending
+	Verdict       Verdict                `protobuf:"varint,3,opt,name=verdict,proto3,enum=reviewassigner.v1.Verdict" json:"verdict,omitempty"`
+	VerdictAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=verdict_at,json=verdictAt,proto3" json:"verdict_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Review) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Review) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *Review) GetVerdict() Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return Verdict_VERDICT_UNSPECIFIED
+}
+
+func (x *Review) GetVerdictAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerdictAt
+	}
+	return nil
+}
+
+type PullRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=reviewassigner.v1.PullRequestStatus" json:"status,omitempty"`
+	Reviews         []*Review              `protobuf:"bytes,5,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// unset while the pull request is open
+	MergedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *PullRequest) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=reviewassigner.v1.PullRequestStatus" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AddTeamRequest) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type SetUserActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserActiveRequest) Reset() {
+	*x = SetUserActiveRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserActiveRequest) ProtoMessage() {}
+
+func (x *SetUserActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserActiveRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetUserActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type GetReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewRequest) Reset() {
+	*x = GetReviewRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRequest) ProtoMessage() {}
+
+func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewResponse) Reset() {
+	*x = GetReviewResponse{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewResponse) ProtoMessage() {}
+
+func (x *GetReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetReviewResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReviewResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReassignReviewerResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type RecordVerdictRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Verdict       Verdict                `protobuf:"varint,3,opt,name=verdict,proto3,enum=reviewassigner.v1.Verdict" json:"verdict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordVerdictRequest) Reset() {
+	*x = RecordVerdictRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordVerdictRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordVerdictRequest) ProtoMessage() {}
+
+func (x *RecordVerdictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordVerdictRequest.ProtoReflect.Descriptor instead.
+func (*RecordVerdictRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RecordVerdictRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *RecordVerdictRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordVerdictRequest) GetVerdict() Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return Verdict_VERDICT_UNSPECIFIED
+}
+
+type GetReviewerStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// all users of the organization when empty
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerStatsRequest) Reset() {
+	*x = GetReviewerStatsRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerStatsRequest) ProtoMessage() {}
+
+func (x *GetReviewerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetReviewerStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReviewerStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username          string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName          string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive          bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	TotalAssignments  int64                  `protobuf:"varint,5,opt,name=total_assignments,json=totalAssignments,proto3" json:"total_assignments,omitempty"`
+	OpenAssignments   int64                  `protobuf:"varint,6,opt,name=open_assignments,json=openAssignments,proto3" json:"open_assignments,omitempty"`
+	MergedAssignments int64                  `protobuf:"varint,7,opt,name=merged_assignments,json=mergedAssignments,proto3" json:"merged_assignments,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReviewerStats) Reset() {
+	*x = ReviewerStats{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerStats) ProtoMessage() {}
+
+func (x *ReviewerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerStats.ProtoReflect.Descriptor instead.
+func (*ReviewerStats) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReviewerStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerStats) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReviewerStats) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ReviewerStats) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ReviewerStats) GetTotalAssignments() int64 {
+	if x != nil {
+		return x.TotalAssignments
+	}
+	return 0
+}
+
+func (x *ReviewerStats) GetOpenAssignments() int64 {
+	if x != nil {
+		return x.OpenAssignments
+	}
+	return 0
+}
+
+func (x *ReviewerStats) GetMergedAssignments() int64 {
+	if x != nil {
+		return x.MergedAssignments
+	}
+	return 0
+}
+
+type GetReviewerStatsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Reviewers         []*ReviewerStats       `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	TotalPullRequests int64                  `protobuf:"varint,2,opt,name=total_pull_requests,json=totalPullRequests,proto3" json:"total_pull_requests,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetReviewerStatsResponse) Reset() {
+	*x = GetReviewerStatsResponse{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerStatsResponse) ProtoMessage() {}
+
+func (x *GetReviewerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetReviewerStatsResponse) GetReviewers() []*ReviewerStats {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *GetReviewerStatsResponse) GetTotalPullRequests() int64 {
+	if x != nil {
+		return x.TotalPullRequests
+	}
+	return 0
+}
+
+type GetPullRequestStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestStatsRequest) Reset() {
+	*x = GetPullRequestStatsRequest{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestStatsRequest) ProtoMessage() {}
+
+func (x *GetPullRequestStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestStatsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{19}
+}
+
+type PullRequestCounts struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TotalPullRequests  int64                  `protobuf:"varint,1,opt,name=total_pull_requests,json=totalPullRequests,proto3" json:"total_pull_requests,omitempty"`
+	OpenPullRequests   int64                  `protobuf:"varint,2,opt,name=open_pull_requests,json=openPullRequests,proto3" json:"open_pull_requests,omitempty"`
+	MergedPullRequests int64                  `protobuf:"varint,3,opt,name=merged_pull_requests,json=mergedPullRequests,proto3" json:"merged_pull_requests,omitempty"`
+	TotalReviewers     int64                  `protobuf:"varint,4,opt,name=total_reviewers,json=totalReviewers,proto3" json:"total_reviewers,omitempty"`
+	AvgReviewersPerPr  float64                `protobuf:"fixed64,5,opt,name=avg_reviewers_per_pr,json=avgReviewersPerPr,proto3" json:"avg_reviewers_per_pr,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PullRequestCounts) Reset() {
+	*x = PullRequestCounts{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestCounts) ProtoMessage() {}
+
+func (x *PullRequestCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestCounts.ProtoReflect.Descriptor instead.
+func (*PullRequestCounts) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PullRequestCounts) GetTotalPullRequests() int64 {
+	if x != nil {
+		return x.TotalPullRequests
+	}
+	return 0
+}
+
+func (x *PullRequestCounts) GetOpenPullRequests() int64 {
+	if x != nil {
+		return x.OpenPullRequests
+	}
+	return 0
+}
+
+func (x *PullRequestCounts) GetMergedPullRequests() int64 {
+	if x != nil {
+		return x.MergedPullRequests
+	}
+	return 0
+}
+
+func (x *PullRequestCounts) GetTotalReviewers() int64 {
+	if x != nil {
+		return x.TotalReviewers
+	}
+	return 0
+}
+
+func (x *PullRequestCounts) GetAvgReviewersPerPr() float64 {
+	if x != nil {
+		return x.AvgReviewersPerPr
+	}
+	return 0
+}
+
+type TeamPullRequestCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Counts        *PullRequestCounts     `protobuf:"bytes,2,opt,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamPullRequestCounts) Reset() {
+	*x = TeamPullRequestCounts{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamPullRequestCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamPullRequestCounts) ProtoMessage() {}
+
+func (x *TeamPullRequestCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamPullRequestCounts.ProtoReflect.Descriptor instead.
+func (*TeamPullRequestCounts) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TeamPullRequestCounts) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamPullRequestCounts) GetCounts() *PullRequestCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type GetPullRequestStatsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Overall       *PullRequestCounts       `protobuf:"bytes,1,opt,name=overall,proto3" json:"overall,omitempty"`
+	ByTeam        []*TeamPullRequestCounts `protobuf:"bytes,2,rep,name=by_team,json=byTeam,proto3" json:"by_team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestStatsResponse) Reset() {
+	*x = GetPullRequestStatsResponse{}
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestStatsResponse) ProtoMessage() {}
+
+func (x *GetPullRequestStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewassigner_v1_review_assigner_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestStatsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewassigner_v1_review_assigner_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPullRequestStatsResponse) GetOverall() *PullRequestCounts {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *GetPullRequestStatsResponse) GetByTeam() []*TeamPullRequestCounts {
+	if x != nil {
+		return x.ByTeam
+	}
+	return nil
+}
+
+var File_reviewassigner_v1_review_assigner_proto protoreflect.FileDescriptor
+
+const file_reviewassigner_v1_review_assigner_proto_rawDesc = "" +
+	"\n" +
+	"'reviewassigner/v1/review_assigner.proto\x12\x11reviewassigner.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"^\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\"\\\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x127\n" +
+	"\amembers\x18\x02 \x03(\v2\x1d.reviewassigner.v1.TeamMemberR\amembers\"u\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\"\xcf\x01\n" +
+	"\x06Review\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\vassigned_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x124\n" +
+	"\averdict\x18\x03 \x01(\x0e2\x1a.reviewassigner.v1.VerdictR\averdict\x129\n" +
+	"\n" +
+	"verdict_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tverdictAt\"\xe5\x02\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12<\n" +
+	"\x06status\x18\x04 \x01(\x0e2$.reviewassigner.v1.PullRequestStatusR\x06status\x123\n" +
+	"\areviews\x18\x05 \x03(\v2\x19.reviewassigner.v1.ReviewR\areviews\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\xc1\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12<\n" +
+	"\x06status\x18\x04 \x01(\x0e2$.reviewassigner.v1.PullRequestStatusR\x06status\"f\n" +
+	"\x0eAddTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x127\n" +
+	"\amembers\x18\x02 \x03(\v2\x1d.reviewassigner.v1.TeamMemberR\amembers\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"L\n" +
+	"\x14SetUserActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"+\n" +
+	"\x10GetReviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"v\n" +
+	"\x11GetReviewResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12H\n" +
+	"\rpull_requests\x18\x02 \x03(\v2#.reviewassigner.v1.PullRequestShortR\fpullRequests\"\x8b\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"a\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"~\n" +
+	"\x18ReassignReviewerResponse\x12A\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1e.reviewassigner.v1.PullRequestR\vpullRequest\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"\x8d\x01\n" +
+	"\x14RecordVerdictRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x124\n" +
+	"\averdict\x18\x03 \x01(\x0e2\x1a.reviewassigner.v1.VerdictR\averdict\"2\n" +
+	"\x17GetReviewerStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x85\x02\n" +
+	"\rReviewerStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12+\n" +
+	"\x11total_assignments\x18\x05 \x01(\x03R\x10totalAssignments\x12)\n" +
+	"\x10open_assignments\x18\x06 \x01(\x03R\x0fopenAssignments\x12-\n" +
+	"\x12merged_assignments\x18\a \x01(\x03R\x11mergedAssignments\"\x8a\x01\n" +
+	"\x18GetReviewerStatsResponse\x12>\n" +
+	"\treviewers\x18\x01 \x03(\v2 .reviewassigner.v1.ReviewerStatsR\treviewers\x12.\n" +
+	"\x13total_pull_requests\x18\x02 \x01(\x03R\x11totalPullRequests\"\x1c\n" +
+	"\x1aGetPullRequestStatsRequest\"\xfd\x01\n" +
+	"\x11PullRequestCounts\x12.\n" +
+	"\x13total_pull_requests\x18\x01 \x01(\x03R\x11totalPullRequests\x12,\n" +
+	"\x12open_pull_requests\x18\x02 \x01(\x03R\x10openPullRequests\x120\n" +
+	"\x14merged_pull_requests\x18\x03 \x01(\x03R\x12mergedPullRequests\x12'\n" +
+	"\x0ftotal_reviewers\x18\x04 \x01(\x03R\x0etotalReviewers\x12/\n" +
+	"\x14avg_reviewers_per_pr\x18\x05 \x01(\x01R\x11avgReviewersPerPr\"r\n" +
+	"\x15TeamPullRequestCounts\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12<\n" +
+	"\x06counts\x18\x02 \x01(\v2$.reviewassigner.v1.PullRequestCountsR\x06counts\"\xa0\x01\n" +
+	"\x1bGetPullRequestStatsResponse\x12>\n" +
+	"\aoverall\x18\x01 \x01(\v2$.reviewassigner.v1.PullRequestCountsR\aoverall\x12A\n" +
+	"\aby_team\x18\x02 \x03(\v2(.reviewassigner.v1.TeamPullRequestCountsR\x06byTeam*v\n" +
+	"\x11PullRequestStatus\x12#\n" +
+	"\x1fPULL_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PULL_REQUEST_STATUS_OPEN\x10\x01\x12\x1e\n" +
+	"\x1aPULL_REQUEST_STATUS_MERGED\x10\x02*W\n" +
+	"\aVerdict\x12\x17\n" +
+	"\x13VERDICT_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10VERDICT_APPROVED\x10\x01\x12\x1d\n" +
+	"\x19VERDICT_CHANGES_REQUESTED\x10\x022\xb5\a\n" +
+	"\x0eReviewAssigner\x12E\n" +
+	"\aAddTeam\x12!.reviewassigner.v1.AddTeamRequest\x1a\x17.reviewassigner.v1.Team\x12E\n" +
+	"\aGetTeam\x12!.reviewassigner.v1.GetTeamRequest\x1a\x17.reviewassigner.v1.Team\x12Q\n" +
+	"\rSetUserActive\x12'.reviewassigner.v1.SetUserActiveRequest\x1a\x17.reviewassigner.v1.User\x12V\n" +
+	"\tGetReview\x12#.reviewassigner.v1.GetReviewRequest\x1a$.reviewassigner.v1.GetReviewResponse\x12`\n" +
+	"\x11CreatePullRequest\x12+.reviewassigner.v1.CreatePullRequestRequest\x1a\x1e.reviewassigner.v1.PullRequest\x12^\n" +
+	"\x10MergePullRequest\x12*.reviewassigner.v1.MergePullRequestRequest\x1a\x1e.reviewassigner.v1.PullRequest\x12k\n" +
+	"\x10ReassignReviewer\x12*.reviewassigner.v1.ReassignReviewerRequest\x1a+.reviewassigner.v1.ReassignReviewerResponse\x12X\n" +
+	"\rRecordVerdict\x12'.reviewassigner.v1.RecordVerdictRequest\x1a\x1e.reviewassigner.v1.PullRequest\x12k\n" +
+	"\x10GetReviewerStats\x12*.reviewassigner.v1.GetReviewerStatsRequest\x1a+.reviewassigner.v1.GetReviewerStatsResponse\x12t\n" +
+	"\x13GetPullRequestStats\x12-.reviewassigner.v1.GetPullRequestStatsRequest\x1a..reviewassigner.v1.GetPullRequestStatsResponseB?Z=github.com/Traunin/review-assigner/internal/api/grpcapi/pb;pbb\x06proto3"
+
+var (
+	file_reviewassigner_v1_review_assigner_proto_rawDescOnce sync.Once
+	file_reviewassigner_v1_review_assigner_proto_rawDescData []byte
+)
+
+func file_reviewassigner_v1_review_assigner_proto_rawDescGZIP() []byte {
+	file_reviewassigner_v1_review_assigner_proto_rawDescOnce.Do(func() {
+		file_reviewassigner_v1_review_assigner_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reviewassigner_v1_review_assigner_proto_rawDesc), len(file_reviewassigner_v1_review_assigner_proto_rawDesc)))
+	})
+	return file_reviewassigner_v1_review_assigner_proto_rawDescData
+}
+
+var file_reviewassigner_v1_review_assigner_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_reviewassigner_v1_review_assigner_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_reviewassigner_v1_review_assigner_proto_goTypes = []any{
+	(PullRequestStatus)(0),              // 0: reviewassigner.v1.PullRequestStatus
+	(Verdict)(0),                        // 1: reviewassigner.v1.Verdict
+	(*TeamMember)(nil),                  // 2: reviewassigner.v1.TeamMember
+	(*Team)(nil),                        // 3: reviewassigner.v1.Team
+	(*User)(nil),                        // 4: reviewassigner.v1.User
+	(*Review)(nil),                      // 5: reviewassigner.v1.Review
+	(*PullRequest)(nil),                 // 6: reviewassigner.v1.PullRequest
+	(*PullRequestShort)(nil),            // 7: reviewassigner.v1.PullRequestShort
+	(*AddTeamRequest)(nil),              // 8: reviewassigner.v1.AddTeamRequest
+	(*GetTeamRequest)(nil),              // 9: reviewassigner.v1.GetTeamRequest
+	(*SetUserActiveRequest)(nil),        // 10: reviewassigner.v1.SetUserActiveRequest
+	(*GetReviewRequest)(nil),            // 11: reviewassigner.v1.GetReviewRequest
+	(*GetReviewResponse)(nil),           // 12: reviewassigner.v1.GetReviewResponse
+	(*CreatePullRequestRequest)(nil),    // 13: reviewassigner.v1.CreatePullRequestRequest
+	(*MergePullRequestRequest)(nil),     // 14: reviewassigner.v1.MergePullRequestRequest
+	(*ReassignReviewerRequest)(nil),     // 15: reviewassigner.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),    // 16: reviewassigner.v1.ReassignReviewerResponse
+	(*RecordVerdictRequest)(nil),        // 17: reviewassigner.v1.RecordVerdictRequest
+	(*GetReviewerStatsRequest)(nil),     // 18: reviewassigner.v1.GetReviewerStatsRequest
+	(*ReviewerStats)(nil),               // 19: reviewassigner.v1.ReviewerStats
+	(*GetReviewerStatsResponse)(nil),    // 20: reviewassigner.v1.GetReviewerStatsResponse
+	(*GetPullRequestStatsRequest)(nil),  // 21: reviewassigner.v1.GetPullRequestStatsRequest
+	(*PullRequestCounts)(nil),           // 22: reviewassigner.v1.PullRequestCounts
+	(*TeamPullRequestCounts)(nil),       // 23: reviewassigner.v1.TeamPullRequestCounts
+	(*GetPullRequestStatsResponse)(nil), // 24: reviewassigner.v1.GetPullRequestStatsResponse
+	(*timestamppb.Timestamp)(nil),       // 25: google.protobuf.Timestamp
+}
+var file_reviewassigner_v1_review_assigner_proto_depIdxs = []int32{
+	2,  // 0: reviewassigner.v1.Team.members:type_name -> reviewassigner.v1.TeamMember
+	25, // 1: reviewassigner.v1.Review.assigned_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: reviewassigner.v1.Review.verdict:type_name -> reviewassigner.v1.Verdict
+	25, // 3: reviewassigner.v1.Review.verdict_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: reviewassigner.v1.PullRequest.status:type_name -> reviewassigner.v1.PullRequestStatus
+	5,  // 5: reviewassigner.v1.PullRequest.reviews:type_name -> reviewassigner.v1.Review
+	25, // 6: reviewassigner.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	25, // 7: reviewassigner.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: reviewassigner.v1.PullRequestShort.status:type_name -> reviewassigner.v1.PullRequestStatus
+	2,  // 9: reviewassigner.v1.AddTeamRequest.members:type_name -> reviewassigner.v1.TeamMember
+	7,  // 10: reviewassigner.v1.GetReviewResponse.pull_requests:type_name -> reviewassigner.v1.PullRequestShort
+	6,  // 11: reviewassigner.v1.ReassignReviewerResponse.pull_request:type_name -> reviewassigner.v1.PullRequest
+	1,  // 12: reviewassigner.v1.RecordVerdictRequest.verdict:type_name -> reviewassigner.v1.Verdict
+	19, // 13: reviewassigner.v1.GetReviewerStatsResponse.reviewers:type_name -> reviewassigner.v1.ReviewerStats
+	22, // 14: reviewassigner.v1.TeamPullRequestCounts.counts:type_name -> reviewassigner.v1.PullRequestCounts
+	22, // 15: reviewassigner.v1.GetPullRequestStatsResponse.overall:type_name -> reviewassigner.v1.PullRequestCounts
+	23, // 16: reviewassigner.v1.GetPullRequestStatsResponse.by_team:type_name -> reviewassigner.v1.TeamPullRequestCounts
+	8,  // 17: reviewassigner.v1.ReviewAssigner.AddTeam:input_type -> reviewassigner.v1.AddTeamRequest
+	9,  // 18: reviewassigner.v1.ReviewAssigner.GetTeam:input_type -> reviewassigner.v1.GetTeamRequest
+	10, // 19: reviewassigner.v1.ReviewAssigner.SetUserActive:input_type -> reviewassigner.v1.SetUserActiveRequest
+	11, // 20: reviewassigner.v1.ReviewAssigner.GetReview:input_type -> reviewassigner.v1.GetReviewRequest
+	13, // 21: reviewassigner.v1.ReviewAssigner.CreatePullRequest:input_type -> reviewassigner.v1.CreatePullRequestRequest
+	14, // 22: reviewassigner.v1.ReviewAssigner.MergePullRequest:input_type -> reviewassigner.v1.MergePullRequestRequest
+	15, // 23: reviewassigner.v1.ReviewAssigner.ReassignReviewer:input_type -> reviewassigner.v1.ReassignReviewerRequest
+	17, // 24: reviewassigner.v1.ReviewAssigner.RecordVerdict:input_type -> reviewassigner.v1.RecordVerdictRequest
+	18, // 25: reviewassigner.v1.ReviewAssigner.GetReviewerStats:input_type -> reviewassigner.v1.GetReviewerStatsRequest
+	21, // 26: reviewassigner.v1.ReviewAssigner.GetPullRequestStats:input_type -> reviewassigner.v1.GetPullRequestStatsRequest
+	3,  // 27: reviewassigner.v1.ReviewAssigner.AddTeam:output_type -> reviewassigner.v1.Team
+	3,  // 28: reviewassigner.v1.ReviewAssigner.GetTeam:output_type -> reviewassigner.v1.Team
+	4,  // 29: reviewassigner.v1.ReviewAssigner.SetUserActive:output_type -> reviewassigner.v1.User
+	12, // 30: reviewassigner.v1.ReviewAssigner.GetReview:output_type -> reviewassigner.v1.GetReviewResponse
+	6,  // 31: reviewassigner.v1.ReviewAssigner.CreatePullRequest:output_type -> reviewassigner.v1.PullRequest
+	6,  // 32: reviewassigner.v1.ReviewAssigner.MergePullRequest:output_type -> reviewassigner.v1.PullRequest
+	16, // 33: reviewassigner.v1.ReviewAssigner.ReassignReviewer:output_type -> reviewassigner.v1.ReassignReviewerResponse
+	6,  // 34: reviewassigner.v1.ReviewAssigner.RecordVerdict:output_type -> reviewassigner.v1.PullRequest
+	20, // 35: reviewassigner.v1.ReviewAssigner.GetReviewerStats:output_type -> reviewassigner.v1.GetReviewerStatsResponse
+	24, // 36: reviewassigner.v1.ReviewAssigner.GetPullRequestStats:output_type -> reviewassigner.v1.GetPullRequestStatsResponse
+	27, // [27:37] is the sub-list for method output_type
+	17, // [17:27] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_reviewassigner_v1_review_assigner_proto_init() }
+func file_reviewassigner_v1_review_assigner_proto_init() {
+	if File_reviewassigner_v1_review_assigner_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reviewassigner_v1_review_assigner_proto_rawDesc), len(file_reviewassigner_v1_review_assigner_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reviewassigner_v1_review_assigner_proto_goTypes,
+		DependencyIndexes: file_reviewassigner_v1_review_assigner_proto_depIdxs,
+		EnumInfos:         file_reviewassigner_v1_review_assigner_proto_enumTypes,
+		MessageInfos:      file_reviewassigner_v1_review_assigner_proto_msgTypes,
+	}.Build()
+	File_reviewassigner_v1_review_assigner_proto = out.File
+	file_reviewassigner_v1_review_assigner_proto_goTypes = nil
+	file_reviewassigner_v1_review_assigner_proto_depIdxs = nil
+}
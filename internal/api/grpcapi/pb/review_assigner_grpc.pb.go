@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: reviewassigner/v1/review_assigner.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReviewAssigner_AddTeam_FullMethodName             = "/reviewassigner.v1.ReviewAssigner/AddTeam"
+	ReviewAssigner_GetTeam_FullMethodName             = "/reviewassigner.v1.ReviewAssigner/GetTeam"
+	ReviewAssigner_SetUserActive_FullMethodName       = "/reviewassigner.v1.ReviewAssigner/SetUserActive"
+	ReviewAssigner_GetReview_FullMethodName           = "/reviewassigner.v1.ReviewAssigner/GetReview"
+	ReviewAssigner_CreatePullRequest_FullMethodName   = "/reviewassigner.v1.ReviewAssigner/CreatePullRequest"
+	ReviewAssigner_MergePullRequest_FullMethodName    = "/reviewassigner.v1.ReviewAssigner/MergePullRequest"
+	ReviewAssigner_ReassignReviewer_FullMethodName    = "/reviewassigner.v1.ReviewAssigner/ReassignReviewer"
+	ReviewAssigner_RecordVerdict_FullMethodName       = "/reviewassigner.v1.ReviewAssigner/RecordVerdict"
+	ReviewAssigner_GetReviewerStats_FullMethodName    = "/reviewassigner.v1.ReviewAssigner/GetReviewerStats"
+	ReviewAssigner_GetPullRequestStats_FullMethodName = "/reviewassigner.v1.ReviewAssigner/GetPullRequestStats"
+)
+
+// ReviewAssignerClient is the client API for ReviewAssigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReviewAssigner mirrors the REST API. Authentication and organizations work
+// the same way: the token goes in the `authorization` metadata as
+// "Bearer <token>", the organization in `x-org-id`.
+type ReviewAssignerClient interface {
+	// admin
+	AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	// admin
+	SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*User, error)
+	// member tokens only for their own user_id
+	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error)
+	// bot
+	CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	// bot, merging a merged pull request returns it unchanged
+	MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	// bot
+	ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error)
+	// bot or member, member tokens only for their own user_id
+	RecordVerdict(ctx context.Context, in *RecordVerdictRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	GetReviewerStats(ctx context.Context, in *GetReviewerStatsRequest, opts ...grpc.CallOption) (*GetReviewerStatsResponse, error)
+	GetPullRequestStats(ctx context.Context, in *GetPullRequestStatsRequest, opts ...grpc.CallOption) (*GetPullRequestStatsResponse, error)
+}
+
+type reviewAssignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewAssignerClient(cc grpc.ClientConnInterface) ReviewAssignerClient {
+	return &reviewAssignerClient{cc}
+}
+
+func (c *reviewAssignerClient) AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, ReviewAssigner_AddTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, ReviewAssigner_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, ReviewAssigner_SetUserActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_GetReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, ReviewAssigner_CreatePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, ReviewAssigner_MergePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignReviewerResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_ReassignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) RecordVerdict(ctx context.Context, in *RecordVerdictRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, ReviewAssigner_RecordVerdict_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) GetReviewerStats(ctx context.Context, in *GetReviewerStatsRequest, opts ...grpc.CallOption) (*GetReviewerStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewerStatsResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_GetReviewerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewAssignerClient) GetPullRequestStats(ctx context.Context, in *GetPullRequestStatsRequest, opts ...grpc.CallOption) (*GetPullRequestStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPullRequestStatsResponse)
+	err := c.cc.Invoke(ctx, ReviewAssigner_GetPullRequestStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewAssignerServer is the server API for ReviewAssigner service.
+// All implementations must embed UnimplementedReviewAssignerServer
+// for forward compatibility.
+//
+// ReviewAssigner mirrors the REST API. Authentication and organizations work
+// the same way: the token goes in the `authorization` metadata as
+// "Bearer <token>", the organization in `x-org-id`.
+type ReviewAssignerServer interface {
+	// admin
+	AddTeam(context.Context, *AddTeamRequest) (*Team, error)
+	GetTeam(context.Context, *GetTeamRequest) (*Team, error)
+	// admin
+	SetUserActive(context.Context, *SetUserActiveRequest) (*User, error)
+	// member tokens only for their own user_id
+	GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error)
+	// bot
+	CreatePullRequest(context.Context, *CreatePullRequestRequest) (*PullRequest, error)
+	// bot, merging a merged pull request returns it unchanged
+	MergePullRequest(context.Context, *MergePullRequestRequest) (*PullRequest, error)
+	// bot
+	ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error)
+	// bot or member, member tokens only for their own user_id
+	RecordVerdict(context.Context, *RecordVerdictRequest) (*PullRequest, error)
+	GetReviewerStats(context.Context, *GetReviewerStatsRequest) (*GetReviewerStatsResponse, error)
+	GetPullRequestStats(context.Context, *GetPullRequestStatsRequest) (*GetPullRequestStatsResponse, error)
+	mustEmbedUnimplementedReviewAssignerServer()
+}
+
+// UnimplementedReviewAssignerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewAssignerServer struct{}
+
+func (UnimplementedReviewAssignerServer) AddTeam(context.Context, *AddTeamRequest) (*Team, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTeam not implemented")
+}
+func (UnimplementedReviewAssignerServer) GetTeam(context.Context, *GetTeamRequest) (*Team, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedReviewAssignerServer) SetUserActive(context.Context, *SetUserActiveRequest) (*User, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserActive not implemented")
+}
+func (UnimplementedReviewAssignerServer) GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReview not implemented")
+}
+func (UnimplementedReviewAssignerServer) CreatePullRequest(context.Context, *CreatePullRequestRequest) (*PullRequest, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePullRequest not implemented")
+}
+func (UnimplementedReviewAssignerServer) MergePullRequest(context.Context, *MergePullRequestRequest) (*PullRequest, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergePullRequest not implemented")
+}
+func (UnimplementedReviewAssignerServer) ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignReviewer not implemented")
+}
+func (UnimplementedReviewAssignerServer) RecordVerdict(context.Context, *RecordVerdictRequest) (*PullRequest, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordVerdict not implemented")
+}
+func (UnimplementedReviewAssignerServer) GetReviewerStats(context.Context, *GetReviewerStatsRequest) (*GetReviewerStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReviewerStats not implemented")
+}
+func (UnimplementedReviewAssignerServer) GetPullRequestStats(context.Context, *GetPullRequestStatsRequest) (*GetPullRequestStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPullRequestStats not implemented")
+}
+func (UnimplementedReviewAssignerServer) mustEmbedUnimplementedReviewAssignerServer() {}
+func (UnimplementedReviewAssignerServer) testEmbeddedByValue()                        {}
+
+// UnsafeReviewAssignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewAssignerServer will
+// result in compilation errors.
+type UnsafeReviewAssignerServer interface {
+	mustEmbedUnimplementedReviewAssignerServer()
+}
+
+func RegisterReviewAssignerServer(s grpc.ServiceRegistrar, srv ReviewAssignerServer) {
+	// If the following call panics, it indicates UnimplementedReviewAssignerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewAssigner_ServiceDesc, srv)
+}
+
+func _ReviewAssigner_AddTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).AddTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_AddTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).AddTeam(ctx, req.(*AddTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_SetUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).SetUserActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_SetUserActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).SetUserActive(ctx, req.(*SetUserActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).GetReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_GetReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).GetReview(ctx, req.(*GetReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_CreatePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).CreatePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_CreatePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).CreatePullRequest(ctx, req.(*CreatePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_MergePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).MergePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_MergePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).MergePullRequest(ctx, req.(*MergePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_ReassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).ReassignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_ReassignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).ReassignReviewer(ctx, req.(*ReassignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_RecordVerdict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordVerdictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).RecordVerdict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_RecordVerdict_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).RecordVerdict(ctx, req.(*RecordVerdictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_GetReviewerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).GetReviewerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_GetReviewerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).GetReviewerStats(ctx, req.(*GetReviewerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewAssigner_GetPullRequestStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPullRequestStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewAssignerServer).GetPullRequestStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewAssigner_GetPullRequestStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewAssignerServer).GetPullRequestStats(ctx, req.(*GetPullRequestStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewAssigner_ServiceDesc is the grpc.ServiceDesc for ReviewAssigner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewAssigner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reviewassigner.v1.ReviewAssigner",
+	HandlerType: (*ReviewAssignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTeam",
+			Handler:    _ReviewAssigner_AddTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _ReviewAssigner_GetTeam_Handler,
+		},
+		{
+			MethodName: "SetUserActive",
+			Handler:    _ReviewAssigner_SetUserActive_Handler,
+		},
+		{
+			MethodName: "GetReview",
+			Handler:    _ReviewAssigner_GetReview_Handler,
+		},
+		{
+			MethodName: "CreatePullRequest",
+			Handler:    _ReviewAssigner_CreatePullRequest_Handler,
+		},
+		{
+			MethodName: "MergePullRequest",
+			Handler:    _ReviewAssigner_MergePullRequest_Handler,
+		},
+		{
+			MethodName: "ReassignReviewer",
+			Handler:    _ReviewAssigner_ReassignReviewer_Handler,
+		},
+		{
+			MethodName: "RecordVerdict",
+			Handler:    _ReviewAssigner_RecordVerdict_Handler,
+		},
+		{
+			MethodName: "GetReviewerStats",
+			Handler:    _ReviewAssigner_GetReviewerStats_Handler,
+		},
+		{
+			MethodName: "GetPullRequestStats",
+			Handler:    _ReviewAssigner_GetPullRequestStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reviewassigner/v1/review_assigner.proto",
+}
@@ -0,0 +1,306 @@
+// Package grpcapi serves the gRPC API defined in
+// proto/reviewassigner/v1. It is a thin layer over the same application
+// services as the REST handlers.
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/api/auth"
+	"github.com/Traunin/review-assigner/internal/api/grpcapi/pb"
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MethodRoles are the roles allowed on each method, as on the REST routes
+var MethodRoles = map[string][]entities.Role{
+	pb.ReviewAssigner_AddTeam_FullMethodName:             {entities.RoleAdmin},
+	pb.ReviewAssigner_SetUserActive_FullMethodName:       {entities.RoleAdmin},
+	pb.ReviewAssigner_CreatePullRequest_FullMethodName:   {entities.RoleBot},
+	pb.ReviewAssigner_MergePullRequest_FullMethodName:    {entities.RoleBot},
+	pb.ReviewAssigner_ReassignReviewer_FullMethodName:    {entities.RoleBot},
+	pb.ReviewAssigner_RecordVerdict_FullMethodName:       {entities.RoleBot, entities.RoleMember},
+	pb.ReviewAssigner_GetPullRequestStats_FullMethodName: {entities.RoleBot},
+}
+
+type Server struct {
+	pb.UnimplementedReviewAssignerServer
+
+	teamService  services.TeamService
+	prService    services.PullRequestService
+	statsService services.StatsService
+	userRepo     repositories.UserRepository
+	teamRepo     repositories.TeamRepository
+	prRepo       repositories.PullRequestRepository
+}
+
+func NewServer(
+	teamService services.TeamService,
+	prService services.PullRequestService,
+	statsService services.StatsService,
+	userRepo repositories.UserRepository,
+	teamRepo repositories.TeamRepository,
+	prRepo repositories.PullRequestRepository,
+) *Server {
+	return &Server{
+		teamService:  teamService,
+		prService:    prService,
+		statsService: statsService,
+		userRepo:     userRepo,
+		teamRepo:     teamRepo,
+		prRepo:       prRepo,
+	}
+}
+
+func (s *Server) AddTeam(ctx context.Context, req *pb.AddTeamRequest) (*pb.Team, error) {
+	cmd := dto.CreateTeamCmd{
+		TeamName: req.GetTeamName(),
+		Members:  make([]dto.TeamMemberCmd, len(req.GetMembers())),
+	}
+	for i, m := range req.GetMembers() {
+		cmd.Members[i] = dto.TeamMemberCmd{
+			UserID:   m.GetUserId(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+		}
+	}
+
+	team, err := s.teamService.CreateTeam(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return s.team(ctx, team)
+}
+
+func (s *Server) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "team_name is required")
+	}
+
+	team, err := s.teamService.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return s.team(ctx, team)
+}
+
+func (s *Server) team(ctx context.Context, team *dto.TeamDTO) (*pb.Team, error) {
+	users, err := s.userRepo.GetByTeamID(ctx, entities.TeamID(team.ID))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.Team{
+		TeamName: team.TeamName,
+		Members:  toMembers(users),
+	}, nil
+}
+
+func (s *Server) SetUserActive(ctx context.Context, req *pb.SetUserActiveRequest) (*pb.User, error) {
+	user, err := s.userRepo.FindByID(ctx, entities.UserID(req.GetUserId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if user == nil {
+		return nil, toStatus(services.ErrUserNotFound)
+	}
+
+	reactivated := req.GetIsActive() && !user.IsActive()
+	user.SetActive(req.GetIsActive())
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, toStatus(err)
+	}
+
+	if reactivated && user.TeamID() != nil {
+		// the returning user may be the candidate their team's under-staffed
+		// PRs wait for
+		if _, err := s.prService.FillTeamReviewers(ctx, *user.TeamID()); err != nil {
+			logging.FromContext(ctx).Warn("failed to fill reviewers",
+				"user_id", user.ID(),
+				"error", err,
+			)
+		}
+	}
+
+	out := &pb.User{
+		UserId:   string(user.ID()),
+		Username: user.Username(),
+		IsActive: user.IsActive(),
+	}
+	if user.TeamID() != nil {
+		team, err := s.teamRepo.FindByID(ctx, *user.TeamID())
+		if err == nil && team != nil {
+			out.TeamName = team.Name()
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.GetReviewResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := requireSelf(ctx, entities.UserID(req.GetUserId())); err != nil {
+		return nil, err
+	}
+
+	prs, err := s.prRepo.FindPullRequestByUserID(ctx, entities.UserID(req.GetUserId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := &pb.GetReviewResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: make([]*pb.PullRequestShort, len(prs)),
+	}
+	for i, pr := range prs {
+		out.PullRequests[i] = &pb.PullRequestShort{
+			PullRequestId:   string(pr.ID()),
+			PullRequestName: pr.Name(),
+			AuthorId:        string(pr.AuthorID()),
+			Status:          statuses[pr.Status()],
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Server) CreatePullRequest(
+	ctx context.Context,
+	req *pb.CreatePullRequestRequest,
+) (*pb.PullRequest, error) {
+	pr, err := s.prService.Create(ctx, dto.CreatePRCmd{
+		PullRequestID:   entities.PullRequestID(req.GetPullRequestId()),
+		PullRequestName: req.GetPullRequestName(),
+		AuthorID:        entities.UserID(req.GetAuthorId()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPullRequest(pr), nil
+}
+
+func (s *Server) MergePullRequest(
+	ctx context.Context,
+	req *pb.MergePullRequestRequest,
+) (*pb.PullRequest, error) {
+	pr, err := s.prService.Merge(ctx, entities.PullRequestID(req.GetPullRequestId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPullRequest(pr), nil
+}
+
+func (s *Server) ReassignReviewer(
+	ctx context.Context,
+	req *pb.ReassignReviewerRequest,
+) (*pb.ReassignReviewerResponse, error) {
+	result, err := s.prService.ReassignReviewer(ctx, dto.ReassignReviewerCmd{
+		OldUserID:     entities.UserID(req.GetOldUserId()),
+		PullRequestID: entities.PullRequestID(req.GetPullRequestId()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.ReassignReviewerResponse{
+		PullRequest: toPullRequest(*result.PullRequestID),
+		ReplacedBy:  string(result.Assigned),
+	}, nil
+}
+
+func (s *Server) RecordVerdict(
+	ctx context.Context,
+	req *pb.RecordVerdictRequest,
+) (*pb.PullRequest, error) {
+	if err := requireSelf(ctx, entities.UserID(req.GetUserId())); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.prService.RecordVerdict(ctx, dto.RecordVerdictCmd{
+		PullRequestID: entities.PullRequestID(req.GetPullRequestId()),
+		UserID:        entities.UserID(req.GetUserId()),
+		Verdict:       fromVerdict(req.GetVerdict()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPullRequest(pr), nil
+}
+
+func (s *Server) GetReviewerStats(
+	ctx context.Context,
+	req *pb.GetReviewerStatsRequest,
+) (*pb.GetReviewerStatsResponse, error) {
+	if err := requireSelf(ctx, entities.UserID(req.GetUserId())); err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" {
+		stats, err := s.statsService.GetReviewerStatsByUserID(ctx, entities.UserID(req.GetUserId()))
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		return &pb.GetReviewerStatsResponse{
+			Reviewers: []*pb.ReviewerStats{toReviewerStats(*stats)},
+		}, nil
+	}
+
+	stats, err := s.statsService.GetReviewerStats(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := &pb.GetReviewerStatsResponse{
+		Reviewers:         make([]*pb.ReviewerStats, len(stats.Reviewers)),
+		TotalPullRequests: int64(stats.TotalPullRequests),
+	}
+	for i, r := range stats.Reviewers {
+		out.Reviewers[i] = toReviewerStats(r)
+	}
+
+	return out, nil
+}
+
+func (s *Server) GetPullRequestStats(
+	ctx context.Context,
+	_ *pb.GetPullRequestStatsRequest,
+) (*pb.GetPullRequestStatsResponse, error) {
+	stats, err := s.statsService.GetPullRequestStats(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := &pb.GetPullRequestStatsResponse{
+		Overall: toPullRequestCounts(stats.PullRequestCountsDTO),
+		ByTeam:  make([]*pb.TeamPullRequestCounts, len(stats.ByTeam)),
+	}
+	for i, t := range stats.ByTeam {
+		out.ByTeam[i] = &pb.TeamPullRequestCounts{
+			TeamName: t.TeamName,
+			Counts:   toPullRequestCounts(t.PullRequestCountsDTO),
+		}
+	}
+
+	return out, nil
+}
+
+// requireSelf restricts member tokens to their own user, like
+// auth.RequireSelf
+func requireSelf(ctx context.Context, userID entities.UserID) error {
+	token := auth.FromContext(ctx)
+	if token != nil && !token.ActsAs(userID) {
+		return status.Error(codes.PermissionDenied, "member tokens can only access their own data")
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/bulk"
+	"github.com/labstack/echo/v4"
+)
+
+// PostAdminImport applies a bulk file in JSON, YAML or CSV, as told by the
+// Content-Type, or only reports the changes with dry_run
+func (s *Server) PostAdminImport(ctx echo.Context) error {
+	dryRun := false
+	if raw := ctx.QueryParam("dry_run"); raw != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(raw); err != nil {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error": map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": "dry_run must be true or false",
+				},
+			})
+		}
+	}
+
+	format, err := bulk.FormatOf(ctx.Request().Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "Content-Type must be application/json, application/yaml or text/csv",
+			},
+		})
+	}
+
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	data, err := bulk.Decode(format, body)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	changes, err := s.bulkService.Import(ctx.Request().Context(), data, dryRun)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidImport) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error": map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": err.Error(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	out := make([]map[string]any, len(changes))
+	for i, c := range changes {
+		out[i] = map[string]any{
+			"entity": c.Entity,
+			"action": c.Action,
+			"id":     c.ID,
+			"detail": c.Detail,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"dry_run": dryRun,
+		"changes": out,
+	})
+}
+
+func (s *Server) GetAdminExport(ctx echo.Context) error {
+	format := bulk.FormatJSON
+	if raw := ctx.QueryParam("format"); raw != "" {
+		var err error
+		if format, err = bulk.ParseFormat(raw); err != nil {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error": map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": err.Error(),
+				},
+			})
+		}
+	}
+
+	data, err := s.bulkService.Export(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	body, err := bulk.Encode(format, data)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	ctx.Response().Header().Set(
+		echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=review-assigner.%s", format),
+	)
+	return ctx.Blob(http.StatusOK, format.ContentType(), body)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/events"
+	"github.com/Traunin/review-assigner/internal/logging"
+	"github.com/Traunin/review-assigner/internal/tenant"
+	"github.com/labstack/echo/v4"
+)
+
+// comment lines keep proxies from closing an idle stream
+const streamHeartbeat = 15 * time.Second
+
+// GetEventsStream pushes pull request events as Server-Sent Events until the
+// client disconnects, optionally only those of a team or involving a user
+func (s *Server) GetEventsStream(ctx echo.Context) error {
+	reqCtx := ctx.Request().Context()
+	filter := events.Filter{
+		OrgID:    tenant.OrgID(reqCtx),
+		TeamName: ctx.QueryParam("team_name"),
+		UserID:   entities.UserID(ctx.QueryParam("user_id")),
+	}
+
+	if filter.TeamName != "" {
+		exists, err := s.teamRepo.TeamExists(reqCtx, filter.TeamName)
+		if err != nil {
+			return ctx.JSON(http.StatusInternalServerError, map[string]any{
+				"error": map[string]string{
+					"code":    "INTERNAL_ERROR",
+					"message": err.Error(),
+				},
+			})
+		}
+		if !exists {
+			return ctx.JSON(http.StatusNotFound, map[string]any{
+				"error": map[string]string{
+					"code":    "NOT_FOUND",
+					"message": "team not found",
+				},
+			})
+		}
+	}
+
+	// the stream outlives the server's write timeout
+	w := ctx.Response()
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		logging.FromContext(reqCtx).Warn("failed to lift write deadline for stream", "error", err)
+	}
+
+	sub := s.events.Subscribe(filter)
+	defer sub.Close()
+
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set(echo.HeaderConnection, "keep-alive")
+	// nginx buffers responses by default
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-reqCtx.Done():
+			return nil
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+		}
+		w.Flush()
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const readinessTimeout = 2 * time.Second
+
+// GetHealthLive reports that the process is up; it never touches the database
+func (s *Server) GetHealthLive(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// GetHealthReady checks the database and the applied migration version
+func (s *Server) GetHealthReady(ctx echo.Context) error {
+	reqCtx, cancel := context.WithTimeout(
+		ctx.Request().Context(),
+		readinessTimeout,
+	)
+	defer cancel()
+
+	if err := s.health.Ping(reqCtx); err != nil {
+		return ctx.JSON(http.StatusServiceUnavailable, map[string]any{
+			"status":   "unavailable",
+			"database": err.Error(),
+		})
+	}
+
+	version, dirty, err := s.health.MigrationVersion(reqCtx)
+	if err != nil {
+		return ctx.JSON(http.StatusServiceUnavailable, map[string]any{
+			"status":     "unavailable",
+			"database":   "ok",
+			"migrations": err.Error(),
+		})
+	}
+
+	response := map[string]any{
+		"status":            "ok",
+		"database":          "ok",
+		"migration_version": version,
+		"migration_dirty":   dirty,
+	}
+
+	// a dirty schema means a migration failed halfway
+	if dirty || version == 0 {
+		response["status"] = "unavailable"
+		return ctx.JSON(http.StatusServiceUnavailable, response)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Traunin/review-assigner/internal/api/auth"
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// GetUsersGetNotificationPreferences lists the channels a user set up, an
+// empty list means the service default channel is used
+func (s *Server) GetUsersGetNotificationPreferences(ctx echo.Context) error {
+	userID := ctx.QueryParam("user_id")
+	if userID == "" {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "user_id is required",
+			},
+		})
+	}
+
+	prefs, err := s.notificationService.GetPreferences(
+		ctx.Request().Context(),
+		entities.UserID(userID),
+	)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return ctx.JSON(http.StatusNotFound, map[string]any{
+				"error": map[string]string{
+					"code":    "NOT_FOUND",
+					"message": "user not found",
+				},
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"user_id":  userID,
+		"channels": formatNotificationPreferences(prefs),
+	})
+}
+
+// PostUsersSetNotificationPreferences replaces all of a user's channels
+func (s *Server) PostUsersSetNotificationPreferences(ctx echo.Context) error {
+	var req struct {
+		UserID   string `json:"user_id"`
+		Channels []struct {
+			Channel string `json:"channel"`
+			Address string `json:"address"`
+			Enabled *bool  `json:"enabled"`
+		} `json:"channels"`
+	}
+
+	if err := ctx.Bind(&req); err != nil || req.UserID == "" {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	// member tokens may only change their own preferences
+	token := auth.FromContext(ctx.Request().Context())
+	if token != nil && !token.ActsAs(entities.UserID(req.UserID)) {
+		return ctx.JSON(http.StatusForbidden, map[string]any{
+			"error": map[string]string{
+				"code":    "FORBIDDEN",
+				"message": "member tokens can only access their own data",
+			},
+		})
+	}
+
+	cmd := dto.SetNotificationPreferencesCmd{
+		UserID:   entities.UserID(req.UserID),
+		Channels: make([]dto.NotificationPreferenceDTO, len(req.Channels)),
+	}
+	for i, c := range req.Channels {
+		cmd.Channels[i] = dto.NotificationPreferenceDTO{
+			Channel: entities.NotificationChannel(c.Channel),
+			Address: c.Address,
+			// a listed channel is on unless said otherwise
+			Enabled: c.Enabled == nil || *c.Enabled,
+		}
+	}
+
+	prefs, err := s.notificationService.SetPreferences(ctx.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidChannel) ||
+			errors.Is(err, services.ErrDuplicateChannel) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error": map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": err.Error(),
+				},
+			})
+		}
+		if errors.Is(err, services.ErrUserNotFound) {
+			return ctx.JSON(http.StatusNotFound, map[string]any{
+				"error": map[string]string{
+					"code":    "NOT_FOUND",
+					"message": "user not found",
+				},
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"user_id":  req.UserID,
+		"channels": formatNotificationPreferences(prefs),
+	})
+}
+
+func formatNotificationPreferences(prefs []dto.NotificationPreferenceDTO) []map[string]any {
+	channels := make([]map[string]any, len(prefs))
+	for i, p := range prefs {
+		channels[i] = map[string]any{
+			"channel": string(p.Channel),
+			"address": p.Address,
+			"enabled": p.Enabled,
+		}
+	}
+	return channels
+}
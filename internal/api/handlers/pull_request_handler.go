@@ -2,13 +2,15 @@ package handlers
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/Traunin/review-assigner/internal/api/auth"
 	"github.com/Traunin/review-assigner/internal/application/dto"
 	"github.com/Traunin/review-assigner/internal/application/services"
 	"github.com/Traunin/review-assigner/internal/domain/entities"
 	domainservices "github.com/Traunin/review-assigner/internal/domain/services"
+	"github.com/Traunin/review-assigner/internal/logging"
 	"github.com/labstack/echo/v4"
 )
 
@@ -68,6 +70,121 @@ func (s *Server) PostPullRequestCreate(ctx echo.Context) error {
 	})
 }
 
+// PostPullRequestPreviewAssignment shows who would review a pull request by
+// the author without creating it. The seed makes the pick repeatable.
+func (s *Server) PostPullRequestPreviewAssignment(ctx echo.Context) error {
+	var req struct {
+		AuthorID string `json:"author_id"`
+		Seed     string `json:"seed"`
+	}
+
+	if err := ctx.Bind(&req); err != nil || req.AuthorID == "" {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "author_id is required",
+			},
+		})
+	}
+
+	// member tokens may only preview their own pull requests
+	token := auth.FromContext(ctx.Request().Context())
+	if token != nil && !token.ActsAs(entities.UserID(req.AuthorID)) {
+		return ctx.JSON(http.StatusForbidden, map[string]any{
+			"error": map[string]string{
+				"code":    "FORBIDDEN",
+				"message": "member tokens can only preview their own pull requests",
+			},
+		})
+	}
+
+	reqCtx := ctx.Request().Context()
+	if req.Seed != "" {
+		seed, err := strconv.ParseUint(req.Seed, 10, 64)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error": map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": "seed must be an unsigned 64-bit integer",
+				},
+			})
+		}
+		reqCtx = domainservices.WithSeed(reqCtx, seed)
+	}
+
+	preview, err := s.prService.PreviewAssignment(
+		reqCtx,
+		entities.UserID(req.AuthorID),
+	)
+	if err != nil {
+		if errors.Is(err, domainservices.ErrAuthorNotFound) ||
+			errors.Is(err, domainservices.ErrTeamNotFound) {
+			return ctx.JSON(http.StatusNotFound, map[string]any{
+				"error": map[string]string{
+					"code":    "NOT_FOUND",
+					"message": err.Error(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	excluded := make([]map[string]any, len(preview.Excluded))
+	for i, e := range preview.Excluded {
+		excluded[i] = map[string]any{
+			"user_id": string(e.UserID),
+			"reason":  string(e.Reason),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"author_id":  string(preview.AuthorID),
+		"team_name":  preview.TeamName,
+		"candidates": userIDStrings(preview.Candidates),
+		"excluded":   excluded,
+		"reviewers":  userIDStrings(preview.Reviewers),
+		"seed":       strconv.FormatUint(preview.Seed, 10),
+	})
+}
+
+// GetPullRequestList lists the organization's pull requests, newest first,
+// optionally only those with the given status
+func (s *Server) GetPullRequestList(ctx echo.Context) error {
+	status := entities.PRStatus(ctx.QueryParam("status"))
+	if status != "" && status != entities.StatusOpen && status != entities.StatusMerged {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "status must be OPEN or MERGED",
+			},
+		})
+	}
+
+	prs, err := s.prService.List(ctx.Request().Context(), status)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	pullRequests := make([]map[string]any, len(prs))
+	for i, pr := range prs {
+		pullRequests[i] = formatPullRequest(pr)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"pull_requests": pullRequests,
+	})
+}
+
 func (s *Server) PostPullRequestMerge(ctx echo.Context) error {
 	var req struct {
 		PullRequestID string `json:"pull_request_id"`
@@ -96,7 +213,11 @@ func (s *Server) PostPullRequestMerge(ctx echo.Context) error {
 				},
 			})
 		}
-		fmt.Printf("Merge error: %v\n", err)
+		logging.FromContext(ctx.Request().Context()).Error(
+			"merge failed",
+			"pr_id", req.PullRequestID,
+			"error", err,
+		)
 		return ctx.JSON(http.StatusInternalServerError, map[string]any{
 			"error": map[string]string{
 				"code":    "INTERNAL_ERROR",
@@ -110,10 +231,13 @@ func (s *Server) PostPullRequestMerge(ctx echo.Context) error {
 	})
 }
 
+// PostPullRequestReassign replaces a reviewer with new_user_id, or with a
+// random active member of the author's team when it's omitted
 func (s *Server) PostPullRequestReassign(ctx echo.Context) error {
 	var req struct {
 		PullRequestID string `json:"pull_request_id"`
 		OldUserID     string `json:"old_user_id"`
+		NewUserID     string `json:"new_user_id"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]any{
@@ -127,42 +251,209 @@ func (s *Server) PostPullRequestReassign(ctx echo.Context) error {
 	cmd := dto.ReassignReviewerCmd{
 		OldUserID:     entities.UserID(req.OldUserID),
 		PullRequestID: entities.PullRequestID(req.PullRequestID),
+		NewUserID:     entities.UserID(req.NewUserID),
 	}
 
 	result, err := s.prService.ReassignReviewer(ctx.Request().Context(), cmd)
 	if err != nil {
-		if errors.Is(err, domainservices.ErrPRAlreadyMerged) {
-			return ctx.JSON(http.StatusConflict, map[string]any{
+		return reviewerChangeError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"pr":          formatPullRequest(*result.PullRequestID),
+		"replaced_by": string(result.Assigned),
+	})
+}
+
+// PostPullRequestAddReviewer assigns a chosen reviewer on top of the
+// current ones
+func (s *Server) PostPullRequestAddReviewer(ctx echo.Context) error {
+	var req struct {
+		PullRequestID string `json:"pull_request_id"`
+		UserID        string `json:"user_id"`
+	}
+	if err := ctx.Bind(&req); err != nil || req.PullRequestID == "" || req.UserID == "" {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "pull_request_id and user_id are required",
+			},
+		})
+	}
+
+	pr, err := s.prService.AddReviewer(ctx.Request().Context(), dto.ChangeReviewerCmd{
+		PullRequestID: entities.PullRequestID(req.PullRequestID),
+		UserID:        entities.UserID(req.UserID),
+	})
+	if err != nil {
+		return reviewerChangeError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"pr":      formatPullRequest(pr),
+		"reviews": formatReviews(pr),
+	})
+}
+
+// PostPullRequestRemoveReviewer unassigns a reviewer without a replacement
+func (s *Server) PostPullRequestRemoveReviewer(ctx echo.Context) error {
+	var req struct {
+		PullRequestID string `json:"pull_request_id"`
+		UserID        string `json:"user_id"`
+	}
+	if err := ctx.Bind(&req); err != nil || req.PullRequestID == "" || req.UserID == "" {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "pull_request_id and user_id are required",
+			},
+		})
+	}
+
+	pr, err := s.prService.RemoveReviewer(ctx.Request().Context(), dto.ChangeReviewerCmd{
+		PullRequestID: entities.PullRequestID(req.PullRequestID),
+		UserID:        entities.UserID(req.UserID),
+	})
+	if err != nil {
+		return reviewerChangeError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"pr":      formatPullRequest(pr),
+		"reviews": formatReviews(pr),
+	})
+}
+
+// PostPullRequestFillReviewers assigns more reviewers to open pull requests
+// that got fewer than a new one would
+func (s *Server) PostPullRequestFillReviewers(ctx echo.Context) error {
+	filled, err := s.prService.FillReviewers(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"filled": formatFilled(filled),
+	})
+}
+
+func formatFilled(filled []dto.FilledDTO) []map[string]any {
+	out := make([]map[string]any, len(filled))
+	for i, f := range filled {
+		out[i] = map[string]any{
+			"pr":    formatPullRequest(f.PullRequest),
+			"added": userIDStrings(f.Added),
+		}
+	}
+	return out
+}
+
+// reviewerChangeError answers a failed reassign, addReviewer or
+// removeReviewer call
+func reviewerChangeError(ctx echo.Context, err error) error {
+	status, code, message := http.StatusInternalServerError, "INTERNAL_ERROR", err.Error()
+	switch {
+	case errors.Is(err, domainservices.ErrPRAlreadyMerged):
+		status, code, message = http.StatusConflict, "PR_MERGED", "cannot change reviewers of a merged PR"
+	case errors.Is(err, domainservices.ErrUserNotReviewer):
+		status, code, message = http.StatusConflict, "NOT_ASSIGNED", "reviewer is not assigned to this PR"
+	case errors.Is(err, domainservices.ErrAtCapacity):
+		status, code, message = http.StatusConflict, "AT_CAPACITY", "every replacement candidate in team is at capacity"
+	case errors.Is(err, domainservices.ErrNoCandidate):
+		status, code, message = http.StatusConflict, "NO_CANDIDATE", "no active replacement candidate in team"
+	case errors.Is(err, entities.ErrReviewerAssigned):
+		status, code = http.StatusConflict, "ALREADY_ASSIGNED"
+	case errors.Is(err, entities.ErrPRTooManyReviewers):
+		status, code = http.StatusConflict, "TOO_MANY_REVIEWERS"
+	case errors.Is(err, domainservices.ErrUserInactive),
+		errors.Is(err, domainservices.ErrUserNotInTeam),
+		errors.Is(err, entities.ErrAuthorIsReviewer):
+		status, code = http.StatusConflict, "INVALID_REVIEWER"
+	case errors.Is(err, domainservices.ErrPRNotFound),
+		errors.Is(err, domainservices.ErrUserNotFound),
+		errors.Is(err, domainservices.ErrTeamNotFound):
+		status, code = http.StatusNotFound, "NOT_FOUND"
+	}
+
+	return ctx.JSON(status, map[string]any{
+		"error": map[string]string{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func (s *Server) PostPullRequestVerdict(ctx echo.Context) error {
+	var req struct {
+		PullRequestID string `json:"pull_request_id"`
+		UserID        string `json:"user_id"`
+		Verdict       string `json:"verdict"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	// member tokens may only record their own verdicts
+	token := auth.FromContext(ctx.Request().Context())
+	if token != nil && !token.ActsAs(entities.UserID(req.UserID)) {
+		return ctx.JSON(http.StatusForbidden, map[string]any{
+			"error": map[string]string{
+				"code":    "FORBIDDEN",
+				"message": "member tokens can only record their own verdicts",
+			},
+		})
+	}
+
+	cmd := dto.RecordVerdictCmd{
+		PullRequestID: entities.PullRequestID(req.PullRequestID),
+		UserID:        entities.UserID(req.UserID),
+		Verdict:       entities.Verdict(req.Verdict),
+	}
+
+	pr, err := s.prService.RecordVerdict(ctx.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidVerdict) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
 				"error": map[string]string{
-					"code":    "PR_MERGED",
-					"message": "cannot reassign on merged PR",
+					"code":    "INVALID_REQUEST",
+					"message": err.Error(),
 				},
 			})
 		}
 
-		if errors.Is(err, domainservices.ErrUserNotReviewer) {
+		if errors.Is(err, entities.ErrPRMerged) {
 			return ctx.JSON(http.StatusConflict, map[string]any{
 				"error": map[string]string{
-					"code":    "NOT_ASSIGNED",
-					"message": "reviewer is not assigned to this PR",
+					"code":    "PR_MERGED",
+					"message": "cannot review a merged PR",
 				},
 			})
 		}
 
-		if errors.Is(err, domainservices.ErrNoCandidate) {
+		if errors.Is(err, entities.ErrReviewerNotAssigned) {
 			return ctx.JSON(http.StatusConflict, map[string]any{
 				"error": map[string]string{
-					"code":    "NO_CANDIDATE",
-					"message": "no active replacement candidate in team",
+					"code":    "NOT_ASSIGNED",
+					"message": "reviewer is not assigned to this PR",
 				},
 			})
 		}
 
-		if errors.Is(err, domainservices.ErrPRNotFound) {
+		if errors.Is(err, services.ErrNotFound) {
 			return ctx.JSON(http.StatusNotFound, map[string]any{
 				"error": map[string]string{
 					"code":    "NOT_FOUND",
-					"message": err.Error(),
+					"message": "PR not found",
 				},
 			})
 		}
@@ -176,11 +467,32 @@ func (s *Server) PostPullRequestReassign(ctx echo.Context) error {
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]any{
-		"pr":          formatPullRequest(*result.PullRequestID),
-		"replaced_by": string(result.Assigned),
+		"pr":      formatPullRequest(pr),
+		"reviews": formatReviews(pr),
 	})
 }
 
+func formatReviews(pr dto.PullRequestDTO) []map[string]any {
+	reviews := make([]map[string]any, len(pr.Reviewers))
+	for i, r := range pr.Reviewers {
+		reviews[i] = map[string]any{
+			"user_id":     string(r.UserID),
+			"assigned_at": r.AssignedAt,
+		}
+		if r.Verdict != "" {
+			reviews[i]["verdict"] = string(r.Verdict)
+			reviews[i]["verdict_at"] = r.VerdictAt
+		}
+		if r.Seed != nil {
+			reviews[i]["seed"] = strconv.FormatUint(*r.Seed, 10)
+		}
+		if r.AssignedBy != "" {
+			reviews[i]["assigned_by"] = r.AssignedBy
+		}
+	}
+	return reviews
+}
+
 func formatPullRequest(pr dto.PullRequestDTO) map[string]any {
 	assignedReviewers := make([]string, len(pr.Reviewers))
 	for i, r := range pr.Reviewers {
@@ -202,3 +514,11 @@ func formatPullRequest(pr dto.PullRequestDTO) map[string]any {
 
 	return response
 }
+
+func userIDStrings(ids []entities.UserID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = string(id)
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"github.com/Traunin/review-assigner/internal/api/auth"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterRoutes mounts the API on e, apiMiddleware runs before the role
+// checks of every route except the healthchecks
+func RegisterRoutes(
+	e *echo.Echo,
+	server *Server,
+	apiMiddleware []echo.MiddlewareFunc,
+) {
+	api := e.Group("", apiMiddleware...)
+	admin := auth.Require(entities.RoleAdmin)
+	bot := auth.Require(entities.RoleBot)
+
+	api.POST("/team/add", server.PostTeamAdd, admin)
+	api.GET("/team/get", server.GetTeamGet)
+	api.POST("/team/setReviewSLA", server.PostTeamSetReviewSLA, admin)
+
+	api.POST("/users/setIsActive", server.PostUsersSetIsActive, admin)
+	api.POST("/users/setMaxOpenReviews", server.PostUsersSetMaxOpenReviews, admin)
+	api.POST("/users/setOutOfOffice", server.PostUsersSetOutOfOffice, admin)
+	api.GET("/users/getReview", server.GetUsersGetReview, auth.RequireSelf("user_id"))
+	api.GET("/users/digest", server.GetUsersDigest, auth.RequireSelf("user_id"))
+	api.GET(
+		"/users/getNotificationPreferences",
+		server.GetUsersGetNotificationPreferences,
+		auth.RequireSelf("user_id"),
+	)
+	api.POST("/users/setNotificationPreferences", server.PostUsersSetNotificationPreferences)
+
+	api.GET("/pullRequest/list", server.GetPullRequestList, bot)
+	api.POST("/pullRequest/create", server.PostPullRequestCreate, bot)
+	// member tokens are limited to their own pull requests by the handler
+	api.POST(
+		"/pullRequest/previewAssignment",
+		server.PostPullRequestPreviewAssignment,
+		auth.Require(entities.RoleBot, entities.RoleMember),
+	)
+	api.POST("/pullRequest/merge", server.PostPullRequestMerge, bot)
+	api.POST("/pullRequest/reassign", server.PostPullRequestReassign, bot)
+	api.POST("/pullRequest/addReviewer", server.PostPullRequestAddReviewer, bot)
+	api.POST("/pullRequest/removeReviewer", server.PostPullRequestRemoveReviewer, bot)
+	api.POST("/pullRequest/fillReviewers", server.PostPullRequestFillReviewers, bot)
+	api.POST(
+		"/pullRequest/verdict",
+		server.PostPullRequestVerdict,
+		auth.Require(entities.RoleBot, entities.RoleMember),
+	)
+
+	api.GET("/events/stream", server.GetEventsStream, auth.RequireSelf("user_id"))
+
+	api.POST("/admin/import", server.PostAdminImport, admin)
+	api.GET("/admin/export", server.GetAdminExport, admin)
+
+	// statistics endpoints, members only see their own assignment counts
+	api.GET("/stats/reviewers", server.GetStatsReviewers, auth.RequireSelf("user_id"))
+	api.GET("/stats/pullRequests", server.GetStatsPullRequests, bot)
+	api.GET("/stats/sla", server.GetStatsSLA, bot)
+	api.GET("/stats/fairness", server.GetStatsFairness, bot)
+
+	// healthchecks stay public, /health is kept for existing probes
+	e.GET("/health", server.GetHealthLive)
+	e.GET("/health/live", server.GetHealthLive)
+	e.GET("/health/ready", server.GetHealthReady)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/api/auth"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// withRole authenticates every request as a token with the role in the
+// X-Test-Role header, members act as u1
+func withRole(t *testing.T) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			role := entities.Role(c.Request().Header.Get("X-Test-Role"))
+			var userID *entities.UserID
+			var orgID *entities.OrgID
+			if role == entities.RoleMember {
+				u, o := entities.UserID("u1"), entities.OrgID(1)
+				userID, orgID = &u, &o
+			}
+			token, err := entities.NewAPIToken(1, "test", role, userID, orgID, time.Now(), nil)
+			if err != nil {
+				t.Fatalf("NewAPIToken: %v", err)
+			}
+			ctx := auth.WithToken(c.Request().Context(), token)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// TestRouteRoles checks who gets past the role checks. Handlers of an empty
+// Server panic once reached, which the recover middleware turns into a 500,
+// so anything but 403 means the request was let through.
+func TestRouteRoles(t *testing.T) {
+	e := echo.New()
+	e.Logger.SetOutput(io.Discard)
+	e.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{DisablePrintStack: true}))
+	RegisterRoutes(e, &Server{}, []echo.MiddlewareFunc{withRole(t)})
+
+	const (
+		admin  = entities.RoleAdmin
+		bot    = entities.RoleBot
+		member = entities.RoleMember
+	)
+
+	tests := []struct {
+		method, target, body string
+		allowed              []entities.Role
+	}{
+		{http.MethodGet, "/pullRequest/list", "", []entities.Role{admin, bot}},
+		{http.MethodGet, "/stats/pullRequests", "", []entities.Role{admin, bot}},
+		{http.MethodGet, "/stats/sla", "", []entities.Role{admin, bot}},
+		{http.MethodGet, "/stats/fairness?team_name=backend", "", []entities.Role{admin, bot}},
+		{http.MethodGet, "/stats/reviewers", "", []entities.Role{admin, bot}},
+		{http.MethodGet, "/stats/reviewers?user_id=u2", "", []entities.Role{admin, bot}},
+		{http.MethodGet, "/stats/reviewers?user_id=u1", "", []entities.Role{admin, bot, member}},
+		{http.MethodPost, "/pullRequest/previewAssignment", `{"author_id":"u2"}`, []entities.Role{admin, bot}},
+		{http.MethodPost, "/pullRequest/previewAssignment", `{"author_id":"u1"}`, []entities.Role{admin, bot, member}},
+		{http.MethodPost, "/pullRequest/create", `{}`, []entities.Role{admin, bot}},
+		{http.MethodPost, "/users/setIsActive", `{}`, []entities.Role{admin}},
+		{http.MethodPost, "/users/setMaxOpenReviews", `{}`, []entities.Role{admin}},
+		{http.MethodPost, "/users/setOutOfOffice", `{}`, []entities.Role{admin}},
+		{http.MethodGet, "/users/getReview?user_id=u2", "", []entities.Role{admin, bot}},
+	}
+	for _, tt := range tests {
+		for _, role := range []entities.Role{admin, bot, member} {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set("X-Test-Role", string(role))
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			want := false
+			for _, r := range tt.allowed {
+				want = want || r == role
+			}
+			if got := rec.Code != http.StatusForbidden; got != want {
+				t.Errorf("%s %s %s as %s: status %d, allowed %t", tt.method, tt.target, tt.body, role, rec.Code, want)
+			}
+		}
+	}
+}
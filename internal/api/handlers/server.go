@@ -1,30 +1,56 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/Traunin/review-assigner/internal/application/services"
 	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/events"
 )
 
+type HealthChecker interface {
+	Ping(ctx context.Context) error
+	MigrationVersion(ctx context.Context) (version int64, dirty bool, err error)
+}
+
 type Server struct {
-	teamService services.TeamService
-	prService   services.PullRequestService
-	userRepo    repositories.UserRepository
-	teamRepo    repositories.TeamRepository
-	prRepo      repositories.PullRequestRepository
+	teamService         services.TeamService
+	prService           services.PullRequestService
+	statsService        services.StatsService
+	digestService       services.DigestService
+	notificationService services.NotificationService
+	bulkService         services.BulkService
+	events              *events.Bus
+	userRepo            repositories.UserRepository
+	teamRepo            repositories.TeamRepository
+	prRepo              repositories.PullRequestRepository
+	health              HealthChecker
 }
 
 func NewServer(
 	teamService services.TeamService,
 	prService services.PullRequestService,
+	statsService services.StatsService,
+	digestService services.DigestService,
+	notificationService services.NotificationService,
+	bulkService services.BulkService,
+	bus *events.Bus,
 	userRepo repositories.UserRepository,
 	teamRepo repositories.TeamRepository,
 	prRepo repositories.PullRequestRepository,
+	health HealthChecker,
 ) *Server {
 	return &Server{
-		teamService: teamService,
-		prService:   prService,
-		userRepo:    userRepo,
-		teamRepo:    teamRepo,
-		prRepo:      prRepo,
+		teamService:         teamService,
+		prService:           prService,
+		statsService:        statsService,
+		digestService:       digestService,
+		notificationService: notificationService,
+		bulkService:         bulkService,
+		events:              bus,
+		userRepo:            userRepo,
+		teamRepo:            teamRepo,
+		prRepo:              prRepo,
+		health:              health,
 	}
 }
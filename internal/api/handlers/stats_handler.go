@@ -1,41 +1,34 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/services"
 	"github.com/Traunin/review-assigner/internal/domain/entities"
 	"github.com/labstack/echo/v4"
 )
 
+// GetStatsReviewers returns assignment counts for every user of the
+// organization, or for one user when user_id is given
 func (s *Server) GetStatsReviewers(ctx echo.Context) error {
-	userID := ctx.QueryParam("user_id")
-
-	// If user_id is provided, return stats for specific user
-	if userID != "" {
-		user, err := s.userRepo.FindByID(ctx.Request().Context(), entities.UserID(userID))
-		if err != nil {
-			return ctx.JSON(http.StatusInternalServerError, map[string]any{
-				"error": map[string]string{
-					"code":    "INTERNAL_ERROR",
-					"message": err.Error(),
-				},
-			})
-		}
-
-		if user == nil {
-			return ctx.JSON(http.StatusNotFound, map[string]any{
-				"error": map[string]string{
-					"code":    "NOT_FOUND",
-					"message": "user not found",
-				},
-			})
-		}
-
-		prs, err := s.prRepo.FindPullRequestByUserID(
+	if userID := ctx.QueryParam("user_id"); userID != "" {
+		stats, err := s.statsService.GetReviewerStatsByUserID(
 			ctx.Request().Context(),
 			entities.UserID(userID),
 		)
 		if err != nil {
+			if errors.Is(err, services.ErrUserNotFound) {
+				return ctx.JSON(http.StatusNotFound, map[string]any{
+					"error": map[string]string{
+						"code":    "NOT_FOUND",
+						"message": "user not found",
+					},
+				})
+			}
 			return ctx.JSON(http.StatusInternalServerError, map[string]any{
 				"error": map[string]string{
 					"code":    "INTERNAL_ERROR",
@@ -44,27 +37,33 @@ func (s *Server) GetStatsReviewers(ctx echo.Context) error {
 			})
 		}
 
-		openCount := 0
-		mergedCount := 0
-		for _, pr := range prs {
-			if pr.Status().String() == "OPEN" {
-				openCount++
-			} else if pr.Status().String() == "MERGED" {
-				mergedCount++
-			}
-		}
+		return ctx.JSON(http.StatusOK, formatReviewerStats(*stats))
+	}
 
-		return ctx.JSON(http.StatusOK, map[string]any{
-			"user_id":            userID,
-			"username":           user.Username(),
-			"total_assignments":  len(prs),
-			"open_assignments":   openCount,
-			"merged_assignments": mergedCount,
+	stats, err := s.statsService.GetReviewerStats(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
 		})
 	}
 
-	// If no user_id, return stats for all users
-	allPRs, err := s.prRepo.FindAll(ctx.Request().Context())
+	users := make([]map[string]any, len(stats.Reviewers))
+	for i, r := range stats.Reviewers {
+		users[i] = formatReviewerStats(r)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"users":               users,
+		"total_pull_requests": stats.TotalPullRequests,
+	})
+}
+
+// GetStatsPullRequests returns pull request counts overall and per author team
+func (s *Server) GetStatsPullRequests(ctx echo.Context) error {
+	stats, err := s.statsService.GetPullRequestStats(ctx.Request().Context())
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]any{
 			"error": map[string]string{
@@ -74,62 +73,127 @@ func (s *Server) GetStatsReviewers(ctx echo.Context) error {
 		})
 	}
 
-	// Count assignments per user
-	userStats := make(map[entities.UserID]*struct {
-		Username string
-		Total    int
-		Open     int
-		Merged   int
-	})
+	byTeam := make([]map[string]any, len(stats.ByTeam))
+	for i, t := range stats.ByTeam {
+		byTeam[i] = formatPullRequestCounts(t.PullRequestCountsDTO)
+		byTeam[i]["team_name"] = t.TeamName
+	}
 
-	for _, pr := range allPRs {
-		reviewers := pr.Reviewers()
-		for _, reviewer := range reviewers {
-			if _, exists := userStats[reviewer.UserID]; !exists {
-				user, err := s.userRepo.FindByID(ctx.Request().Context(), reviewer.UserID)
-				if err != nil || user == nil {
-					continue
-				}
-				userStats[reviewer.UserID] = &struct {
-					Username string
-					Total    int
-					Open     int
-					Merged   int
-				}{
-					Username: user.Username(),
-				}
-			}
+	response := formatPullRequestCounts(stats.PullRequestCountsDTO)
+	response["by_team"] = byTeam
 
-			userStats[reviewer.UserID].Total++
-			if pr.Status().String() == "OPEN" {
-				userStats[reviewer.UserID].Open++
-			} else if pr.Status().String() == "MERGED" {
-				userStats[reviewer.UserID].Merged++
-			}
-		}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func formatReviewerStats(r dto.ReviewerStatsDTO) map[string]any {
+	return map[string]any{
+		"user_id":            string(r.UserID),
+		"username":           r.Username,
+		"team_name":          r.TeamName,
+		"is_active":          r.IsActive,
+		"total_assignments":  r.TotalAssignments,
+		"open_assignments":   r.OpenAssignments,
+		"merged_assignments": r.MergedAssignments,
+	}
+}
+
+func formatPullRequestCounts(c dto.PullRequestCountsDTO) map[string]any {
+	return map[string]any{
+		"total_pull_requests":  c.TotalPullRequests,
+		"open_pull_requests":   c.OpenPullRequests,
+		"merged_pull_requests": c.MergedPullRequests,
+		"total_reviewers":      c.TotalReviewers,
+		"avg_reviewers_per_pr": c.AvgReviewersPerPR,
 	}
+}
 
-	users := make([]map[string]any, 0, len(userStats))
-	for userID, stats := range userStats {
-		users = append(users, map[string]any{
-			"user_id":            string(userID),
-			"username":           stats.Username,
-			"total_assignments":  stats.Total,
-			"open_assignments":   stats.Open,
-			"merged_assignments": stats.Merged,
+// GetStatsSLA returns review latency percentiles, optionally limited to PRs
+// created inside [from, to)
+func (s *Server) GetStatsSLA(ctx echo.Context) error {
+	window, err := parseWindow(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": err.Error(),
+			},
 		})
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{
-		"users":               users,
-		"total_pull_requests": len(allPRs),
-	})
+	report, err := s.statsService.GetSLA(ctx.Request().Context(), window)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidWindow) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error": map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": err.Error(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	response := map[string]any{
+		"time_to_merge":         formatSLABreakdown(report.TimeToMerge),
+		"time_to_first_review":  formatSLABreakdown(report.TimeToFirstReview),
+		"assignment_to_verdict": formatSLABreakdown(report.AssignmentToVerdict),
+	}
+	if report.From != nil {
+		response["from"] = report.From
+	}
+	if report.To != nil {
+		response["to"] = report.To
+	}
+
+	return ctx.JSON(http.StatusOK, response)
 }
 
-// GetStatsPullRequests returns statistics about pull requests
-func (s *Server) GetStatsPullRequests(ctx echo.Context) error {
-	allPRs, err := s.prRepo.FindAll(ctx.Request().Context())
+// GetStatsFairness compares each team member's assignments to their share of
+// the team's active time
+func (s *Server) GetStatsFairness(ctx echo.Context) error {
+	teamName := ctx.QueryParam("team_name")
+	if teamName == "" {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "team_name is required",
+			},
+		})
+	}
+
+	window, err := parseWindow(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	report, err := s.statsService.GetFairness(ctx.Request().Context(), teamName, window)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidWindow) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error": map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": err.Error(),
+				},
+			})
+		}
+		if errors.Is(err, services.ErrTeamNotFound) {
+			return ctx.JSON(http.StatusNotFound, map[string]any{
+				"error": map[string]string{
+					"code":    "NOT_FOUND",
+					"message": "team not found",
+				},
+			})
+		}
 		return ctx.JSON(http.StatusInternalServerError, map[string]any{
 			"error": map[string]string{
 				"code":    "INTERNAL_ERROR",
@@ -138,29 +202,84 @@ func (s *Server) GetStatsPullRequests(ctx echo.Context) error {
 		})
 	}
 
-	openCount := 0
-	mergedCount := 0
-	totalReviewers := 0
+	members := make([]map[string]any, len(report.Members))
+	for i, m := range report.Members {
+		members[i] = map[string]any{
+			"user_id":              string(m.UserID),
+			"username":             m.Username,
+			"is_active":            m.IsActive,
+			"active_days":          m.ActiveDays,
+			"assignments":          m.Assignments,
+			"expected_share":       m.ExpectedShare,
+			"expected_assignments": m.ExpectedAssignments,
+			"deviation":            m.Deviation,
+		}
+	}
+
+	response := map[string]any{
+		"team_name":         report.TeamName,
+		"total_assignments": report.TotalAssignments,
+		"gini":              report.Gini,
+		"members":           members,
+	}
+	if report.From != nil {
+		response["from"] = report.From
+	}
+	if report.To != nil {
+		response["to"] = report.To
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	for _, pr := range allPRs {
-		if pr.Status().String() == "OPEN" {
-			openCount++
-		} else if pr.Status().String() == "MERGED" {
-			mergedCount++
+// parseWindow reads the optional from/to RFC 3339 query parameters
+func parseWindow(ctx echo.Context) (entities.TimeWindow, error) {
+	var window entities.TimeWindow
+	for _, bound := range []struct {
+		name string
+		dst  **time.Time
+	}{
+		{"from", &window.From},
+		{"to", &window.To},
+	} {
+		raw := ctx.QueryParam(bound.name)
+		if raw == "" {
+			continue
 		}
-		totalReviewers += len(pr.Reviewers())
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return window, fmt.Errorf("%s must be an RFC 3339 timestamp", bound.name)
+		}
+		*bound.dst = &t
 	}
+	return window, nil
+}
 
-	avgReviewers := 0.0
-	if len(allPRs) > 0 {
-		avgReviewers = float64(totalReviewers) / float64(len(allPRs))
+func formatSLABreakdown(b dto.SLABreakdownDTO) map[string]any {
+	byTeam := make([]map[string]any, len(b.ByTeam))
+	for i, t := range b.ByTeam {
+		byTeam[i] = formatPercentiles(t.PercentilesDTO)
+		byTeam[i]["team_name"] = t.TeamName
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{
-		"total_pull_requests":  len(allPRs),
-		"open_pull_requests":   openCount,
-		"merged_pull_requests": mergedCount,
-		"total_reviewers":      totalReviewers,
-		"avg_reviewers_per_pr": avgReviewers,
-	})
+	byReviewer := make([]map[string]any, len(b.ByReviewer))
+	for i, r := range b.ByReviewer {
+		byReviewer[i] = formatPercentiles(r.PercentilesDTO)
+		byReviewer[i]["user_id"] = string(r.UserID)
+	}
+
+	return map[string]any{
+		"overall":     formatPercentiles(b.Overall),
+		"by_team":     byTeam,
+		"by_reviewer": byReviewer,
+	}
+}
+
+func formatPercentiles(p dto.PercentilesDTO) map[string]any {
+	return map[string]any{
+		"samples":     p.Samples,
+		"p50_seconds": p.P50,
+		"p90_seconds": p.P90,
+		"p99_seconds": p.P99,
+	}
 }
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/Traunin/review-assigner/internal/application/dto"
 	"github.com/Traunin/review-assigner/internal/application/services"
@@ -145,3 +147,67 @@ func (s *Server) GetTeamGet(ctx echo.Context) error {
 		"members":   members,
 	})
 }
+
+// PostTeamSetReviewSLA sets how long the team's reviewers may stay silent,
+// omitting both durations resets the team to the service defaults
+func (s *Server) PostTeamSetReviewSLA(ctx echo.Context) error {
+	var req struct {
+		TeamName             string `json:"team_name"`
+		RemindAfterSeconds   *int   `json:"remind_after_seconds"`
+		ReassignAfterSeconds *int   `json:"reassign_after_seconds"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	cmd := dto.SetReviewSLACmd{
+		TeamName:      req.TeamName,
+		RemindAfter:   secondsToDuration(req.RemindAfterSeconds),
+		ReassignAfter: secondsToDuration(req.ReassignAfterSeconds),
+	}
+
+	if err := s.teamService.SetReviewSLA(ctx.Request().Context(), cmd); err != nil {
+		if errors.Is(err, entities.ErrReviewSLARemind) ||
+			errors.Is(err, entities.ErrReviewSLAReassign) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error": map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": err.Error(),
+				},
+			})
+		}
+		if errors.Is(err, services.ErrTeamNotFound) {
+			return ctx.JSON(http.StatusNotFound, map[string]any{
+				"error": map[string]string{
+					"code":    "NOT_FOUND",
+					"message": "team not found",
+				},
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"team_name":              req.TeamName,
+		"remind_after_seconds":   req.RemindAfterSeconds,
+		"reassign_after_seconds": req.ReassignAfterSeconds,
+	})
+}
+
+func secondsToDuration(seconds *int) *time.Duration {
+	if seconds == nil {
+		return nil
+	}
+	d := time.Duration(*seconds) * time.Second
+	return &d
+}
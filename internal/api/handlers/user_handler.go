@@ -1,9 +1,15 @@
 package handlers
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"time"
 
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/digest"
 	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/logging"
 	"github.com/labstack/echo/v4"
 )
 
@@ -41,6 +47,7 @@ func (s *Server) PostUsersSetIsActive(ctx echo.Context) error {
 		})
 	}
 
+	reactivated := req.IsActive && !user.IsActive()
 	user.SetActive(req.IsActive)
 	if err := s.userRepo.Update(ctx.Request().Context(), user); err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]any{
@@ -51,22 +58,215 @@ func (s *Server) PostUsersSetIsActive(ctx echo.Context) error {
 		})
 	}
 
+	formatted, err := s.formatUser(ctx.Request().Context(), user)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	response := map[string]any{"user": formatted}
+	if reactivated && user.TeamID() != nil {
+		// the returning user may be the candidate their team's under-staffed
+		// PRs wait for, failing that isn't a reason to fail the update
+		filled, err := s.prService.FillTeamReviewers(ctx.Request().Context(), *user.TeamID())
+		if err != nil {
+			logging.FromContext(ctx.Request().Context()).Warn(
+				"failed to fill reviewers",
+				"user_id", user.ID(),
+				"error", err,
+			)
+		} else {
+			response["filled"] = formatFilled(filled)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// PostUsersSetMaxOpenReviews caps how many open pull requests a user is
+// picked to review, null removes the cap
+func (s *Server) PostUsersSetMaxOpenReviews(ctx echo.Context) error {
+	var req struct {
+		UserID         string `json:"user_id"`
+		MaxOpenReviews *int   `json:"max_open_reviews"`
+	}
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	user, err := s.userRepo.FindByID(ctx.Request().Context(), entities.UserID(req.UserID))
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	if user == nil {
+		return ctx.JSON(http.StatusNotFound, map[string]any{
+			"error": map[string]string{
+				"code":    "NOT_FOUND",
+				"message": "user not found",
+			},
+		})
+	}
+
+	if err := user.SetMaxOpenReviews(req.MaxOpenReviews); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	found, err := s.userRepo.SetMaxOpenReviews(
+		ctx.Request().Context(),
+		user.ID(),
+		user.MaxOpenReviews(),
+	)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	// deleted between the lookup and the update
+	if !found {
+		return ctx.JSON(http.StatusNotFound, map[string]any{
+			"error": map[string]string{
+				"code":    "NOT_FOUND",
+				"message": "user not found",
+			},
+		})
+	}
+
+	formatted, err := s.formatUser(ctx.Request().Context(), user)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{"user": formatted})
+}
+
+// PostUsersSetOutOfOffice keeps a user out of automatic selection until the
+// given time, null brings them back
+func (s *Server) PostUsersSetOutOfOffice(ctx echo.Context) error {
+	var req struct {
+		UserID string     `json:"user_id"`
+		Until  *time.Time `json:"until"`
+	}
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	user, err := s.userRepo.FindByID(ctx.Request().Context(), entities.UserID(req.UserID))
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	if user == nil {
+		return ctx.JSON(http.StatusNotFound, map[string]any{
+			"error": map[string]string{
+				"code":    "NOT_FOUND",
+				"message": "user not found",
+			},
+		})
+	}
+
+	user.SetOutOfOfficeUntil(req.Until)
+	found, err := s.userRepo.SetOutOfOffice(
+		ctx.Request().Context(),
+		user.ID(),
+		user.OutOfOfficeUntil(),
+	)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	// deleted between the lookup and the update
+	if !found {
+		return ctx.JSON(http.StatusNotFound, map[string]any{
+			"error": map[string]string{
+				"code":    "NOT_FOUND",
+				"message": "user not found",
+			},
+		})
+	}
+
+	formatted, err := s.formatUser(ctx.Request().Context(), user)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{"user": formatted})
+}
+
+// formatUser renders a user along with their current review load
+func (s *Server) formatUser(ctx context.Context, user *entities.User) (map[string]any, error) {
 	var teamName string
 	if user.TeamID() != nil {
-		team, err := s.teamRepo.FindByID(ctx.Request().Context(), *user.TeamID())
+		team, err := s.teamRepo.FindByID(ctx, *user.TeamID())
 		if err == nil && team != nil {
 			teamName = team.Name()
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{
-		"user": map[string]any{
-			"user_id":   string(user.ID()),
-			"username":  user.Username(),
-			"team_name": teamName,
-			"is_active": user.IsActive(),
-		},
-	})
+	openReviews, err := s.prRepo.CountOpenReviews(ctx, user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"user_id":             string(user.ID()),
+		"username":            user.Username(),
+		"team_name":           teamName,
+		"is_active":           user.IsActive(),
+		"open_reviews":        openReviews,
+		"max_open_reviews":    user.MaxOpenReviews(),
+		"out_of_office_until": user.OutOfOfficeUntil(),
+	}, nil
 }
 
 func (s *Server) GetUsersGetReview(ctx echo.Context) error {
@@ -93,8 +293,27 @@ func (s *Server) GetUsersGetReview(ctx echo.Context) error {
 		})
 	}
 
+	user, err := s.userRepo.FindByID(ctx.Request().Context(), entities.UserID(userID))
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	var maxOpenReviews *int
+	if user != nil {
+		maxOpenReviews = user.MaxOpenReviews()
+	}
+
+	openReviews := 0
 	pullRequests := make([]map[string]any, len(prs))
 	for i, pr := range prs {
+		if pr.Status() == entities.StatusOpen {
+			openReviews++
+		}
 		pullRequests[i] = map[string]any{
 			"pull_request_id":   string(pr.ID()),
 			"pull_request_name": pr.Name(),
@@ -104,7 +323,59 @@ func (s *Server) GetUsersGetReview(ctx echo.Context) error {
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]any{
-		"user_id":       userID,
-		"pull_requests": pullRequests,
+		"user_id":          userID,
+		"pull_requests":    pullRequests,
+		"open_reviews":     openReviews,
+		"max_open_reviews": maxOpenReviews,
 	})
 }
+
+// GetUsersDigest previews the daily digest a user would receive
+func (s *Server) GetUsersDigest(ctx echo.Context) error {
+	userID := ctx.QueryParam("user_id")
+	if userID == "" {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error": map[string]string{
+				"code":    "INVALID_REQUEST",
+				"message": "user_id is required",
+			},
+		})
+	}
+
+	format := digest.FormatMarkdown
+	if raw := ctx.QueryParam("format"); raw != "" {
+		var err error
+		if format, err = digest.ParseFormat(raw); err != nil {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error": map[string]string{
+					"code":    "INVALID_REQUEST",
+					"message": err.Error(),
+				},
+			})
+		}
+	}
+
+	body, err := s.digestService.Preview(
+		ctx.Request().Context(),
+		entities.UserID(userID),
+		format,
+	)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return ctx.JSON(http.StatusNotFound, map[string]any{
+				"error": map[string]string{
+					"code":    "NOT_FOUND",
+					"message": "user not found",
+				},
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{
+			"error": map[string]string{
+				"code":    "INTERNAL_ERROR",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	return ctx.Blob(http.StatusOK, format.ContentType(), []byte(body))
+}
@@ -19,14 +19,55 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for BulkChangeAction.
+const (
+	Create BulkChangeAction = "create"
+	Move   BulkChangeAction = "move"
+	Update BulkChangeAction = "update"
+)
+
+// Defines values for BulkChangeEntity.
+const (
+	BulkChangeEntityPullRequest BulkChangeEntity = "pull_request"
+	BulkChangeEntityTeam        BulkChangeEntity = "team"
+	BulkChangeEntityUser        BulkChangeEntity = "user"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ALREADYASSIGNED  ErrorResponseErrorCode = "ALREADY_ASSIGNED"
+	ATCAPACITY       ErrorResponseErrorCode = "AT_CAPACITY"
+	FORBIDDEN        ErrorResponseErrorCode = "FORBIDDEN"
+	INTERNALERROR    ErrorResponseErrorCode = "INTERNAL_ERROR"
+	INVALIDREQUEST   ErrorResponseErrorCode = "INVALID_REQUEST"
+	INVALIDREVIEWER  ErrorResponseErrorCode = "INVALID_REVIEWER"
+	NOCANDIDATE      ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED      ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND         ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS         ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED         ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED      ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS       ErrorResponseErrorCode = "TEAM_EXISTS"
+	TOOMANYREVIEWERS ErrorResponseErrorCode = "TOO_MANY_REVIEWERS"
+	UNAUTHORIZED     ErrorResponseErrorCode = "UNAUTHORIZED"
+)
+
+// Defines values for ExclusionReason.
+const (
+	AtCapacity  ExclusionReason = "at_capacity"
+	Author      ExclusionReason = "author"
+	Inactive    ExclusionReason = "inactive"
+	OutOfOffice ExclusionReason = "out_of_office"
+)
+
+// Defines values for NotificationPreferenceChannel.
+const (
+	Chat  NotificationPreferenceChannel = "chat"
+	Email NotificationPreferenceChannel = "email"
 )
 
 // Defines values for PullRequestStatus.
@@ -41,6 +82,91 @@ const (
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewVerdict.
+const (
+	ReviewVerdictAPPROVED         ReviewVerdict = "APPROVED"
+	ReviewVerdictCHANGESREQUESTED ReviewVerdict = "CHANGES_REQUESTED"
+)
+
+// Defines values for GetAdminExportParamsFormat.
+const (
+	Csv  GetAdminExportParamsFormat = "csv"
+	Json GetAdminExportParamsFormat = "json"
+	Yaml GetAdminExportParamsFormat = "yaml"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	MERGED GetPullRequestListParamsStatus = "MERGED"
+	OPEN   GetPullRequestListParamsStatus = "OPEN"
+)
+
+// Defines values for PostPullRequestVerdictJSONBodyVerdict.
+const (
+	PostPullRequestVerdictJSONBodyVerdictAPPROVED         PostPullRequestVerdictJSONBodyVerdict = "APPROVED"
+	PostPullRequestVerdictJSONBodyVerdictCHANGESREQUESTED PostPullRequestVerdictJSONBodyVerdict = "CHANGES_REQUESTED"
+)
+
+// Defines values for GetUsersDigestParamsFormat.
+const (
+	Html     GetUsersDigestParamsFormat = "html"
+	Markdown GetUsersDigestParamsFormat = "markdown"
+	Text     GetUsersDigestParamsFormat = "text"
+)
+
+// AssignmentPreview defines model for AssignmentPreview.
+type AssignmentPreview struct {
+	AuthorId string `json:"author_id"`
+
+	// Candidates Участники команды, из которых выбираются ревьюверы
+	Candidates []string    `json:"candidates"`
+	Excluded   []Exclusion `json:"excluded"`
+
+	// Reviewers Кого назначил бы `/pullRequest/create` с тем же зерном
+	Reviewers []string `json:"reviewers"`
+
+	// Seed Зерно выбора, его можно передать в запрос снова, пока не изменились кандидаты
+	Seed     string `json:"seed"`
+	TeamName string `json:"team_name"`
+}
+
+// BulkChange defines model for BulkChange.
+type BulkChange struct {
+	Action BulkChangeAction `json:"action"`
+	Detail string           `json:"detail"`
+	Entity BulkChangeEntity `json:"entity"`
+
+	// Id Имя команды, user_id или pull_request_id
+	Id string `json:"id"`
+}
+
+// BulkChangeAction defines model for BulkChange.Action.
+type BulkChangeAction string
+
+// BulkChangeEntity defines model for BulkChange.Entity.
+type BulkChangeEntity string
+
+// BulkData Команды с участниками и открытые PR. В CSV одна запись на строку, колонка `record`
+// (`team`, `member` или `pull_request`) определяет, какие из остальных колонок заполнены:
+// `record,team_name,user_id,username,is_active,pull_request_id,pull_request_name,author_id,reviewers`,
+// ревьюверы разделяются `;`.
+type BulkData struct {
+	PullRequests *[]struct {
+		AssignedReviewers *[]string `json:"assigned_reviewers,omitempty"`
+		AuthorId          string    `json:"author_id"`
+		PullRequestId     string    `json:"pull_request_id"`
+		PullRequestName   string    `json:"pull_request_name"`
+	} `json:"pull_requests,omitempty"`
+	Teams *[]struct {
+		Members []struct {
+			IsActive *bool  `json:"is_active,omitempty"`
+			UserId   string `json:"user_id"`
+			Username string `json:"username"`
+		} `json:"members"`
+		TeamName string `json:"team_name"`
+	} `json:"teams,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -52,6 +178,53 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// Exclusion defines model for Exclusion.
+type Exclusion struct {
+	// Reason `author` — автор PR, `inactive` — пользователь неактивен,
+	// `out_of_office` — пользователь отсутствует до `out_of_office_until`,
+	// `at_capacity` — у пользователя уже `max_open_reviews` открытых ревью.
+	Reason ExclusionReason `json:"reason"`
+	UserId string          `json:"user_id"`
+}
+
+// ExclusionReason `author` — автор PR, `inactive` — пользователь неактивен,
+// `out_of_office` — пользователь отсутствует до `out_of_office_until`,
+// `at_capacity` — у пользователя уже `max_open_reviews` открытых ревью.
+type ExclusionReason string
+
+// FilledPullRequest defines model for FilledPullRequest.
+type FilledPullRequest struct {
+	// Added Добавленные ревьюверы
+	Added []string    `json:"added"`
+	Pr    PullRequest `json:"pr"`
+}
+
+// NotificationPreference defines model for NotificationPreference.
+type NotificationPreference struct {
+	// Address URL вебхука или email, пустая строка - адрес по умолчанию
+	Address *string                       `json:"address,omitempty"`
+	Channel NotificationPreferenceChannel `json:"channel"`
+	Enabled *bool                         `json:"enabled,omitempty"`
+}
+
+// NotificationPreferenceChannel defines model for NotificationPreference.Channel.
+type NotificationPreferenceChannel string
+
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	// Channels Пустой список - канал по умолчанию
+	Channels []NotificationPreference `json:"channels"`
+	UserId   string                   `json:"user_id"`
+}
+
+// Percentiles Перцентили в секундах, null если выборка пуста
+type Percentiles struct {
+	P50Seconds *float32 `json:"p50_seconds"`
+	P90Seconds *float32 `json:"p90_seconds"`
+	P99Seconds *float32 `json:"p99_seconds"`
+	Samples    int      `json:"samples"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
@@ -67,6 +240,17 @@ type PullRequest struct {
 // PullRequestStatus defines model for PullRequest.Status.
 type PullRequestStatus string
 
+// PullRequestCounts defines model for PullRequestCounts.
+type PullRequestCounts struct {
+	AvgReviewersPerPr  float32 `json:"avg_reviewers_per_pr"`
+	MergedPullRequests int     `json:"merged_pull_requests"`
+	OpenPullRequests   int     `json:"open_pull_requests"`
+	TotalPullRequests  int     `json:"total_pull_requests"`
+
+	// TotalReviewers Число назначений ревьюверов
+	TotalReviewers int `json:"total_reviewers"`
+}
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
@@ -78,6 +262,62 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// Review defines model for Review.
+type Review struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// AssignedBy Кто назначил ревьювера: `user_id` токена, `token:ИМЯ` для токена без пользователя
+	// или `job:ИМЯ` для фоновой задачи. Нет, если неизвестно.
+	AssignedBy *string `json:"assigned_by,omitempty"`
+
+	// Seed Зерно случайного выбора ревьювера, нет у назначенных не случайно (например, импортом).
+	// При `ASSIGNMENT_ALLOW_SEED=true` заголовок `X-Assignment-Seed` с этим значением
+	// повторяет выбор, пока не изменились кандидаты: список кандидатов не сохраняется,
+	// и приход, уход, деактивация участника или достижение им лимита открытых ревью
+	// меняют выбор.
+	Seed      *string        `json:"seed,omitempty"`
+	UserId    string         `json:"user_id"`
+	Verdict   *ReviewVerdict `json:"verdict,omitempty"`
+	VerdictAt *time.Time     `json:"verdict_at,omitempty"`
+}
+
+// ReviewVerdict defines model for Review.Verdict.
+type ReviewVerdict string
+
+// ReviewerStats defines model for ReviewerStats.
+type ReviewerStats struct {
+	IsActive          bool `json:"is_active"`
+	MergedAssignments int  `json:"merged_assignments"`
+	OpenAssignments   int  `json:"open_assignments"`
+
+	// TeamName Пустая строка, если пользователь не состоит в команде
+	TeamName         string `json:"team_name"`
+	TotalAssignments int    `json:"total_assignments"`
+	UserId           string `json:"user_id"`
+	Username         string `json:"username"`
+}
+
+// SLABreakdown defines model for SLABreakdown.
+type SLABreakdown struct {
+	ByReviewer []struct {
+		P50Seconds *float32 `json:"p50_seconds"`
+		P90Seconds *float32 `json:"p90_seconds"`
+		P99Seconds *float32 `json:"p99_seconds"`
+		Samples    int      `json:"samples"`
+		UserId     string   `json:"user_id"`
+	} `json:"by_reviewer"`
+	ByTeam []struct {
+		P50Seconds *float32 `json:"p50_seconds"`
+		P90Seconds *float32 `json:"p90_seconds"`
+		P99Seconds *float32 `json:"p99_seconds"`
+		Samples    int      `json:"samples"`
+		TeamName   string   `json:"team_name"`
+	} `json:"by_team"`
+
+	// Overall Перцентили в секундах, null если выборка пуста
+	Overall Percentiles `json:"overall"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
@@ -93,10 +333,19 @@ type TeamMember struct {
 
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Сколько открытых PR пользователю можно назначить автоматически, null — без ограничения
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// OpenReviews Открытые PR, где пользователь назначен ревьювером
+	OpenReviews *int `json:"open_reviews,omitempty"`
+
+	// OutOfOfficeUntil До какого момента пользователь отсутствует и не назначается автоматически, null — на месте
+	OutOfOfficeUntil *time.Time `json:"out_of_office_until"`
+	TeamName         string     `json:"team_name"`
+	UserId           string     `json:"user_id"`
+	Username         string     `json:"username"`
 }
 
 // TeamNameQuery defines model for TeamNameQuery.
@@ -105,6 +354,34 @@ type TeamNameQuery = string
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// GetAdminExportParams defines parameters for GetAdminExport.
+type GetAdminExportParams struct {
+	Format *GetAdminExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAdminExportParamsFormat defines parameters for GetAdminExport.
+type GetAdminExportParamsFormat string
+
+// PostAdminImportParams defines parameters for PostAdminImport.
+type PostAdminImportParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	// TeamName Только PR авторов из этой команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// UserId Только PR, где пользователь автор или ревьювер
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// PostPullRequestAddReviewerJSONBody defines parameters for PostPullRequestAddReviewer.
+type PostPullRequestAddReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
 	AuthorId        string `json:"author_id"`
@@ -112,15 +389,66 @@ type PostPullRequestCreateJSONBody struct {
 	PullRequestName string `json:"pull_request_name"`
 }
 
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	Status *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestPreviewAssignmentJSONBody defines parameters for PostPullRequestPreviewAssignment.
+type PostPullRequestPreviewAssignmentJSONBody struct {
+	AuthorId string `json:"author_id"`
+
+	// Seed Зерно выбора, без него берётся случайное
+	Seed *string `json:"seed,omitempty"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestRemoveReviewerJSONBody defines parameters for PostPullRequestRemoveReviewer.
+type PostPullRequestRemoveReviewerJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestVerdictJSONBody defines parameters for PostPullRequestVerdict.
+type PostPullRequestVerdictJSONBody struct {
+	PullRequestId string                                `json:"pull_request_id"`
+	UserId        string                                `json:"user_id"`
+	Verdict       PostPullRequestVerdictJSONBodyVerdict `json:"verdict"`
+}
+
+// PostPullRequestVerdictJSONBodyVerdict defines parameters for PostPullRequestVerdict.
+type PostPullRequestVerdictJSONBodyVerdict string
+
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	TeamName string     `form:"team_name" json:"team_name"`
+	From     *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To       *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsReviewersParams defines parameters for GetStatsReviewers.
+type GetStatsReviewersParams struct {
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// GetStatsSlaParams defines parameters for GetStatsSla.
+type GetStatsSlaParams struct {
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To   *time.Time `form:"to,omitempty" json:"to,omitempty"`
 }
 
 // GetTeamGetParams defines parameters for GetTeamGet.
@@ -129,6 +457,29 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PostTeamSetReviewSLAJSONBody defines parameters for PostTeamSetReviewSLA.
+type PostTeamSetReviewSLAJSONBody struct {
+	ReassignAfterSeconds *int   `json:"reassign_after_seconds"`
+	RemindAfterSeconds   *int   `json:"remind_after_seconds"`
+	TeamName             string `json:"team_name"`
+}
+
+// GetUsersDigestParams defines parameters for GetUsersDigest.
+type GetUsersDigestParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery                 `form:"user_id" json:"user_id"`
+	Format *GetUsersDigestParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetUsersDigestParamsFormat defines parameters for GetUsersDigest.
+type GetUsersDigestParamsFormat string
+
+// GetUsersGetNotificationPreferencesParams defines parameters for GetUsersGetNotificationPreferences.
+type GetUsersGetNotificationPreferencesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
@@ -141,44 +492,140 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersSetOutOfOfficeJSONBody defines parameters for PostUsersSetOutOfOffice.
+type PostUsersSetOutOfOfficeJSONBody struct {
+	Until  *time.Time `json:"until"`
+	UserId string     `json:"user_id"`
+}
+
+// PostAdminImportJSONRequestBody defines body for PostAdminImport for application/json ContentType.
+type PostAdminImportJSONRequestBody = BulkData
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody PostPullRequestAddReviewerJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestPreviewAssignmentJSONRequestBody defines body for PostPullRequestPreviewAssignment for application/json ContentType.
+type PostPullRequestPreviewAssignmentJSONRequestBody PostPullRequestPreviewAssignmentJSONBody
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody PostPullRequestRemoveReviewerJSONBody
+
+// PostPullRequestVerdictJSONRequestBody defines body for PostPullRequestVerdict for application/json ContentType.
+type PostPullRequestVerdictJSONRequestBody PostPullRequestVerdictJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamSetReviewSLAJSONRequestBody defines body for PostTeamSetReviewSLA for application/json ContentType.
+type PostTeamSetReviewSLAJSONRequestBody PostTeamSetReviewSLAJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersSetNotificationPreferencesJSONRequestBody defines body for PostUsersSetNotificationPreferences for application/json ContentType.
+type PostUsersSetNotificationPreferencesJSONRequestBody = NotificationPreferences
+
+// PostUsersSetOutOfOfficeJSONRequestBody defines body for PostUsersSetOutOfOffice for application/json ContentType.
+type PostUsersSetOutOfOfficeJSONRequestBody PostUsersSetOutOfOfficeJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Выгрузка команд, пользователей и открытых PR
+	// (GET /admin/export)
+	GetAdminExport(ctx echo.Context, params GetAdminExportParams) error
+	// Массовый импорт команд, пользователей и открытых PR
+	// (POST /admin/import)
+	PostAdminImport(ctx echo.Context, params PostAdminImportParams) error
+	// Поток событий PR (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(ctx echo.Context, params GetEventsStreamParams) error
+	// Назначить выбранного ревьювера в дополнение к текущим
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(ctx echo.Context) error
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(ctx echo.Context) error
+	// Дополнить ревьюверами открытые PR, у которых их меньше положенного
+	// (POST /pullRequest/fillReviewers)
+	PostPullRequestFillReviewers(ctx echo.Context) error
+	// Список PR организации, новые первыми
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx echo.Context, params GetPullRequestListParams) error
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(ctx echo.Context) error
+	// Показать, кто будет назначен ревьюверами PR автора, ничего не создавая
+	// (POST /pullRequest/previewAssignment)
+	PostPullRequestPreviewAssignment(ctx echo.Context) error
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(ctx echo.Context) error
+	// Снять ревьювера без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(ctx echo.Context) error
+	// Записать вердикт ревьювера
+	// (POST /pullRequest/verdict)
+	PostPullRequestVerdict(ctx echo.Context) error
+	// Равномерность распределения ревью в команде
+	// (GET /stats/fairness)
+	GetStatsFairness(ctx echo.Context, params GetStatsFairnessParams) error
+	// Число PR по статусам
+	// (GET /stats/pullRequests)
+	GetStatsPullRequests(ctx echo.Context) error
+	// Число назначений по ревьюверам
+	// (GET /stats/reviewers)
+	GetStatsReviewers(ctx echo.Context, params GetStatsReviewersParams) error
+	// Перцентили времени ревью
+	// (GET /stats/sla)
+	GetStatsSla(ctx echo.Context, params GetStatsSlaParams) error
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(ctx echo.Context) error
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
+	// Задать SLA ревью для команды
+	// (POST /team/setReviewSLA)
+	PostTeamSetReviewSLA(ctx echo.Context) error
+	// Предпросмотр ежедневного дайджеста
+	// (GET /users/digest)
+	GetUsersDigest(ctx echo.Context, params GetUsersDigestParams) error
+	// Каналы уведомлений пользователя
+	// (GET /users/getNotificationPreferences)
+	GetUsersGetNotificationPreferences(ctx echo.Context, params GetUsersGetNotificationPreferencesParams) error
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(ctx echo.Context) error
+	// Ограничить число открытых PR, на которые пользователь назначается автоматически
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx echo.Context) error
+	// Задать каналы уведомлений
+	// (POST /users/setNotificationPreferences)
+	PostUsersSetNotificationPreferences(ctx echo.Context) error
+	// Отметить отсутствие пользователя до указанного момента
+	// (POST /users/setOutOfOffice)
+	PostUsersSetOutOfOffice(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -186,37 +633,279 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAdminExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminExport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminExportParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminExport(ctx, params)
+	return err
+}
+
+// PostAdminImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminImport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminImportParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminImport(ctx, params)
+	return err
+}
+
+// GetEventsStream converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEventsStream(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEventsStream(ctx, params)
+	return err
+}
+
+// PostPullRequestAddReviewer converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestAddReviewer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestAddReviewer(ctx)
+	return err
+}
+
 // PostPullRequestCreate converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostPullRequestCreate(ctx)
 	return err
 }
 
+// PostPullRequestFillReviewers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestFillReviewers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestFillReviewers(ctx)
+	return err
+}
+
+// GetPullRequestList converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestList(ctx, params)
+	return err
+}
+
 // PostPullRequestMerge converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostPullRequestMerge(ctx)
 	return err
 }
 
+// PostPullRequestPreviewAssignment converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestPreviewAssignment(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestPreviewAssignment(ctx)
+	return err
+}
+
 // PostPullRequestReassign converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostPullRequestReassign(ctx)
 	return err
 }
 
+// PostPullRequestRemoveReviewer converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestRemoveReviewer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestRemoveReviewer(ctx)
+	return err
+}
+
+// PostPullRequestVerdict converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestVerdict(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestVerdict(ctx)
+	return err
+}
+
+// GetStatsFairness converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsFairness(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsFairness(ctx, params)
+	return err
+}
+
+// GetStatsPullRequests converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsPullRequests(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsPullRequests(ctx)
+	return err
+}
+
+// GetStatsReviewers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsReviewers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReviewersParams
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsReviewers(ctx, params)
+	return err
+}
+
+// GetStatsSla converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsSla(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsSlaParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsSla(ctx, params)
+	return err
+}
+
 // PostTeamAdd converts echo context to params.
 func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostTeamAdd(ctx)
 	return err
@@ -226,6 +915,8 @@ func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTeamGetParams
 	// ------------- Required query parameter "team_name" -------------
@@ -240,10 +931,70 @@ func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	return err
 }
 
+// PostTeamSetReviewSLA converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSetReviewSLA(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSetReviewSLA(ctx)
+	return err
+}
+
+// GetUsersDigest converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersDigest(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersDigestParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersDigest(ctx, params)
+	return err
+}
+
+// GetUsersGetNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersGetNotificationPreferences(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetNotificationPreferencesParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersGetNotificationPreferences(ctx, params)
+	return err
+}
+
 // GetUsersGetReview converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetUsersGetReviewParams
 	// ------------- Required query parameter "user_id" -------------
@@ -262,11 +1013,46 @@ func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostUsersSetIsActive(ctx)
 	return err
 }
 
+// PostUsersSetMaxOpenReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetMaxOpenReviews(ctx)
+	return err
+}
+
+// PostUsersSetNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetNotificationPreferences(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetNotificationPreferences(ctx)
+	return err
+}
+
+// PostUsersSetOutOfOffice converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetOutOfOffice(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetOutOfOffice(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -295,55 +1081,178 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/admin/export", wrapper.GetAdminExport)
+	router.POST(baseURL+"/admin/import", wrapper.PostAdminImport)
+	router.GET(baseURL+"/events/stream", wrapper.GetEventsStream)
+	router.POST(baseURL+"/pullRequest/addReviewer", wrapper.PostPullRequestAddReviewer)
 	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.POST(baseURL+"/pullRequest/fillReviewers", wrapper.PostPullRequestFillReviewers)
+	router.GET(baseURL+"/pullRequest/list", wrapper.GetPullRequestList)
 	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(baseURL+"/pullRequest/previewAssignment", wrapper.PostPullRequestPreviewAssignment)
 	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(baseURL+"/pullRequest/removeReviewer", wrapper.PostPullRequestRemoveReviewer)
+	router.POST(baseURL+"/pullRequest/verdict", wrapper.PostPullRequestVerdict)
+	router.GET(baseURL+"/stats/fairness", wrapper.GetStatsFairness)
+	router.GET(baseURL+"/stats/pullRequests", wrapper.GetStatsPullRequests)
+	router.GET(baseURL+"/stats/reviewers", wrapper.GetStatsReviewers)
+	router.GET(baseURL+"/stats/sla", wrapper.GetStatsSla)
 	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
 	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
+	router.POST(baseURL+"/team/setReviewSLA", wrapper.PostTeamSetReviewSLA)
+	router.GET(baseURL+"/users/digest", wrapper.GetUsersDigest)
+	router.GET(baseURL+"/users/getNotificationPreferences", wrapper.GetUsersGetNotificationPreferences)
 	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(baseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	router.POST(baseURL+"/users/setNotificationPreferences", wrapper.PostUsersSetNotificationPreferences)
+	router.POST(baseURL+"/users/setOutOfOffice", wrapper.PostUsersSetOutOfOffice)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xabW8bxxH+K4ttgTgALVK0XaD8xtiKqg+WWYoGigqCsOKtpEvuzXd7agSDgF7Suq2M",
-	"qvlWBEiMwH+ApsWK1gv1F2b/UTG7R94deTxRpiwX+WJTx73d2dlnnnlmli9p07U91+GOCGjlJfWYz2wu",
-	"uK/+anBmLzOb/zHk/i4+MHjQ9E1PmK5DKxTewiX04AzacC5fwyX0oUugBxfymMAZ9OEC2nAJJ/KIFqiJ",
-	"b7xQExWow2xOK1RwZq+rzwXq8xeh6XODVoQf8gINmtvcZrio2PVwcCB809mirVaBPg+4v2RMsuo/cAJd",
-	"uJQH0JPfa/vkAfTlHoEr6CtTT6EPHfW4C+fyeIJ5YcD9ddO4kXGtwZfKgQu+7/p1HniuE3B8wL9jtmfp",
-	"j/gdfmi6Bk6x/Kyx/vWz58tPaIHaPAjYFj71eeCGfpMTxxVk0w0dQ3nA812P+8LkQWqq9GM98UvKndCm",
-	"lVXaWKg+XV/409JKY4UWaK2e+vx0ob64gGujHdWVlaXF5ejP9cfV5SdLT6qNhehbbeVaYXTzCbuzTi12",
-	"4qo2LR4fz+VufMObYmy83uH4sAKthZZV5y9CHohxD7AgMLccbqz7fMfkf4lgncZLdMoELqENp/ivfIX4",
-	"gUt5JP9K5B50oSNfy39BB7pyD5FD7pXm5spfImwEt4OM7Q4NZb7PdvFvFoptV8Epa3TT50xwo6r2sOn6",
-	"NhO0Qg0m+H1hqvhwQstiGwgdDcEM3/tbs83ghZa17mtfTjI0NUbHScaoQDARBknsPastLNMCjVA2jp2R",
-	"8x41JWvhpE+HSxayzvwa3Kxsu34WeHJP7NfgrCy/IOeP+8Lm9kYUPUPI/9bnm7RCf1OMU0gx4r4izvJU",
-	"vZMVCzHvX0sUyRQxMGKS2dGCY8abwTprCnMnudyG61qcOfjqgOazzga/m87QOFkM3ykkVs6yGdPYja3N",
-	"890n3UvyJPL2hZOZzqarljEF8g2t1Uk9ikZSVfFpc0eQFe7vmE1O7jV4IEiDBd8WyNfMski5VH6E9LrD",
-	"/UCz9Pxcaa6Eu3A97jDPpBX6YK409wDBzsS28lzRi2O6qBlVudfVqQGdzJD0lww0yQ1EggMe6+HaDzwQ",
-	"X7nGrs6hjuCOep95nmU21QzFbwK0KpXPE3RBw3mawRDU8+/Pl0rzmQFaoVXDIAFnfnObtpIS43Ow0owM",
-	"k42KtIpSD7QyUhsrl+Zv5nDPn5TiV2lYRvA+QENu81xistYc3co5KG1eHkkmpUurlemytFSp1Ynchz6c",
-	"wgkqazzMh6WHU3gttjHPnrRazVgf/g0dLaWLSX0PbRRPXa2gPkTq+0hb9/ubnemoKE6K1FgU1+rENAiz",
-	"fM6MXcK/MwMRjJzFTPtEPx/Cf6FL5L48lP+ArtyXB9CRh9CVB3ql0LYZlh8UfhmciDyQr0mtTqBHoK09",
-	"hS5ShcgrnAPO8Kukzuypd+AE+qScLTWhB6cj1dRwdrkHbWRmtqVAn8BTQNfQyhQjKoE4NSE+VaNn4MPJ",
-	"YZYXNNfy1zXM9HHMU7ob5oklOsUMd3++dL/8sDFfrjx4WHn0uz/fGjdFwvHu2Qk6iqBUtPTlsWoP9MjA",
-	"nDtmK7RnlJZGY/eNiquuCtFB9J5BG84io8k96Kk3L+AK+qpfcCkPVPAeE+jDlYrTtvwb9OTxl9PHos81",
-	"eqYOx/rghRki0rVirEaoLOdiLgc/OFee2Jw5kAupJT5/WKPSDB99ckGBe/As1uTG+gYiNHxEby+KRybP",
-	"6YT0oQN9eA/98aSkMk7+Ufo0vdLaFOwBb9Ts3bE2TA9DuCOPdPMOIxrt+yxs0oNzTODZXcTXWWxzQwkU",
-	"1dqYJZTpMVH9pNeAU+SdC0VDx1o7IC/twzl0ybCFt8OscJKcGg6K5VSTOY4ryICTiOsQbQOp1bUrHPcx",
-	"cwzTiCqqtF3yQAkYJH15CFdR30zRKIrDnpZG6Ks800b6jLF1jkt0rUkiSKnSsTmwh5gOwdJ0YKioRvE7",
-	"Yuib3EN7J4/gfKwFmKXILvI3keqdJtu4UfVrBqqTOyAZIlwits0g8vTtSVj4CdpyTx7Kv8dBdKKT3bC1",
-	"CVcYztBBXJMolWXEnzwez5rjQyMli0L1Es7wa5UnJ5GI8jWBE7QRh6hhWut29efRC4SczIrnX2SGkZ9N",
-	"G5zZVcOYJYMO22CrqT6N7qcO06HOCnG7hVYts8lpq5D/Ujn90lfuBm2tpRo+1GO7trqqmRoojWFo3HL1",
-	"LaI+4ed2yQZrfsujW5FJaXJg6xSOmiZT/ZgqfZMVObQ15Zdmq3rTFzUxiwz3/Qlr39HdfWwdnIrfQyL3",
-	"iTyUr6CtZhhcG15Aj9yLHSh/kAdF6MO7SIGcy2OdXjIzLnThQ1Jy4wmmGGGLK+dH/6UJYZErPljkQnUQ",
-	"41vP1Wz/xUOK6VtRxONssvP/JoJuzikj0PkZ3sl/QhfO8MzS/H3nraof8/tTGKnjpeC5wmjvRgCegEB0",
-	"eoAQ1I3vPCA+x6GLw5E3xWPyNnx2NCYLFr38J6131kbQOmVvaPqbqLF7voz7qMmF7MSLkbQxUxU4v8AV",
-	"9BTZnZFa/Qvd3Zv0i4RrwFmrfyGPCgTeI5pzK5KpBO0AwAqJKQAHXCwF1eF91GR1pV5dSYyeQWYlCG2T",
-	"WQGfHiMffdU38aCvu+q65R5EGN0Jjrsgi7KvpfocVw1WygsedUM5nSj6OZG1f9AlBnyYiMy7zwdvpi/a",
-	"06H3VhF+O9qcDj/5PZxDG94TaGOygx508Hs1spf3M6OxQGsNn70c/OxIZxFM7NEDPTjxIFUCJZ7/gTNL",
-	"bNPWWut/AQAA//80B9xs2CUAAA==",
+	"H4sIAAAAAAAC/+x9a2/cxvnvVxnwHKA2QEkr2U7iPfi/2NhKKsC21JWSJrWEXXp3JG2yS25JrmvBMGBL",
+	"dZMcp3YdBEgRNHHSFjgvjyxr47Uu668w/Arnkxw8z8yQQ3LI5eriS/8B2tjmknN95vfcn7ltNJxO17Gp",
+	"7XtG+bbRtVyrQ33q4r+WqNW5ZnXo73rU3YAHTeo13FbXbzm2UTbYv9ghG7A9ts32g6/ZIRuyPmEDdhA8",
+	"ImyPDdkB22aHbDd4YJhGC774IzZkGrbVoUbZ8KnVqeHfTcOlf+y1XNo0yr7bo6bhNdZpx4JO/Y0uvOz5",
+	"bsteM+7cMY2PPOrONbNG9Xe2y/rsMNhkg+DPfHzBJhsGdwl7yYY41OdsyHbwcZ/tB48yhtfzqFtrNcca",
+	"3B35Iy5gxfNaa3aH2v6CS2+26J9wjV2nS12/RfEVq+evO9hNujXTaFh2s9W0fOppJvqv4Au2HdwLNsU2",
+	"DBKrbsJmPMeHfAGCB8F9wnaCB+wpGwR32XbwMNgM7gWPSHCX9dlO8HXwkO2wPrwJa+LTjqcdlnhgua61",
+	"Af+mtxrtXpPiHMKv/qdLV42y8T+mIhKbEmszNQsfeDAPTWt8qairm/P3bMiesSFhh2ybPYf/Bl+wAdsn",
+	"7GnwgNSnur12u0r/2KOeP9VwqeXTOgnuEdzpA8J+AQp9DjNEcj0Ya5YepU3NkL6TzcmlHcLSmoT1+UgP",
+	"2JD9wn9/ia/22S7QXvA1YTswmm32MrjLhjDMezisHfwciHWPbcNU8Vg9ZwdA1zBbNgjuwed7fKvZQLQI",
+	"u0ZvWZ1uGwY+PfPuOxffOzfz7vlzpYul8++euzBTMkzNPMNjqD1tEfFfV6jVjJ1ehU4ValC3UizfSti/",
+	"c+Mz2vCh//d77c8vrVv2GtUcjwZf5tsGtXsdGALfVcM0et0m/0vHuUmVhqOJNalvtdr4cbgoLa8Gbd6k",
+	"ZQJnmUws90qlc5SsWm2P6laH2n7L31BHABOH/j3qGqYBFFdzOclpR9FqakEqjZImEYhD+B4TtWmx5rm7",
+	"I4ZqylXDvsNlyFr6y5ZvZZy0cGh4hrYSeLPNDgBzBgTxZQ/xZTN4wPpkoTpJ2Dfk0uLHhA3ZLhxTQeiS",
+	"cuEJNAWEz/aCLZOvxT4bskOk+rpLG47brC/bZ+qw4HWT1Du0c4O6dbk8dXV96mehq5fieCGss36wafJD",
+	"sscG4hDBYGEKgmdxSIy6HrI9OVJ4dIic5EF52RbjMUOqN8Vm4Z/4ICQtM7Fx8X/ju+FBMsMjUjeX7TQO",
+	"AzQD0ok5hYhd/1/1yWXY4vh5UXvyYnCcOFfImWizFgPb4liYz7aShDvynWLokz4P6UbUoekoPjkR2M+8",
+	"heI0l/dGuO38EK1avbYvBQXR2w3HaVMLmZ0UKnRLIilp9EpEokn4TdHJFlxrFd3lGozu4o7mjVnXddwq",
+	"9bqO7dEYGN82KPwGf2k4Tfjq2vxS7YP5j65dxl49zwKeYLjUc3pugxLb8cmq07Ob2FN8G8Km4o95wxF4",
+	"L81WrtZmP5lbXFo0TGOhGvv71dnqh7PQN4yjsrg49+E18c/apcq1y3OXK0uzhmlUlmqXKguVS3NLn8K/",
+	"rlRnK5c/Vd9fmp+vXa1c+7RWnf14bvb3s1Vof+7ax5Urc5fDZ4YZm2708+8+ml1cMkzjo2uVj5Z+O1+d",
+	"+wM2+sF89f25y5dnrxmmUa0szdauzF2dW5rl3y7NVq9VrtRmq9X5qpYLhas5auNxwaL303ueeJ+vu440",
+	"IiEvtSsutTzHTnOdOj+8dfL/7n5L2DbbEZL7QtUk9ZbNz5n4VS/MI2/pA+SDAgAoyg7NZbvu9Pyas1pz",
+	"VldbjZEtDBFkt/C/m2wn2AJWQtguG5J4Q7We7bfagNx1y681rK7VaPkbvPlgK1PhIMEWiqL1jnWr5nSp",
+	"LWDYqyd4aXBfEc053ktC5iuFqouAH9OIjQ2gMBqTliiywSgTb8TO6fb7g1a7TZsLkQSuYTvNplaG/pYN",
+	"2VPYb7YPGwY8l/WPq5V03VFqiDrWFLOBteXj1U32muO3VlsNC2aw4NJV6lK7QbUzdqmnUWU+ql4hSJ5P",
+	"g/vBFpf0uVxDO1arDQpAsMVFleCRIiuxbTIBR2MXVie4hzQG9HSApPYFSmyD4KFOlG2sW7ZN2zFpet3y",
+	"gag6cQlRFX+tG23aLMDbkjgiOiu+eBreKhrRLB97IlZnyF6A3oSSJYpvE1Iv2mb7OatTSE/N2GUNsR3h",
+	"KIWT0y3RAnUbIM+3tar/EzwOf5F2DiQbtgPr0AdpGpXC7eC+Sexeu02ATsQroYKK9BZSWFqOvFCqebTh",
+	"2E38JzQDdJDYe7sHggGetYvjvn9xrPc9FBnUc9+yfbpG3dT6yjfN2BziI4z3r13+XBTTCs/xLQpVOdVQ",
+	"IaAthuoc2oA/kDOlycmZs2Nh3AjzEerKzQrOYdVxO5ZvlA1Qmyf8Fop2GQuvyg3u2vFaOCllwDQ83/J7",
+	"ngpf8wsoDgm5bcU8YQUi7NLU7fkIurnk9KRVNU49N9eiRmpd6tY4o0rRPF/6WkqlSx4A00ARosB7vuNb",
+	"7eIv5pA3+z+It/tsmKbwAXuhpW/DTPWUVDo049POLmNx0gM39cs9YusW1x3XH9dW+59A57p1qWZZruWJ",
+	"sLLBITW18KMbOtP998FmkqDAtpsipu0yqQuArYNtF8QioLxtk9R953Nql9nf2T/Y/62DzI7ytvIOYU9Z",
+	"nz3PFM6XbWlf+sy5kWroz8JMtIOCB5qKdvlAJwn7QZicQn4LqghYbmHY3HA2FFJ8aJDk4220dKs10uIM",
+	"/XC7HHsBD7jFWTFDa9bOxGEFm6ig6LkTvJBqm5zBt8HENgBTdHAXHQwHsI7BXVzhg7OTyzZ7Am+QOteH",
+	"r85eW6pVrlyZ/31tcXb28n8Bk6jzdXsmrG47KLbVP5mI3CUTi5Q2ueX+ryjgHJAExPTZwbKNWyh0RG7v",
+	"UyZ/RBN6OS5NJl9ARi3XZxjchxVlh7xzMMyZQD6Er1JwH6yfJlhOxd/Ybkw33Q7+wgaoDiZNq1IXAJ0T",
+	"nw9AYeQzJ3w99uEPNgDxLU9rXLb5pLntUFmfJCEWdRfkWbBuUrfZavgqdFUWFqrzH6OZ4tJvK9c+nF2U",
+	"Jg4tkoVtjAErmRK2ClHZ0EbdRd/yR1n20qY8wYKskGrzuPPIt2K2uQxdJ6kJqlCTZw7htMq1pQESQcz1",
+	"wPpatxCy0pHjPjWDZtzDFO2FbmSaRdbuj44IFq9U3nep9XnT+ZPGUnVjI5QeYjZgq92eXzXK10cYGBQt",
+	"7o6ZbHtsnTE9/BWNTnBjowYrd+LDPZL1uNiQnZvUtdptozzG+BJdyiai+ZuxzdNt/ZJYptEm/7xRQStX",
+	"qZTaX4HNXelwXMw6xdMa9awbM8RsjI+wCfOoBhp/5s674Gv4M80HF6pZyPgw5pmPiZzcNy+szwCSGEvy",
+	"BWLtHhsIqwqakIUoOWTPuCDA38M/Iayk07JbHeCDpUxFOckosqf6Y8rLahL2DOA7F/1jAp5OMTswtINJ",
+	"27n1hlvpYR1G4Q4HwjC1Pb6NfSCENXXc21K4KrgpKOQfCJEbeNvRDBd5J/c18D2tJ8ajjZ7b8jcWAYsE",
+	"x6KWS91Kz19P71dlYW4i0oVMLg2iFZDtqcusCgeg59Q5VU4IgcolqLUQEWEDQv9PsM9sUCZ1q9lp2XUy",
+	"EWuFRyMF98nilQrJFFdgG+s3HB++Rvkb/N7bXOo1CfJz8TGPo0lbHvBULNsxER4wYECEAAVEw+VplJ/C",
+	"mIIJEnwRbIatBPdQLRnERGmU30Eb2gVhnDdo8oE+hVOJlg99VwPQC/iBA1ViD44wN05jhwewhN+ybdX1",
+	"EXr9eQyCeBu0umdiTZ5LBYIdlElsW7mSthM8Ys/DRl8Qtqf9HlYd+3uK8LnNlcNNFVa5ysNewCwS8ROs",
+	"z6lICSnTj/JhUutD8gC9b95dm5i7XCdnMqz1pD5dP8vVFeS5yCWQyiPkWvf9Lo+/a9mrDh68lo96zUKV",
+	"SDmfRBomWaTuzVaDkjNL1PPJkuV9bpIPrHabzJRmLoAR9iZ1ufPSmJ4sTZYkQFvdllE2zk2WJs8ZptG1",
+	"/HU8dVNI9lP0VleYjtaor8HLn4BfBFuweJxGYlxIcJNIUZXmNF3kWF102epAl3AI6xzn/mvD6rTrBAn4",
+	"OdvBXflKbGtdRvzA7+TTytUrIjxugCfygNStbrctPB5T2BBfeODa+HCuaZSND6lfgc5n+XTNWODo9dva",
+	"cEo+OEONngw9SsZnnqM6N8U/oX/DNBreTZ3NawWglIcV4BbMlErc42/71MbFV+fymXA4R73nyXRymXhI",
+	"A73lT8EgYp9rQj9TzDE60PFQvy+5sQVXHExKwV3B0fpAZudPcCLx6AvdKH9I2qgQK9RRcU7T63QsCLg1",
+	"2DfBA5B3gi32nNsqFKA3s6C9z7FRI6HBIbbWPDQWAFEZK9BfjLpRcnQ8XysACjYRPIbTdIiHRGBU8DD4",
+	"iltMYqwohwEBxhF0RnMj3740K4mw5gFRzDeHvJ/ga4KSzFdiAXeCLd5xcF8Yg8Th3cFgOFTABxiJS7jj",
+	"NwTpreBhfKhbk8C9IlYYBezeS377AKPx0uY+eGzKgAP9OFl/2V6oCsFLMRZBT2jV5EKtiLvtQ2O7bFuu",
+	"jXjrG3A+prAL1iy0GKrt4oHgsYHowd0U8vNzXF/kSsATfyb1prtRc3u2sBwm+JIG4uLmuxSW6uBswfE4",
+	"ns11iuOZGJce0DCcVOMjX+HiH/X8953mxunAVRLB483Ej4/8UHIDrXGtMP7F4+TvHBOf06EAa7S4Vq5E",
+	"FWu0crl5GrUzIaFH2yxHoBHE06j69+Q5MOMH4XHEGQZc8A3uhbReRwz6hducwdQNL756zvBvFHb3xaHn",
+	"EimIunhSQws152gcEYcYlIIqNJzDbXbAYxKehvMkQqJHwEoyln+gEfweGvVRYFXcC6fEZehNvii+K2xB",
+	"azSXy0Rahs7x2TfzlRM2kFrMDofqKHXmgCCO9aWLAtGPDZGdDUL46guU5jB5N3jAdjjsoe9BbBK0Pn0h",
+	"Fg0S80iwQbScoaoOP4OmVybdlr2GOt33sGH8dzOWRsJe8GGg4viS9eUgCN96LqMKgh/G9cuYuhQ8gm7+",
+	"KRWXSBtDtXOfuzwURid4rXC+hS7ADAl1Frd2ke9sCtITG/xPha0sVENDg4jMwMhxdEUN2Qu5dMWTm3KS",
+	"mUYMpICFJxypPGFJGWBkelP28EaL2Mgb8BBNRGcocirhL2XSdSelL2bZblq+VSa3l7G3ZaO8bKg/G+Zy",
+	"0n8t3pmYLpWm07/DdPCNSrNJPGq5jXV8KXRv4489/mW4K/jwhtX4nNq8zzBGYNkoX182ejP4tHdu2ViB",
+	"xsLhlcPfLB//BfriRGl6ojS9NF0ql+B/f1g27izbhjmWtvCEny+2l7AocNw//wpxX0n9CJ2nh8LwgbiW",
+	"xO2socNJOgOKNnUnFkHp5kfyrILF/IkAYzV7y2o2q4rjJUP6/ylO6nHg4AMJvo7J7MB30g5XxN+EkhBB",
+	"gPCZx1HhABlNP/gqeKyxWcooVrTsoGyM8rM27ot//ZQfcNANYRognd/PElaVWJWKsk7jCpdKJH4qekUe",
+	"OEMxdRq988YdM1NEKxIBU9jnlY5hyfaCnbbsOWYkscx7Ky6s8h3U5lOkYpJl24XE0NT5SNDo6zE37KEY",
+	"AUdhLzQ4qImQrxzyFqqSeeb70VUY5IO8WPyYCUesS63mRkXwE3h002r3tNkwmswSNSlG2DRbHhGNEsml",
+	"iO8Qf73lgdgrbKI3rXYrBqiZvWryVKJeex7vETJxWjbx1ynhfPY3HkGnK3bnO85Vy97I7UabIxN11HXL",
+	"xHcc0rHsDRLyZmj+jnmSu75DeFCdmYJmaTNJHhlTD+Ly7V2gmoLUBL/uRjJ5kq3+kHZKoqVdmEpkvFfa",
+	"5gPTQk6oZDEKOxhPhd5DM9CAHSicWIEwHT8WebcKK85lS5dkmu6ROZISbWn0pg0zl0VpAisVeTCPab2l",
+	"mYxFWN70mCKAmxXcft3ozQADPmesqKM6/r5Esa48xPVOnnQxdjrPSPYYs62yw1fOdtjfpDw5xfbyhe7g",
+	"wdj8Roe6asJjBLYLVdJqhlyE3moBBJws0GYYoMEkkIS9yKIPkMe9t9mOf23sBmTqzWQI27ImRoaoXxwR",
+	"V1vwdyVEvpiOEjwQMJ6swQFO1T2sUSGz1oXVJukLH6BhILgnPIKDhH4T3NfpNzt5kwYl5d8yspmLYy/Z",
+	"Pu+Orxuyiz0iPNLClIMGEWno42EXMO5fkPccEOH77kPrSpUM2ABdtC8a7UOGyWkDV0bEXittoytCYXDg",
+	"iWAH4oeFagG16YPY5h1JW1CO2SqmPqJdSaQ4Xhdo+UaA6koOrMqhF9RU0kmeo5QW0cFKITwODbtAwBDB",
+	"8CUPx9Cc5CRsfBuRg4ABvTNMV67CxGD4RKEc9NFx+6jQyl+K2IVfQvftMzYsDhjtlpcTGqAa/gTF81gY",
+	"CKbnQTVm6mATtsMGwqIrY1aUefM4ARDavak16nOCr0/qrKXK2K/AOAv5wML0qIi6CqesrJysip5Z+GIM",
+	"tT1f+Y71UIyc3xhvvohIAkt8mt3GQ6UyIoRC3tCXLo4d7nMuTv/o+iisQVzFt0/FpHUsG9YIUf70rFMn",
+	"IKpH2ZzcbD1dmpg5vzQ9Uz53vnzhnT+cGN8Rp/7Vi/M8CVrkOgSPhLgkh/MarEppq1HKdI6hqpuCZy1U",
+	"uZyzJwZNziC+94UjdFO46w4xF4QNxVEUcYBni5/FLqeZSAjLEWC/kSlDILvtYpyNVljd0RdgmySKi0+X",
+	"6sQj4PrBJgZuHsA00Y+MPFyN9wg5HNYDSTBHMoGLtx88ZE85Z06KuKNkwoXUmpyc+eLIFohxq86JGMJD",
+	"GbDzFF4MHgtROpUw2B+ZTnVcK0TpmGYftQZihG6m0btgrCjF5kBEkWVlouIoiutiGlNawleUsilx/8ZK",
+	"rALhdejHhG5X5F5kZspF8eLSs2gU1qHTpSL1nrlEtqLJtaY9IPvgi1hUScJYmFVR5VcHwBEsMWkMVyIU",
+	"ABB5IvNTjJTr87jEEXkYXDeJhx5wv2My+i4yBoBF+VFxzAfaByrLgfrHiB51m/6pFiZY48YcyORpxXTB",
+	"+hmocqK2CQznF2HyMqSEE++jaABF2JHiUa4X4AZVuVrHYAJOOxLOBMLM5ApZOWxC2RMto4C+8n4/tmQb",
+	"7+L1y7kREzhV6wnModu2GqJoAXR5cmJtovGcKjI8T17v7xnJw4XjOOqpkPP4SV4kG2YOKe6l4X+y09a/",
+	"JMuolW+ryC+CnmMQx8PNE3xaTeR/hmNO5TaaOY7gWMHByG5Pb1J3g4h9xayWUFhC7yy1Ougehudi/Fnu",
+	"4GhSCs7oaulFxRTyQDvcmfCZYR7T5RwKbDgFrskmtuMHvu3sOehGMuAcHQKgO0GifJ+ENR4zR6MWgoyG",
+	"0bBscHrzIODII02cVWLxoM5m6G63nUtyH9IjDDZDYgi22MuoBm2qzkTeIBMlKaNx2g7h65RPF3KgvhqJ",
+	"kJBpsk/U0+ABjwsulFyaM4ml0cENsOoZgQ0n56ECXztktARfRgi3KzNZ5Ra9RKFnh9cbzwzzDR6l5cP0",
+	"q8JRtccLHnN1N9ejj8LXLowRXsHX+CEUomEyJrWwTAjls3WRdiOEo9hnvwae/Rp4lh94FtzjYPyrvplj",
+	"Giwqm5xC/NMYotRIxE85GCQb1uGasFeFOuY44KWUG8rQZ3W5BaG5UalJH6bVqinaiWSzYeRj60v8lcka",
+	"BBmmFH8EgYGI/Jyrt9H0ZJkqtaPJUbrox2Kip4+zM4ZSxSkq3nRq4HsSNaMKA3jU2a9QXhzKv4mdCfXM",
+	"vK744bSDM36e/tvCuQxCfQWo/t0I6NQaKbJhHYwu3tSq1XJtUS5bHyXxrS4USIlpFWkWGi9TTEAuh9l2",
+	"vKpMIqWb32uCYnpOkVOewrwHa4iFMdSMTe6fw2yU4JH+8zMyPw6FE3CzD/E9kRGYyAfUjvJslOwoLjAJ",
+	"tZehyIYPHkNliLWW3ZI1WYK/Bn+Gm7GwS3QnEvYtjv1QkArRaTUyxIuvrHY4JilBHwNR5lBORJhsefFL",
+	"aWL2nbpcvaHIZ4TN4WE/qm05Fq8cry80ZAehx6u+6jqduiDodD0mXiuTpyrKpMMR0TYZaYVYLPADSaiF",
+	"wmSOeNGZvjGYZyzipliJxIyROeM3dbKBOzidwvVjgY4ziiWPuqeFW0VqTWvD0/q489EgOuo8xgAqV7E9",
+	"XmQd1PKwZkSfnDn3jjgkKPtNkPr05IX6WUNT1nxkdcUmvdmy5BVY8SEr35IJQm91acNPlDvU9Kh9r3w7",
+	"50Vv3XKp9pU3odacGdvX+IqmJpExfXWZj3uNDiS/FCfnQjU2c6oEpr8XRySvgKBGqPox2ATvcbAp4D9e",
+	"HPR1yHjx8iZx9q2Ylw/Tyj2ewYizxJjoW5BL+xOaGA9FWNBdtcjMXcQU5Y6zsMZKPOIzXdtVyF3IumIC",
+	"V1eVxDKFrm8I3jWxH2a9pogEA8BjOfOTpBCPzeSwMRnxmBynYPXTVO3+dA3UY9RWLdD6qVVYTXwsZ6H7",
+	"VEPDP6cr53EDfIxwoxsBRNHNWNQpUEguJcYuG8ggQ+5tyygdmB25uiPjY3gB5U3WD+6HEd9hYAWXlwdR",
+	"VaJnGYKwqEsUxUfoqxAlFy3YSjSddTlUVFT0afAYK6vv8XIgp1UoAzdDTYYoINKeTPWI/GPr2FQcrMQx",
+	"KXqNBQxyXAOKrAY+6gzxtvV3amiEiLG6LnwK9XkRr5rHPSnufc+CDL1+/VLrCxsBJF7byoSQ7oXSVPci",
+	"/P9iSr2GnDH045r87zLKPbxVQbVvoAgSKtkpiBAtJD7BGqAKKzWzGOkge+qThP0sq+xECVI7BAzGay71",
+	"sAwk14QHoZKtKsP83oH4VQAcnF6mbQ7xxLPgEb/Z9Zi682LbKlgx8j9L043E9Jrv1BSzd97pi1Wnv2OO",
+	"qS7Dc+hsteV6fi26g3ycHmUbYfbGWB87R7zEId5rxkzMjDU9is6zeKXyRvgm89UXTWBB6hq4+AkOMSQD",
+	"NEEQhNDIfNc/lJyvNJvHcUCFFprrsbrv3AoWi9NWzQNGpd3CG/fyP5qJf/S+cwMDudWQ7K61wVXkwlEj",
+	"S2GczAln2ksV4nUvSRilnuPek2MtsFBFTl1cH47xl+3i5y8nwz1+wW8UUhTO+xTz3JOzO2rOe7wGbM7l",
+	"62eiBQQkm9JVrs0slKhmCy3hNdgKIgjxSfyRYuTw/odUkyWqW7/oFaSUa1aH/g6Z8xEZ7BsEKmPneUSY",
+	"kmRH7GnwvzkTSAZzvRUF4GTO9lgEnEeBnsxXBs48RgU4oT2nxPUor3w7Ssl4ieMc4N9F5f8vRLTec7j1",
+	"oNOymzVr1aeuvC60rgaT6mR/kPK3E81wMSXZkGq104UTRgL+GfSrHbJ+xouw6Gcj5xrCAL+9gE+b10VN",
+	"mFA1Zgtx+wPR+f4yavNHkcCH4FRkz4ItmZDIBjo1QIoTi+r+HkOu0K+uUX7vnfOlkmno9tAoz7z3Hvw4",
+	"8iinry3XdXW7wOUu+nEU+fKErIKnHeDy1i9OHFjgopLYFX+P35xqedwIiuaeZ2+FU+M7cU0m8AZcWAX5",
+	"wuIu+vhllS/wQhbN1hrNK6RxavckYY1uDpvcF8NDEIgwlMSSzvvwPMGDtM4OuBjLu8ynNK40Bd/ONYUs",
+	"ZR7h2o2O5XJVPbp6Awr6Gqb6y7rfaR+tgAe0NYWf51aOFxXmwy6LvNxtWy37KBdyKLf3vNmXcsQ8oDmU",
+	"O8i4alZzjcdbYxh+InycIjxb1gQgrA97h06UvvCSilJQiWuZFADBAxYDkDXq6y/29/JUHmznw+xPj3V4",
+	"T/Mum6wBZ6XByAtGX2B5tWALSEokw+xL2/xbREs8Zx6i0R5kTCbTFTeKiqKrsUcRTVUaLF85jajKcuo6",
+	"xXPJSwenzWQlpeu3Tze9diWhcueI37rrIMe/VTH9xrFrR/H74jWRQmPf8GoeocxUooLTb3jGQRZJj9Dc",
+	"F6q/CR6ckLyUe3w86s95lTB8LEO7/x4RfjfvJknILOKPhNNd/HMQ3RkZK/5u6grAaS980KbWyWoE6Wum",
+	"su9fStQqDB6OU7YgVmYys3ABLvCisqbH0KgVm5i4x6joCT1yRGDmcci9//LkCw70xDW16SVIgw9AjwY/",
+	"dTaFkWbFQgUa0zkHyRs2i9ZONBOpTbzMqHqdvriXK3lZPZrsTqpQpBkud15DQNkFnQs/Ktbvx9G1lxnI",
+	"8RbJMP9KIdvXINrvw32aGQHLRxFpPOpftW7Nd6ldjfhlBjI/yYRjUk+elXoScg8SVSo1FwVx7BaEmVX9",
+	"V1ybJMMY+su2jtonCfsJD4VwrmrrWWiucA5vW0oVnZkkdTj9dc58BuxA2pS1rYzC7MSaH8fHqpPwjiNe",
+	"daxb/ALrmenz755/79w7598d91brI4B+aiSvC/v5nIrJzSeN+28ANr5Ki8d3qQIz/OyB9Qz8NPzyOQgF",
+	"L01ORtT4FoH4j/E4LA7iSopZ+s46MyyqHV29VlQoL3Jp+ihmkGMlyeAK36XynHnM7mHKrpDFoCaJNs5V",
+	"SdpWC7voy0Omu5vMBeBsk86RkRjqxNi07cma4C5mNeI11V55amrdcT73Jr221fh8suGAsxNvo/amlkql",
+	"0tT78J9PPvnkE3HrpE3bRhn+xiNJo0e0Y7XaaLwFDG4KYTWhU08X907nmopO1nl0ihYrxWujXp3wBmQp",
+	"K9m4Qs4OHgnt8YW8eV9Yq94iYFO9O2xvpLltFOrM9/z51fnV1VYjzzLwLRuSes/2W+16DiKelGCZdZcE",
+	"7/olXwBewhafa2tyBvdEv6ifcQWr7vT8mrNac3C+UCv3b5r7pdlABhNpL5nrx4Rp3TXOUETx7mgZGF/e",
+	"wv9ush3+cIQEDB/FIV/TirwLFH0su8GXMjsaFn172UayeqHGR6mmkVHis0ovx0BspCVeGPudienpidLM",
+	"UumiuM/RKC5Fi2Yygl4zxOUjlClS8iKxxzdNRM6wjsTIvTZizX8VqU8lupiPSKbdSx/b9tskSnPMCaum",
+	"65ErM8Fql4cyKdfnS0eiWl1A60SEcdBGz8VakNdvGzeo5VK30vPXjfL1lTsr4Se3pQOeBzDcMcMHvC3l",
+	"QSzVUXkuE5HCB+KyVOUJv8paefBbarX9dUgh+v8DAFMzKkXcrQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
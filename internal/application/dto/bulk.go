@@ -0,0 +1,31 @@
+package dto
+
+// BulkDataDTO is what bulk import reads and export writes: teams with
+// their members and the open pull requests
+type BulkDataDTO struct {
+	Teams        []BulkTeamDTO
+	PullRequests []BulkPullRequestDTO
+}
+
+type BulkTeamDTO struct {
+	TeamName string
+	Members  []TeamMemberCmd
+}
+
+type BulkPullRequestDTO struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	Reviewers       []string
+}
+
+// BulkChangeDTO is one change an import makes or, in a dry run, would make
+type BulkChangeDTO struct {
+	// team, user or pull_request
+	Entity string
+	// create, update or move
+	Action string
+	ID     string
+	// human-readable, e.g. "is_active: true -> false"
+	Detail string
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type DigestItemDTO struct {
+	PullRequestID   entities.PullRequestID
+	PullRequestName string
+	AuthorID        entities.UserID
+	// since the pull request was opened
+	Age time.Duration
+	// since this reviewer was assigned
+	Waiting time.Duration
+	// empty while the review is pending
+	Verdict entities.Verdict
+}
+
+type DigestDTO struct {
+	UserID      entities.UserID
+	Username    string
+	GeneratedAt time.Time
+	Items       []DigestItemDTO
+}
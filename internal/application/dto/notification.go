@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/Traunin/review-assigner/internal/domain/entities"
+
+type NotificationPreferenceDTO struct {
+	Channel entities.NotificationChannel
+	Address string
+	Enabled bool
+}
+
+// SetNotificationPreferencesCmd with no channels restores the default channel
+type SetNotificationPreferencesCmd struct {
+	UserID   entities.UserID
+	Channels []NotificationPreferenceDTO
+}
@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type OrganizationDTO struct {
+	ID        entities.OrgID
+	Name      string
+	CreatedAt time.Time
+}
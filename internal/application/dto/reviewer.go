@@ -9,14 +9,53 @@ import (
 type ReviewerDTO struct {
 	UserID     entities.UserID
 	AssignedAt time.Time
+	Verdict    entities.Verdict
+	VerdictAt  *time.Time
+	// nil when the reviewer wasn't picked at random
+	Seed *uint64
+	// empty when unknown
+	AssignedBy string
+}
+
+type RecordVerdictCmd struct {
+	PullRequestID entities.PullRequestID
+	UserID        entities.UserID
+	Verdict       entities.Verdict
 }
 
 type ReassignReviewerCmd struct {
 	OldUserID     entities.UserID
 	PullRequestID entities.PullRequestID
+	// picked at random among the author's team when empty
+	NewUserID entities.UserID
+}
+
+// FilledDTO is a pull request that got more reviewers
+type FilledDTO struct {
+	PullRequest PullRequestDTO
+	Added       []entities.UserID
+}
+
+type ChangeReviewerCmd struct {
+	PullRequestID entities.PullRequestID
+	UserID        entities.UserID
 }
 
 type ReassignedDTO struct {
 	PullRequestID *PullRequestDTO
 	Assigned      entities.UserID
 }
+
+type ExclusionDTO struct {
+	UserID entities.UserID
+	Reason entities.ExclusionReason
+}
+
+type AssignmentPreviewDTO struct {
+	AuthorID   entities.UserID
+	TeamName   string
+	Candidates []entities.UserID
+	Excluded   []ExclusionDTO
+	Reviewers  []entities.UserID
+	Seed       uint64
+}
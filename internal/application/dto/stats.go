@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type PercentilesDTO struct {
+	Samples int
+	// seconds, nil without samples
+	P50 *float64
+	P90 *float64
+	P99 *float64
+}
+
+type TeamPercentilesDTO struct {
+	TeamName string
+	PercentilesDTO
+}
+
+type ReviewerPercentilesDTO struct {
+	UserID entities.UserID
+	PercentilesDTO
+}
+
+type SLABreakdownDTO struct {
+	Overall    PercentilesDTO
+	ByTeam     []TeamPercentilesDTO
+	ByReviewer []ReviewerPercentilesDTO
+}
+
+type SLAReportDTO struct {
+	From                *time.Time
+	To                  *time.Time
+	TimeToMerge         SLABreakdownDTO
+	TimeToFirstReview   SLABreakdownDTO
+	AssignmentToVerdict SLABreakdownDTO
+}
+
+type ReviewerStatsDTO struct {
+	UserID            entities.UserID
+	Username          string
+	TeamName          string
+	IsActive          bool
+	TotalAssignments  int
+	OpenAssignments   int
+	MergedAssignments int
+}
+
+type ReviewerStatsListDTO struct {
+	Reviewers         []ReviewerStatsDTO
+	TotalPullRequests int
+}
+
+type PullRequestCountsDTO struct {
+	TotalPullRequests  int
+	OpenPullRequests   int
+	MergedPullRequests int
+	TotalReviewers     int
+	AvgReviewersPerPR  float64
+}
+
+type TeamPullRequestStatsDTO struct {
+	TeamName string
+	PullRequestCountsDTO
+}
+
+type PullRequestStatsDTO struct {
+	PullRequestCountsDTO
+	ByTeam []TeamPullRequestStatsDTO
+}
+
+type MemberFairnessDTO struct {
+	UserID              entities.UserID
+	Username            string
+	IsActive            bool
+	ActiveDays          float64 // fractional, 36 active hours are 1.5
+	Assignments         int
+	ExpectedShare       float64
+	ExpectedAssignments float64
+	Deviation           float64
+}
+
+type FairnessReportDTO struct {
+	TeamName         string
+	From             *time.Time
+	To               *time.Time
+	TotalAssignments int
+	Gini             float64
+	Members          []MemberFairnessDTO
+}
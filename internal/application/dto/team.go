@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 type CreateTeamCmd struct {
 	TeamName string
 	Members  []TeamMemberCmd
@@ -15,3 +17,10 @@ type TeamDTO struct {
 	ID       int64
 	TeamName string
 }
+
+// SetReviewSLACmd with both fields nil resets the team to the defaults
+type SetReviewSLACmd struct {
+	TeamName      string
+	RemindAfter   *time.Duration
+	ReassignAfter *time.Duration
+}
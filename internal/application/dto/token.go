@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type CreateTokenCmd struct {
+	Name   string
+	Role   entities.Role
+	UserID *entities.UserID
+	// OrgID binds the token to an organization, tokens tied to a user
+	// default to the default organization
+	OrgID *entities.OrgID
+}
+
+type TokenDTO struct {
+	ID        entities.TokenID
+	Name      string
+	Role      entities.Role
+	UserID    *entities.UserID
+	OrgID     *entities.OrgID
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// CreatedTokenDTO carries the plaintext secret, it's shown once and never
+// stored
+type CreatedTokenDTO struct {
+	TokenDTO
+	Secret string
+}
@@ -1,6 +1,9 @@
 package mapper
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/Traunin/review-assigner/internal/application/dto"
 	"github.com/Traunin/review-assigner/internal/domain/entities"
 )
@@ -9,6 +12,10 @@ func ToReviewerDTO(r entities.Reviewer) dto.ReviewerDTO {
 	return dto.ReviewerDTO{
 		UserID:     r.UserID,
 		AssignedAt: r.AssignedAt,
+		Verdict:    r.Verdict,
+		VerdictAt:  r.VerdictAt,
+		Seed:       r.Seed,
+		AssignedBy: r.AssignedBy,
 	}
 }
 
@@ -67,3 +74,153 @@ func ToUserDTOs(domain []*entities.User) []*dto.UserDTO {
 	}
 	return out
 }
+
+func ToTokenDTO(t *entities.APIToken) dto.TokenDTO {
+	return dto.TokenDTO{
+		ID:        t.ID(),
+		Name:      t.Name(),
+		Role:      t.Role(),
+		UserID:    t.UserID(),
+		OrgID:     t.OrgID(),
+		CreatedAt: t.CreatedAt(),
+		RevokedAt: t.RevokedAt(),
+	}
+}
+
+func ToTokenDTOs(domain []*entities.APIToken) []dto.TokenDTO {
+	out := make([]dto.TokenDTO, len(domain))
+	for i, t := range domain {
+		out[i] = ToTokenDTO(t)
+	}
+	return out
+}
+
+func ToOrganizationDTO(o *entities.Organization) dto.OrganizationDTO {
+	return dto.OrganizationDTO{
+		ID:        o.ID(),
+		Name:      o.Name(),
+		CreatedAt: o.CreatedAt(),
+	}
+}
+
+func ToPercentilesDTO(p entities.DurationPercentiles) dto.PercentilesDTO {
+	out := dto.PercentilesDTO{Samples: p.Samples}
+	if p.Samples > 0 {
+		p50, p90, p99 := p.P50.Seconds(), p.P90.Seconds(), p.P99.Seconds()
+		out.P50, out.P90, out.P99 = &p50, &p90, &p99
+	}
+	return out
+}
+
+func ToSLABreakdownDTO(b entities.SLABreakdown) dto.SLABreakdownDTO {
+	out := dto.SLABreakdownDTO{
+		Overall:    ToPercentilesDTO(b.Overall),
+		ByTeam:     make([]dto.TeamPercentilesDTO, 0, len(b.ByTeam)),
+		ByReviewer: make([]dto.ReviewerPercentilesDTO, 0, len(b.ByReviewer)),
+	}
+
+	for _, team := range slices.Sorted(maps.Keys(b.ByTeam)) {
+		out.ByTeam = append(out.ByTeam, dto.TeamPercentilesDTO{
+			TeamName:       team,
+			PercentilesDTO: ToPercentilesDTO(b.ByTeam[team]),
+		})
+	}
+	for _, userID := range slices.Sorted(maps.Keys(b.ByReviewer)) {
+		out.ByReviewer = append(out.ByReviewer, dto.ReviewerPercentilesDTO{
+			UserID:         userID,
+			PercentilesDTO: ToPercentilesDTO(b.ByReviewer[userID]),
+		})
+	}
+
+	return out
+}
+
+func ToReviewerStatsDTO(s entities.ReviewerStats) dto.ReviewerStatsDTO {
+	return dto.ReviewerStatsDTO{
+		UserID:            s.UserID,
+		Username:          s.Username,
+		TeamName:          s.TeamName,
+		IsActive:          s.IsActive,
+		TotalAssignments:  s.Total,
+		OpenAssignments:   s.Open,
+		MergedAssignments: s.Merged,
+	}
+}
+
+func ToPullRequestCountsDTO(c entities.PullRequestCounts) dto.PullRequestCountsDTO {
+	out := dto.PullRequestCountsDTO{
+		TotalPullRequests:  c.Total,
+		OpenPullRequests:   c.Open,
+		MergedPullRequests: c.Merged,
+		TotalReviewers:     c.Reviewers,
+	}
+	if c.Total > 0 {
+		out.AvgReviewersPerPR = float64(c.Reviewers) / float64(c.Total)
+	}
+	return out
+}
+
+func ToPullRequestStatsDTO(s *entities.PullRequestStats) dto.PullRequestStatsDTO {
+	out := dto.PullRequestStatsDTO{
+		PullRequestCountsDTO: ToPullRequestCountsDTO(s.PullRequestCounts),
+		ByTeam:               make([]dto.TeamPullRequestStatsDTO, 0, len(s.ByTeam)),
+	}
+	for _, team := range slices.Sorted(maps.Keys(s.ByTeam)) {
+		out.ByTeam = append(out.ByTeam, dto.TeamPullRequestStatsDTO{
+			TeamName:             team,
+			PullRequestCountsDTO: ToPullRequestCountsDTO(s.ByTeam[team]),
+		})
+	}
+	return out
+}
+
+func ToFairnessReportDTO(r *entities.FairnessReport) dto.FairnessReportDTO {
+	out := dto.FairnessReportDTO{
+		TeamName:         r.TeamName,
+		TotalAssignments: r.TotalAssignments,
+		Gini:             r.Gini,
+		Members:          make([]dto.MemberFairnessDTO, len(r.Members)),
+	}
+	for i, m := range r.Members {
+		out.Members[i] = dto.MemberFairnessDTO{
+			UserID:              m.UserID,
+			Username:            m.Username,
+			IsActive:            m.IsActive,
+			ActiveDays:          m.ActiveTime.Hours() / 24,
+			Assignments:         m.Assignments,
+			ExpectedShare:       m.ExpectedShare,
+			ExpectedAssignments: m.ExpectedAssignments,
+			Deviation:           m.Deviation,
+		}
+	}
+	return out
+}
+
+func ToNotificationPreferenceDTOs(
+	prefs []entities.NotificationPreference,
+) []dto.NotificationPreferenceDTO {
+	out := make([]dto.NotificationPreferenceDTO, len(prefs))
+	for i, p := range prefs {
+		out[i] = dto.NotificationPreferenceDTO{
+			Channel: p.Channel,
+			Address: p.Address,
+			Enabled: p.Enabled,
+		}
+	}
+	return out
+}
+
+func ToAssignmentPreviewDTO(p *entities.AssignmentPreview) dto.AssignmentPreviewDTO {
+	out := dto.AssignmentPreviewDTO{
+		AuthorID:   p.AuthorID,
+		TeamName:   p.TeamName,
+		Candidates: p.Candidates,
+		Excluded:   make([]dto.ExclusionDTO, len(p.Excluded)),
+		Reviewers:  p.Reviewers,
+		Seed:       p.Seed,
+	}
+	for i, e := range p.Excluded {
+		out.Excluded[i] = dto.ExclusionDTO{UserID: e.UserID, Reason: e.Reason}
+	}
+	return out
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/mapper"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/tenant"
+)
+
+// secrets carry a prefix so leaked tokens are easy to grep for
+const tokenPrefix = "ra_"
+
+var (
+	ErrInvalidToken  = errors.New("invalid or revoked token")
+	ErrTokenNotFound = errors.New("token not found")
+	ErrUserNotFound  = errors.New("user not found")
+	ErrOrgNotFound   = errors.New("organization not found")
+)
+
+type AuthService interface {
+	CreateToken(ctx context.Context, cmd dto.CreateTokenCmd) (*dto.CreatedTokenDTO, error)
+	Authenticate(ctx context.Context, secret string) (*entities.APIToken, error)
+	RevokeToken(ctx context.Context, id entities.TokenID) error
+	ListTokens(ctx context.Context) ([]dto.TokenDTO, error)
+}
+
+type authService struct {
+	tokens repositories.TokenRepository
+	users  repositories.UserRepository
+	orgs   repositories.OrganizationRepository
+}
+
+func NewAuthService(
+	tokens repositories.TokenRepository,
+	users repositories.UserRepository,
+	orgs repositories.OrganizationRepository,
+) AuthService {
+	return &authService{
+		tokens: tokens,
+		users:  users,
+		orgs:   orgs,
+	}
+}
+
+func (s *authService) CreateToken(
+	ctx context.Context,
+	cmd dto.CreateTokenCmd,
+) (*dto.CreatedTokenDTO, error) {
+	orgID := cmd.OrgID
+	if cmd.UserID != nil && orgID == nil {
+		defaultOrg := entities.DefaultOrgID
+		orgID = &defaultOrg
+	}
+
+	token, err := entities.NewAPIToken(
+		0,
+		cmd.Name,
+		cmd.Role,
+		cmd.UserID,
+		orgID,
+		time.Time{},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if orgID != nil {
+		org, err := s.orgs.FindByID(ctx, *orgID)
+		if err != nil {
+			return nil, err
+		}
+		if org == nil {
+			return nil, ErrOrgNotFound
+		}
+	}
+
+	if cmd.UserID != nil {
+		user, err := s.users.FindByID(tenant.WithOrg(ctx, *orgID), *cmd.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, ErrUserNotFound
+		}
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.tokens.Create(ctx, token, hashSecret(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CreatedTokenDTO{
+		TokenDTO: mapper.ToTokenDTO(created),
+		Secret:   secret,
+	}, nil
+}
+
+func (s *authService) Authenticate(
+	ctx context.Context,
+	secret string,
+) (*entities.APIToken, error) {
+	if !strings.HasPrefix(secret, tokenPrefix) {
+		return nil, ErrInvalidToken
+	}
+
+	token, err := s.tokens.FindActiveByHash(ctx, hashSecret(secret))
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, ErrInvalidToken
+	}
+	return token, nil
+}
+
+func (s *authService) RevokeToken(
+	ctx context.Context,
+	id entities.TokenID,
+) error {
+	revoked, err := s.tokens.Revoke(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return ErrTokenNotFound
+	}
+	return nil
+}
+
+func (s *authService) ListTokens(ctx context.Context) ([]dto.TokenDTO, error) {
+	tokens, err := s.tokens.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.ToTokenDTOs(tokens), nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return tokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// tokens are high-entropy random strings, so a plain SHA-256 is enough and
+// keeps the lookup a single indexed query
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
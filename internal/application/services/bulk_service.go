@@ -0,0 +1,313 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+)
+
+var ErrInvalidImport = errors.New("invalid import")
+
+const (
+	BulkEntityTeam        = "team"
+	BulkEntityUser        = "user"
+	BulkEntityPullRequest = "pull_request"
+
+	BulkActionCreate = "create"
+	BulkActionUpdate = "update"
+	BulkActionMove   = "move"
+)
+
+type BulkService interface {
+	// Import creates and updates the teams, users and pull requests in data
+	// and returns the changes, with dryRun nothing is written. Nothing is
+	// deleted, and pull requests that already exist are left as they are.
+	Import(ctx context.Context, data dto.BulkDataDTO, dryRun bool) ([]dto.BulkChangeDTO, error)
+	// Export returns every team with its members and the open pull requests
+	Export(ctx context.Context) (dto.BulkDataDTO, error)
+}
+
+type bulkService struct {
+	teams repositories.TeamRepository
+	users repositories.UserRepository
+	prs   repositories.PullRequestRepository
+	bulk  repositories.BulkRepository
+	now   func() time.Time
+}
+
+func NewBulkService(
+	teams repositories.TeamRepository,
+	users repositories.UserRepository,
+	prs repositories.PullRequestRepository,
+	bulk repositories.BulkRepository,
+) BulkService {
+	return &bulkService{
+		teams: teams,
+		users: users,
+		prs:   prs,
+		bulk:  bulk,
+		now:   time.Now,
+	}
+}
+
+func (s *bulkService) Import(
+	ctx context.Context,
+	data dto.BulkDataDTO,
+	dryRun bool,
+) ([]dto.BulkChangeDTO, error) {
+	if err := validateBulk(data); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teams.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	teamNames := make(map[entities.TeamID]string, len(teams))
+	for _, t := range teams {
+		teamNames[t.ID()] = t.Name()
+	}
+
+	users, err := s.users.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usersByID := make(map[entities.UserID]*entities.User, len(users))
+	for _, u := range users {
+		usersByID[u.ID()] = u
+	}
+
+	var (
+		plan    repositories.BulkPlan
+		changes []dto.BulkChangeDTO
+	)
+
+	for _, t := range data.Teams {
+		if !slices.ContainsFunc(teams, func(e *entities.Team) bool { return e.Name() == t.TeamName }) {
+			plan.Teams = append(plan.Teams, t.TeamName)
+			changes = append(changes, dto.BulkChangeDTO{
+				Entity: BulkEntityTeam,
+				Action: BulkActionCreate,
+				ID:     t.TeamName,
+			})
+		}
+
+		for _, m := range t.Members {
+			id := entities.UserID(m.UserID)
+			u, ok := usersByID[id]
+			if !ok {
+				u, err = entities.NewUser(id, m.Username, m.IsActive, nil)
+				if err != nil {
+					return nil, fmt.Errorf("%w: team %s: %w", ErrInvalidImport, t.TeamName, err)
+				}
+				usersByID[id] = u
+				plan.Users = append(plan.Users, repositories.BulkUser{User: u, TeamName: t.TeamName, New: true})
+				changes = append(changes, dto.BulkChangeDTO{
+					Entity: BulkEntityUser,
+					Action: BulkActionCreate,
+					ID:     m.UserID,
+					Detail: fmt.Sprintf("team: %s, is_active: %t", t.TeamName, m.IsActive),
+				})
+				continue
+			}
+
+			var from string
+			if u.TeamID() != nil {
+				from = teamNames[*u.TeamID()]
+			}
+			moved := from != t.TeamName
+			if moved {
+				changes = append(changes, dto.BulkChangeDTO{
+					Entity: BulkEntityUser,
+					Action: BulkActionMove,
+					ID:     m.UserID,
+					Detail: fmt.Sprintf("team: %s -> %s", orNone(from), t.TeamName),
+				})
+			}
+
+			var updates []string
+			if m.Username != "" && m.Username != u.Username() {
+				updates = append(updates, fmt.Sprintf("username: %s -> %s", u.Username(), m.Username))
+				u.SetUsername(m.Username)
+			}
+			if m.IsActive != u.IsActive() {
+				updates = append(updates, fmt.Sprintf("is_active: %t -> %t", u.IsActive(), m.IsActive))
+				u.SetActive(m.IsActive)
+			}
+			if len(updates) > 0 {
+				changes = append(changes, dto.BulkChangeDTO{
+					Entity: BulkEntityUser,
+					Action: BulkActionUpdate,
+					ID:     m.UserID,
+					Detail: strings.Join(updates, ", "),
+				})
+			}
+
+			if moved || len(updates) > 0 {
+				plan.Users = append(plan.Users, repositories.BulkUser{User: u, TeamName: t.TeamName})
+			}
+		}
+	}
+
+	now := s.now()
+	for _, p := range data.PullRequests {
+		existing, err := s.prs.FindByID(ctx, entities.PullRequestID(p.PullRequestID))
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			continue
+		}
+
+		for _, id := range append([]string{p.AuthorID}, p.Reviewers...) {
+			if _, ok := usersByID[entities.UserID(id)]; !ok {
+				return nil, fmt.Errorf("%w: pull request %s: unknown user %s", ErrInvalidImport, p.PullRequestID, id)
+			}
+		}
+
+		reviewers := make([]entities.Reviewer, len(p.Reviewers))
+		for i, id := range p.Reviewers {
+			reviewers[i] = entities.Reviewer{UserID: entities.UserID(id), AssignedAt: now}
+		}
+		pr, err := entities.NewPullRequest(
+			entities.PullRequestID(p.PullRequestID),
+			p.PullRequestName,
+			entities.UserID(p.AuthorID),
+			entities.StatusOpen,
+			reviewers,
+			now,
+			nil,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: pull request %s: %w", ErrInvalidImport, p.PullRequestID, err)
+		}
+
+		plan.PullRequests = append(plan.PullRequests, pr)
+		changes = append(changes, dto.BulkChangeDTO{
+			Entity: BulkEntityPullRequest,
+			Action: BulkActionCreate,
+			ID:     p.PullRequestID,
+			Detail: "reviewers: " + orNone(strings.Join(p.Reviewers, ", ")),
+		})
+	}
+
+	if dryRun || len(changes) == 0 {
+		return changes, nil
+	}
+	if err := s.bulk.Apply(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// validateBulk catches what the file contradicts itself on, the rest is
+// checked against the stored data
+func validateBulk(data dto.BulkDataDTO) error {
+	teams := make(map[string]bool)
+	memberOf := make(map[string]string)
+	for _, t := range data.Teams {
+		if t.TeamName == "" {
+			return fmt.Errorf("%w: %w", ErrInvalidImport, entities.ErrTeamNoName)
+		}
+		if teams[t.TeamName] {
+			return fmt.Errorf("%w: team %s is listed twice", ErrInvalidImport, t.TeamName)
+		}
+		teams[t.TeamName] = true
+
+		for _, m := range t.Members {
+			if m.UserID == "" {
+				return fmt.Errorf("%w: team %s: %w", ErrInvalidImport, t.TeamName, entities.ErrUserNoID)
+			}
+			if other, ok := memberOf[m.UserID]; ok {
+				return fmt.Errorf("%w: user %s is in teams %s and %s", ErrInvalidImport, m.UserID, other, t.TeamName)
+			}
+			memberOf[m.UserID] = t.TeamName
+		}
+	}
+
+	prs := make(map[string]bool)
+	for _, p := range data.PullRequests {
+		if prs[p.PullRequestID] {
+			return fmt.Errorf("%w: pull request %s is listed twice", ErrInvalidImport, p.PullRequestID)
+		}
+		prs[p.PullRequestID] = true
+
+		if len(p.Reviewers) > entities.MaxReviewers {
+			return fmt.Errorf("%w: pull request %s: %w", ErrInvalidImport, p.PullRequestID, entities.ErrPRTooManyReviewers)
+		}
+		for i, r := range p.Reviewers {
+			if slices.Contains(p.Reviewers[:i], r) {
+				return fmt.Errorf("%w: pull request %s: reviewer %s is listed twice", ErrInvalidImport, p.PullRequestID, r)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *bulkService) Export(ctx context.Context) (dto.BulkDataDTO, error) {
+	teams, err := s.teams.FindAll(ctx)
+	if err != nil {
+		return dto.BulkDataDTO{}, err
+	}
+	users, err := s.users.FindAll(ctx)
+	if err != nil {
+		return dto.BulkDataDTO{}, err
+	}
+	prs, err := s.prs.FindOpenPullRequests(ctx)
+	if err != nil {
+		return dto.BulkDataDTO{}, err
+	}
+
+	members := make(map[entities.TeamID][]dto.TeamMemberCmd)
+	for _, u := range users {
+		if u.TeamID() == nil {
+			continue
+		}
+		members[*u.TeamID()] = append(members[*u.TeamID()], dto.TeamMemberCmd{
+			UserID:   u.ID().String(),
+			Username: u.Username(),
+			IsActive: u.IsActive(),
+		})
+	}
+
+	slices.SortFunc(teams, func(a, b *entities.Team) int { return strings.Compare(a.Name(), b.Name()) })
+	out := dto.BulkDataDTO{
+		Teams:        make([]dto.BulkTeamDTO, len(teams)),
+		PullRequests: make([]dto.BulkPullRequestDTO, len(prs)),
+	}
+	for i, t := range teams {
+		m := members[t.ID()]
+		slices.SortFunc(m, func(a, b dto.TeamMemberCmd) int { return strings.Compare(a.UserID, b.UserID) })
+		out.Teams[i] = dto.BulkTeamDTO{TeamName: t.Name(), Members: m}
+	}
+	for i, pr := range prs {
+		reviewers := make([]string, len(pr.Reviewers()))
+		for j, r := range pr.Reviewers() {
+			reviewers[j] = r.UserID.String()
+		}
+		out.PullRequests[i] = dto.BulkPullRequestDTO{
+			PullRequestID:   pr.ID().String(),
+			PullRequestName: pr.Name(),
+			AuthorID:        pr.AuthorID().String(),
+			Reviewers:       reviewers,
+		}
+	}
+
+	return out, nil
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/memory"
+)
+
+type bulkFixture struct {
+	teams *memory.TeamRepository
+	users *memory.UserRepository
+	prs   *memory.PullRequestRepository
+	bulk  *memory.BulkRepository
+}
+
+// newBulkFixture stores team backend with u1 and u2 and pr-1 by u1
+func newBulkFixture(t *testing.T) bulkFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	store := memory.NewStore()
+	f := bulkFixture{
+		teams: memory.NewTeamRepository(store),
+		users: memory.NewUserRepository(store),
+		prs:   memory.NewPullRequestRepository(store),
+		bulk:  memory.NewBulkRepository(store),
+	}
+
+	team, err := entities.NewTeam("backend", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.teams.Create(ctx, team); err != nil {
+		t.Fatal(err)
+	}
+	team, err = f.teams.FindByName(ctx, "backend")
+	if err != nil || team == nil {
+		t.Fatalf("FindByName: %v, %v", team, err)
+	}
+
+	teamID := team.ID()
+	for _, id := range []entities.UserID{"u1", "u2"} {
+		user, err := entities.NewUser(id, string(id), true, &teamID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := f.users.Create(ctx, user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pr, err := entities.NewPullRequest("pr-1", "existing", "u1", entities.StatusOpen, nil, time.Now(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.prs.Create(ctx, pr); err != nil {
+		t.Fatal(err)
+	}
+
+	return f
+}
+
+// snapshot is everything an import can change, users as
+// id/username/team/is_active
+type snapshot struct {
+	teams []string
+	users []string
+	prs   []string
+}
+
+func (f bulkFixture) snapshot(t *testing.T) snapshot {
+	t.Helper()
+	ctx := context.Background()
+
+	var s snapshot
+	teams, err := f.teams.FindAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, team := range teams {
+		s.teams = append(s.teams, team.Name())
+	}
+
+	users, err := f.users.FindAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, u := range users {
+		team := "-"
+		if u.TeamID() != nil {
+			team = teams[slices.IndexFunc(teams, func(t *entities.Team) bool { return t.ID() == *u.TeamID() })].Name()
+		}
+		s.users = append(s.users, fmt.Sprintf("%s/%s/%s/%t", u.ID(), u.Username(), team, u.IsActive()))
+	}
+
+	prs, err := f.prs.FindAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pr := range prs {
+		s.prs = append(s.prs, pr.ID().String())
+	}
+	slices.Sort(s.prs)
+	return s
+}
+
+func (s snapshot) equal(o snapshot) bool {
+	return slices.Equal(s.teams, o.teams) && slices.Equal(s.users, o.users) && slices.Equal(s.prs, o.prs)
+}
+
+// importData renames u1, deactivates u2, moves in u3 with a new team and
+// adds pr-2, pr-1 already exists and is skipped
+var importData = dto.BulkDataDTO{
+	Teams: []dto.BulkTeamDTO{
+		{TeamName: "backend", Members: []dto.TeamMemberCmd{
+			{UserID: "u1", Username: "alice", IsActive: true},
+			{UserID: "u2", Username: "u2", IsActive: false},
+		}},
+		{TeamName: "frontend", Members: []dto.TeamMemberCmd{
+			{UserID: "u3", Username: "carol", IsActive: true},
+		}},
+	},
+	PullRequests: []dto.BulkPullRequestDTO{
+		{PullRequestID: "pr-2", PullRequestName: "new", AuthorID: "u3", Reviewers: []string{"u1"}},
+		{PullRequestID: "pr-1", PullRequestName: "existing", AuthorID: "u1"},
+	},
+}
+
+func TestBulkImportDryRun(t *testing.T) {
+	ctx := context.Background()
+	f := newBulkFixture(t)
+	service := NewBulkService(f.teams, f.users, f.prs, f.bulk)
+	before := f.snapshot(t)
+
+	want := []dto.BulkChangeDTO{
+		{Entity: BulkEntityUser, Action: BulkActionUpdate, ID: "u1", Detail: "username: u1 -> alice"},
+		{Entity: BulkEntityUser, Action: BulkActionUpdate, ID: "u2", Detail: "is_active: true -> false"},
+		{Entity: BulkEntityTeam, Action: BulkActionCreate, ID: "frontend"},
+		{Entity: BulkEntityUser, Action: BulkActionCreate, ID: "u3", Detail: "team: frontend, is_active: true"},
+		{Entity: BulkEntityPullRequest, Action: BulkActionCreate, ID: "pr-2", Detail: "reviewers: u1"},
+	}
+
+	changes, err := service.Import(ctx, importData, true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if !slices.Equal(changes, want) {
+		t.Errorf("dry run changes:\n got %v\nwant %v", changes, want)
+	}
+	if after := f.snapshot(t); !after.equal(before) {
+		t.Fatalf("dry run wrote data:\nbefore %+v\n after %+v", before, after)
+	}
+
+	changes, err = service.Import(ctx, importData, false)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if !slices.Equal(changes, want) {
+		t.Errorf("import changes:\n got %v\nwant %v", changes, want)
+	}
+	wantAfter := snapshot{
+		teams: []string{"backend", "frontend"},
+		users: []string{"u1/alice/backend/true", "u2/u2/backend/false", "u3/carol/frontend/true"},
+		prs:   []string{"pr-1", "pr-2"},
+	}
+	if after := f.snapshot(t); !after.equal(wantAfter) {
+		t.Errorf("after import %+v, want %+v", after, wantAfter)
+	}
+
+	// everything is in place, a second import has nothing to do
+	changes, err = service.Import(ctx, importData, false)
+	if err != nil || len(changes) != 0 {
+		t.Errorf("repeated import: %v, %v", changes, err)
+	}
+}
+
+// staleReads hides pr-1, as if it was created between planning the import
+// and applying it
+type staleReads struct {
+	*memory.PullRequestRepository
+}
+
+func (r staleReads) FindByID(ctx context.Context, id entities.PullRequestID) (*entities.PullRequest, error) {
+	if id == "pr-1" {
+		return nil, nil
+	}
+	return r.PullRequestRepository.FindByID(ctx, id)
+}
+
+func TestBulkImportRollsBack(t *testing.T) {
+	ctx := context.Background()
+	f := newBulkFixture(t)
+	service := NewBulkService(f.teams, f.users, staleReads{f.prs}, f.bulk)
+	before := f.snapshot(t)
+
+	// teams, users and pr-2 are written before pr-1 conflicts
+	if _, err := service.Import(ctx, importData, false); err == nil {
+		t.Fatal("import of a conflicting pull request succeeded")
+	}
+	if after := f.snapshot(t); !after.equal(before) {
+		t.Fatalf("failed import left changes:\nbefore %+v\n after %+v", before, after)
+	}
+}
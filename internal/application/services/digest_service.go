@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/digest"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/logging"
+	"github.com/Traunin/review-assigner/internal/notify"
+)
+
+type DigestService interface {
+	// Preview renders a user's digest without sending it
+	Preview(ctx context.Context, userID entities.UserID, format digest.Format) (string, error)
+	// SendAll delivers digests to every active user with open reviews
+	SendAll(ctx context.Context) (int, error)
+}
+
+type digestService struct {
+	users    repositories.UserRepository
+	prs      repositories.PullRequestRepository
+	notifier notify.Notifier
+	now      func() time.Time
+}
+
+func NewDigestService(
+	users repositories.UserRepository,
+	prs repositories.PullRequestRepository,
+	notifier notify.Notifier,
+) DigestService {
+	return &digestService{
+		users:    users,
+		prs:      prs,
+		notifier: notifier,
+		now:      time.Now,
+	}
+}
+
+func (s *digestService) Preview(
+	ctx context.Context,
+	userID entities.UserID,
+	format digest.Format,
+) (string, error) {
+	user, err := s.users.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if user == nil {
+		return "", ErrUserNotFound
+	}
+
+	d, err := s.build(ctx, user)
+	if err != nil {
+		return "", err
+	}
+
+	return digest.Render(format, d)
+}
+
+func (s *digestService) SendAll(ctx context.Context) (int, error) {
+	users, err := s.users.GetActiveUsers(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	logger := logging.FromContext(ctx)
+	sent := 0
+	for _, user := range users {
+		d, err := s.build(ctx, user)
+		if err != nil {
+			return sent, err
+		}
+		if len(d.Items) == 0 {
+			continue
+		}
+
+		msg, err := digestMessage(d)
+		if err != nil {
+			return sent, err
+		}
+
+		// one unreachable user shouldn't hold back everyone else's digest
+		if err := s.notifier.Notify(ctx, msg); err != nil {
+			logger.Error("failed to send digest", "user_id", user.ID(), "error", err)
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (s *digestService) build(ctx context.Context, user *entities.User) (dto.DigestDTO, error) {
+	prs, err := s.prs.FindPullRequestByUserID(ctx, user.ID())
+	if err != nil {
+		return dto.DigestDTO{}, err
+	}
+
+	now := s.now()
+	d := dto.DigestDTO{
+		UserID:      user.ID(),
+		Username:    user.Username(),
+		GeneratedAt: now,
+		Items:       make([]dto.DigestItemDTO, 0, len(prs)),
+	}
+	for _, pr := range prs {
+		if pr.IsMerged() {
+			continue
+		}
+
+		item := dto.DigestItemDTO{
+			PullRequestID:   pr.ID(),
+			PullRequestName: pr.Name(),
+			AuthorID:        pr.AuthorID(),
+			Age:             now.Sub(pr.CreatedAt()),
+		}
+		for _, r := range pr.Reviewers() {
+			if r.UserID == user.ID() {
+				item.Waiting = now.Sub(r.AssignedAt)
+				item.Verdict = r.Verdict
+			}
+		}
+		d.Items = append(d.Items, item)
+	}
+
+	return d, nil
+}
+
+func digestMessage(d dto.DigestDTO) (notify.Message, error) {
+	msg := notify.Message{
+		UserID:  d.UserID,
+		Subject: digest.Subject(d),
+	}
+
+	var err error
+	if msg.Text, err = digest.Render(digest.FormatText, d); err != nil {
+		return msg, err
+	}
+	if msg.Markdown, err = digest.Render(digest.FormatMarkdown, d); err != nil {
+		return msg, err
+	}
+	if msg.HTML, err = digest.Render(digest.FormatHTML, d); err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/mapper"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+)
+
+var ErrDuplicateChannel = errors.New("notification: channel listed more than once")
+
+type NotificationService interface {
+	GetPreferences(
+		ctx context.Context,
+		userID entities.UserID,
+	) ([]dto.NotificationPreferenceDTO, error)
+	SetPreferences(
+		ctx context.Context,
+		cmd dto.SetNotificationPreferencesCmd,
+	) ([]dto.NotificationPreferenceDTO, error)
+}
+
+type notificationService struct {
+	users repositories.UserRepository
+	prefs repositories.NotificationPreferenceRepository
+}
+
+func NewNotificationService(
+	users repositories.UserRepository,
+	prefs repositories.NotificationPreferenceRepository,
+) NotificationService {
+	return &notificationService{
+		users: users,
+		prefs: prefs,
+	}
+}
+
+func (s *notificationService) GetPreferences(
+	ctx context.Context,
+	userID entities.UserID,
+) ([]dto.NotificationPreferenceDTO, error) {
+	if err := s.requireUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	prefs, err := s.prefs.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToNotificationPreferenceDTOs(prefs), nil
+}
+
+func (s *notificationService) SetPreferences(
+	ctx context.Context,
+	cmd dto.SetNotificationPreferencesCmd,
+) ([]dto.NotificationPreferenceDTO, error) {
+	seen := make(map[entities.NotificationChannel]bool, len(cmd.Channels))
+	prefs := make([]entities.NotificationPreference, 0, len(cmd.Channels))
+	for _, c := range cmd.Channels {
+		pref, err := entities.NewNotificationPreference(c.Channel, c.Address, c.Enabled)
+		if err != nil {
+			return nil, err
+		}
+		if seen[pref.Channel] {
+			return nil, ErrDuplicateChannel
+		}
+		seen[pref.Channel] = true
+		prefs = append(prefs, pref)
+	}
+
+	if err := s.requireUser(ctx, cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.prefs.Replace(ctx, cmd.UserID, prefs); err != nil {
+		return nil, err
+	}
+
+	return mapper.ToNotificationPreferenceDTOs(prefs), nil
+}
+
+func (s *notificationService) requireUser(ctx context.Context, userID entities.UserID) error {
+	user, err := s.users.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+	return nil
+}
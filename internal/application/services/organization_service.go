@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/mapper"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+)
+
+type OrganizationService interface {
+	CreateOrganization(ctx context.Context, name string) (*dto.OrganizationDTO, error)
+	ListOrganizations(ctx context.Context) ([]dto.OrganizationDTO, error)
+}
+
+type organizationService struct {
+	orgs repositories.OrganizationRepository
+}
+
+func NewOrganizationService(
+	orgs repositories.OrganizationRepository,
+) OrganizationService {
+	return &organizationService{
+		orgs: orgs,
+	}
+}
+
+func (s *organizationService) CreateOrganization(
+	ctx context.Context,
+	name string,
+) (*dto.OrganizationDTO, error) {
+	org, err := entities.NewOrganization(0, name, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.orgs.Create(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+
+	out := mapper.ToOrganizationDTO(created)
+	return &out, nil
+}
+
+func (s *organizationService) ListOrganizations(
+	ctx context.Context,
+) ([]dto.OrganizationDTO, error) {
+	orgs, err := s.orgs.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]dto.OrganizationDTO, len(orgs))
+	for i, org := range orgs {
+		out[i] = mapper.ToOrganizationDTO(org)
+	}
+	return out, nil
+}
@@ -19,23 +19,43 @@ var (
 
 type PullRequestService interface {
 	GetByID(ctx context.Context, id entities.PullRequestID) (dto.PullRequestDTO, error)
+	// List returns the organization's pull requests, newest first, all of
+	// them when status is empty
+	List(ctx context.Context, status entities.PRStatus) ([]dto.PullRequestDTO, error)
 	Create(ctx context.Context, input dto.CreatePRCmd) (dto.PullRequestDTO, error)
 	Merge(ctx context.Context, id entities.PullRequestID) (dto.PullRequestDTO, error)
 	ReassignReviewer(ctx context.Context, input dto.ReassignReviewerCmd) (*dto.ReassignedDTO, error)
+	AddReviewer(ctx context.Context, input dto.ChangeReviewerCmd) (dto.PullRequestDTO, error)
+	RemoveReviewer(ctx context.Context, input dto.ChangeReviewerCmd) (dto.PullRequestDTO, error)
+	// FillReviewers assigns more reviewers to open pull requests created
+	// when their team had too few active members
+	FillReviewers(ctx context.Context) ([]dto.FilledDTO, error)
+	// FillTeamReviewers is FillReviewers for the pull requests of the team's
+	// authors, for when one of its members becomes a candidate again
+	FillTeamReviewers(ctx context.Context, teamID entities.TeamID) ([]dto.FilledDTO, error)
+	RecordVerdict(ctx context.Context, input dto.RecordVerdictCmd) (dto.PullRequestDTO, error)
+	// PreviewAssignment shows who would review a pull request by the author
+	// if it was created now
+	PreviewAssignment(ctx context.Context, authorID entities.UserID) (dto.AssignmentPreviewDTO, error)
 }
 
 type pullRequestService struct {
 	repo      repositories.PullRequestRepository
 	prService ds.ReviewerAssignmentService
+	// the reviewersPerPR of prService, pull requests with as many reviewers
+	// aren't loaded again to be filled
+	reviewersPerPR int
 }
 
 func NewPullRequestService(
 	repo repositories.PullRequestRepository,
 	prService ds.ReviewerAssignmentService,
+	reviewersPerPR int,
 ) PullRequestService {
 	return &pullRequestService{
-		repo:      repo,
-		prService: prService,
+		repo:           repo,
+		prService:      prService,
+		reviewersPerPR: min(reviewersPerPR, entities.MaxReviewers),
 	}
 }
 
@@ -54,6 +74,34 @@ func (s *pullRequestService) GetByID(
 	return mapper.ToPullRequestDTO(pr), nil
 }
 
+func (s *pullRequestService) List(
+	ctx context.Context,
+	status entities.PRStatus,
+) ([]dto.PullRequestDTO, error) {
+	var (
+		prs []*entities.PullRequest
+		err error
+	)
+	if status == entities.StatusOpen {
+		prs, err = s.repo.FindOpenPullRequests(ctx)
+	} else {
+		prs, err = s.repo.FindAll(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]dto.PullRequestDTO, 0, len(prs))
+	for _, pr := range prs {
+		if status != "" && pr.Status() != status {
+			continue
+		}
+		out = append(out, mapper.ToPullRequestDTO(pr))
+	}
+
+	return out, nil
+}
+
 func (s *pullRequestService) Create(
 	ctx context.Context,
 	input dto.CreatePRCmd,
@@ -103,6 +151,7 @@ func (s *pullRequestService) ReassignReviewer(
 		ctx,
 		input.PullRequestID,
 		input.OldUserID,
+		input.NewUserID,
 	)
 	if err != nil {
 		return nil, err
@@ -111,3 +160,117 @@ func (s *pullRequestService) ReassignReviewer(
 	prDTO := mapper.ToPullRequestDTO(pr)
 	return &dto.ReassignedDTO{PullRequestID: &prDTO, Assigned: assigned}, nil
 }
+
+func (s *pullRequestService) AddReviewer(
+	ctx context.Context,
+	input dto.ChangeReviewerCmd,
+) (dto.PullRequestDTO, error) {
+	pr, err := s.prService.AddReviewer(ctx, input.PullRequestID, input.UserID)
+	if err != nil {
+		return dto.PullRequestDTO{}, err
+	}
+
+	return mapper.ToPullRequestDTO(pr), nil
+}
+
+func (s *pullRequestService) RemoveReviewer(
+	ctx context.Context,
+	input dto.ChangeReviewerCmd,
+) (dto.PullRequestDTO, error) {
+	pr, err := s.prService.RemoveReviewer(ctx, input.PullRequestID, input.UserID)
+	if err != nil {
+		return dto.PullRequestDTO{}, err
+	}
+
+	return mapper.ToPullRequestDTO(pr), nil
+}
+
+func (s *pullRequestService) FillReviewers(
+	ctx context.Context,
+) ([]dto.FilledDTO, error) {
+	prs, err := s.repo.FindOpenPullRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fill(ctx, prs)
+}
+
+func (s *pullRequestService) FillTeamReviewers(
+	ctx context.Context,
+	teamID entities.TeamID,
+) ([]dto.FilledDTO, error) {
+	prs, err := s.repo.FindOpenPullRequestsByTeamID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fill(ctx, prs)
+}
+
+func (s *pullRequestService) fill(
+	ctx context.Context,
+	prs []*entities.PullRequest,
+) ([]dto.FilledDTO, error) {
+	filled := make([]dto.FilledDTO, 0)
+	for _, pr := range prs {
+		if len(pr.Reviewers()) >= s.reviewersPerPR {
+			continue
+		}
+
+		added, updated, err := s.prService.FillReviewers(ctx, pr.ID())
+		// the author left the team, or the pull request was merged meanwhile
+		if errors.Is(err, ds.ErrTeamNotFound) || errors.Is(err, ds.ErrPRAlreadyMerged) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(added) == 0 {
+			continue
+		}
+
+		filled = append(filled, dto.FilledDTO{
+			PullRequest: mapper.ToPullRequestDTO(updated),
+			Added:       added,
+		})
+	}
+
+	return filled, nil
+}
+
+func (s *pullRequestService) RecordVerdict(
+	ctx context.Context,
+	input dto.RecordVerdictCmd,
+) (dto.PullRequestDTO, error) {
+	pr, err := s.repo.FindByID(ctx, input.PullRequestID)
+	if err != nil {
+		return dto.PullRequestDTO{}, err
+	}
+	if pr == nil {
+		return dto.PullRequestDTO{}, ErrNotFound
+	}
+
+	err = pr.RecordVerdict(input.UserID, input.Verdict, time.Now())
+	if err != nil {
+		return dto.PullRequestDTO{}, err
+	}
+
+	if err := s.repo.Update(ctx, pr); err != nil {
+		return dto.PullRequestDTO{}, err
+	}
+
+	return mapper.ToPullRequestDTO(pr), nil
+}
+
+func (s *pullRequestService) PreviewAssignment(
+	ctx context.Context,
+	authorID entities.UserID,
+) (dto.AssignmentPreviewDTO, error) {
+	preview, err := s.prService.Preview(ctx, authorID)
+	if err != nil {
+		return dto.AssignmentPreviewDTO{}, err
+	}
+
+	return mapper.ToAssignmentPreviewDTO(preview), nil
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	ds "github.com/Traunin/review-assigner/internal/domain/services"
+)
+
+// TestFillReviewersPerPR checks that filling tops pull requests up to the
+// configured reviewers per pull request, not to entities.MaxReviewers
+func TestFillReviewersPerPR(t *testing.T) {
+	ctx := ds.WithSeed(context.Background(), 42)
+	f := newBulkFixture(t)
+
+	team, err := f.teams.FindByName(ctx, "backend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	teamID := team.ID()
+	for _, id := range []entities.UserID{"u3", "u4"} {
+		user, err := entities.NewUser(id, string(id), true, &teamID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := f.users.Create(ctx, user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// pr-1 from the fixture has no reviewers, pr-2 has one
+	pr, err := entities.NewPullRequest("pr-2", "reviewed", "u1", entities.StatusOpen,
+		[]entities.Reviewer{{UserID: "u2", AssignedAt: time.Now()}}, time.Now(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.prs.Create(ctx, pr); err != nil {
+		t.Fatal(err)
+	}
+
+	assignment := ds.NewReviewerAssignmentService(f.users, f.prs, f.teams, 1, nil)
+	service := NewPullRequestService(f.prs, assignment, 1)
+
+	filled, err := service.FillReviewers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filled) != 1 || filled[0].PullRequest.PullRequestID != "pr-1" || len(filled[0].Added) != 1 {
+		t.Fatalf("filled %+v, want one reviewer for pr-1", filled)
+	}
+
+	for _, id := range []entities.PullRequestID{"pr-1", "pr-2"} {
+		pr, err := f.prs.FindByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := len(pr.Reviewers()); n != 1 {
+			t.Errorf("%s has %d reviewers, want 1", id, n)
+		}
+	}
+
+	// everything has its reviewer, a second fill has nothing to do
+	filled, err = service.FillReviewers(ctx)
+	if err != nil || len(filled) != 0 {
+		t.Errorf("repeated fill: %+v, %v", filled, err)
+	}
+	if pr, _ := f.prs.FindByID(ctx, "pr-2"); !slices.Equal(pr.ReviewerIDs(), []entities.UserID{"u2"}) {
+		t.Errorf("pr-2 reviewers changed to %v", pr.ReviewerIDs())
+	}
+}
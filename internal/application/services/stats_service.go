@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/mapper"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+)
+
+var ErrInvalidWindow = errors.New("from must be before to")
+
+type StatsService interface {
+	GetReviewerStats(ctx context.Context) (*dto.ReviewerStatsListDTO, error)
+	GetReviewerStatsByUserID(
+		ctx context.Context,
+		userID entities.UserID,
+	) (*dto.ReviewerStatsDTO, error)
+	GetPullRequestStats(ctx context.Context) (*dto.PullRequestStatsDTO, error)
+	GetSLA(ctx context.Context, window entities.TimeWindow) (*dto.SLAReportDTO, error)
+	GetFairness(
+		ctx context.Context,
+		teamName string,
+		window entities.TimeWindow,
+	) (*dto.FairnessReportDTO, error)
+}
+
+type statsService struct {
+	stats repositories.StatsRepository
+}
+
+func NewStatsService(
+	stats repositories.StatsRepository,
+) StatsService {
+	return &statsService{
+		stats: stats,
+	}
+}
+
+func (s *statsService) GetReviewerStats(
+	ctx context.Context,
+) (*dto.ReviewerStatsListDTO, error) {
+	reviewers, err := s.stats.ReviewerStats(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	prs, err := s.stats.PullRequestStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.ReviewerStatsListDTO{
+		Reviewers:         make([]dto.ReviewerStatsDTO, len(reviewers)),
+		TotalPullRequests: prs.Total,
+	}
+	for i, r := range reviewers {
+		result.Reviewers[i] = mapper.ToReviewerStatsDTO(r)
+	}
+
+	return result, nil
+}
+
+func (s *statsService) GetReviewerStatsByUserID(
+	ctx context.Context,
+	userID entities.UserID,
+) (*dto.ReviewerStatsDTO, error) {
+	reviewers, err := s.stats.ReviewerStats(ctx, &userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(reviewers) == 0 {
+		return nil, ErrUserNotFound
+	}
+
+	result := mapper.ToReviewerStatsDTO(reviewers[0])
+	return &result, nil
+}
+
+func (s *statsService) GetPullRequestStats(
+	ctx context.Context,
+) (*dto.PullRequestStatsDTO, error) {
+	stats, err := s.stats.PullRequestStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mapper.ToPullRequestStatsDTO(stats)
+	return &result, nil
+}
+
+func (s *statsService) GetSLA(
+	ctx context.Context,
+	window entities.TimeWindow,
+) (*dto.SLAReportDTO, error) {
+	if err := validateWindow(window); err != nil {
+		return nil, err
+	}
+
+	report, err := s.stats.SLA(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SLAReportDTO{
+		From:                window.From,
+		To:                  window.To,
+		TimeToMerge:         mapper.ToSLABreakdownDTO(report.TimeToMerge),
+		TimeToFirstReview:   mapper.ToSLABreakdownDTO(report.TimeToFirstReview),
+		AssignmentToVerdict: mapper.ToSLABreakdownDTO(report.AssignmentToVerdict),
+	}, nil
+}
+
+func (s *statsService) GetFairness(
+	ctx context.Context,
+	teamName string,
+	window entities.TimeWindow,
+) (*dto.FairnessReportDTO, error) {
+	if err := validateWindow(window); err != nil {
+		return nil, err
+	}
+
+	report, err := s.stats.Fairness(ctx, teamName, window)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, ErrTeamNotFound
+	}
+
+	result := mapper.ToFairnessReportDTO(report)
+	result.From = window.From
+	result.To = window.To
+	return &result, nil
+}
+
+func validateWindow(window entities.TimeWindow) error {
+	if window.From != nil && window.To != nil && !window.From.Before(*window.To) {
+		return ErrInvalidWindow
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/Traunin/review-assigner/internal/application/dto"
 	"github.com/Traunin/review-assigner/internal/domain/entities"
@@ -17,20 +18,24 @@ var (
 type TeamService interface {
 	CreateTeam(ctx context.Context, cmd dto.CreateTeamCmd) (*dto.TeamDTO, error)
 	GetTeam(ctx context.Context, teamName string) (*dto.TeamDTO, error)
+	SetReviewSLA(ctx context.Context, cmd dto.SetReviewSLACmd) error
 }
 
 type teamService struct {
-	teams repositories.TeamRepository
-	users repositories.UserRepository
+	teams     repositories.TeamRepository
+	users     repositories.UserRepository
+	reminders repositories.ReviewReminderRepository
 }
 
 func NewTeamService(
 	teams repositories.TeamRepository,
 	users repositories.UserRepository,
+	reminders repositories.ReviewReminderRepository,
 ) TeamService {
 	return &teamService{
-		teams: teams,
-		users: users,
+		teams:     teams,
+		users:     users,
+		reminders: reminders,
 	}
 }
 
@@ -119,3 +124,35 @@ func (s *teamService) GetTeam(
 		TeamName: team.Name(),
 	}, nil
 }
+
+func (s *teamService) SetReviewSLA(
+	ctx context.Context,
+	cmd dto.SetReviewSLACmd,
+) error {
+	var sla *entities.ReviewSLA
+	if cmd.RemindAfter != nil || cmd.ReassignAfter != nil {
+		var remindAfter, reassignAfter time.Duration
+		if cmd.RemindAfter != nil {
+			remindAfter = *cmd.RemindAfter
+		}
+		if cmd.ReassignAfter != nil {
+			reassignAfter = *cmd.ReassignAfter
+		}
+
+		teamSLA, err := entities.NewReviewSLA(remindAfter, reassignAfter)
+		if err != nil {
+			return err
+		}
+		sla = &teamSLA
+	}
+
+	team, err := s.teams.FindByName(ctx, cmd.TeamName)
+	if err != nil {
+		return err
+	}
+	if team == nil {
+		return ErrTeamNotFound
+	}
+
+	return s.reminders.SetTeamSLA(ctx, team.ID(), sla)
+}
@@ -0,0 +1,316 @@
+// Package bulk reads and writes the files used by bulk import and export.
+package bulk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatCSV  Format = "csv"
+)
+
+var ErrUnknownFormat = errors.New("format must be json, yaml or csv")
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatJSON, FormatYAML, FormatCSV:
+		return f, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// FormatOf picks the format for a request Content-Type
+func FormatOf(contentType string) (Format, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", ErrUnknownFormat
+	}
+	switch mediaType {
+	case "application/json":
+		return FormatJSON, nil
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return FormatYAML, nil
+	case "text/csv":
+		return FormatCSV, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatYAML:
+		return "application/yaml"
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	default:
+		return "application/json"
+	}
+}
+
+// file mirrors the /team/add and /pullRequest/create request bodies
+type file struct {
+	Teams        []team        `json:"teams" yaml:"teams"`
+	PullRequests []pullRequest `json:"pull_requests" yaml:"pull_requests"`
+}
+
+type team struct {
+	TeamName string   `json:"team_name" yaml:"team_name"`
+	Members  []member `json:"members" yaml:"members"`
+}
+
+type member struct {
+	UserID   string `json:"user_id" yaml:"user_id"`
+	Username string `json:"username" yaml:"username"`
+	// members are active unless the file says otherwise
+	IsActive *bool `json:"is_active" yaml:"is_active"`
+}
+
+type pullRequest struct {
+	PullRequestID     string   `json:"pull_request_id" yaml:"pull_request_id"`
+	PullRequestName   string   `json:"pull_request_name" yaml:"pull_request_name"`
+	AuthorID          string   `json:"author_id" yaml:"author_id"`
+	AssignedReviewers []string `json:"assigned_reviewers" yaml:"assigned_reviewers"`
+}
+
+// csvHeader has a record column saying which of the other columns are used:
+// team uses team_name, member uses team_name to is_active, pull_request uses
+// the rest, reviewers separated by ';'
+var csvHeader = []string{
+	"record",
+	"team_name",
+	"user_id",
+	"username",
+	"is_active",
+	"pull_request_id",
+	"pull_request_name",
+	"author_id",
+	"reviewers",
+}
+
+const (
+	recordTeam        = "team"
+	recordMember      = "member"
+	recordPullRequest = "pull_request"
+)
+
+func Decode(format Format, data []byte) (dto.BulkDataDTO, error) {
+	var f file
+	switch format {
+	case FormatJSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&f); err != nil {
+			return dto.BulkDataDTO{}, fmt.Errorf("invalid json: %w", err)
+		}
+	case FormatYAML:
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+			return dto.BulkDataDTO{}, fmt.Errorf("invalid yaml: %w", err)
+		}
+	case FormatCSV:
+		var err error
+		if f, err = decodeCSV(data); err != nil {
+			return dto.BulkDataDTO{}, fmt.Errorf("invalid csv: %w", err)
+		}
+	default:
+		return dto.BulkDataDTO{}, ErrUnknownFormat
+	}
+	return f.toDTO(), nil
+}
+
+func Encode(format Format, d dto.BulkDataDTO) ([]byte, error) {
+	f := fromDTO(d)
+	switch format {
+	case FormatJSON:
+		return json.MarshalIndent(f, "", "  ")
+	case FormatYAML:
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(f); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), enc.Close()
+	case FormatCSV:
+		return encodeCSV(f)
+	}
+	return nil, ErrUnknownFormat
+}
+
+func decodeCSV(data []byte) (file, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = len(csvHeader)
+
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return file{}, nil
+	}
+	if err != nil {
+		return file{}, err
+	}
+	if strings.Join(header, ",") != strings.Join(csvHeader, ",") {
+		return file{}, fmt.Errorf("header must be %s", strings.Join(csvHeader, ","))
+	}
+
+	var f file
+	// members may come before or after their team row
+	teams := make(map[string]int)
+	teamIndex := func(name string) int {
+		i, ok := teams[name]
+		if !ok {
+			i = len(f.Teams)
+			teams[name] = i
+			f.Teams = append(f.Teams, team{TeamName: name, Members: []member{}})
+		}
+		return i
+	}
+
+	for {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return file{}, err
+		}
+		line, _ := r.FieldPos(0)
+
+		switch row[0] {
+		case recordTeam:
+			teamIndex(row[1])
+		case recordMember:
+			m := member{UserID: row[2], Username: row[3]}
+			if row[4] != "" {
+				active, err := strconv.ParseBool(row[4])
+				if err != nil {
+					return file{}, fmt.Errorf("line %d: invalid is_active %q", line, row[4])
+				}
+				m.IsActive = &active
+			}
+			i := teamIndex(row[1])
+			f.Teams[i].Members = append(f.Teams[i].Members, m)
+		case recordPullRequest:
+			pr := pullRequest{
+				PullRequestID:     row[5],
+				PullRequestName:   row[6],
+				AuthorID:          row[7],
+				AssignedReviewers: []string{},
+			}
+			if row[8] != "" {
+				pr.AssignedReviewers = strings.Split(row[8], ";")
+			}
+			f.PullRequests = append(f.PullRequests, pr)
+		default:
+			return file{}, fmt.Errorf("line %d: record must be team, member or pull_request, got %q", line, row[0])
+		}
+	}
+
+	return f, nil
+}
+
+func encodeCSV(f file) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	rows := [][]string{csvHeader}
+	for _, t := range f.Teams {
+		rows = append(rows, []string{recordTeam, t.TeamName, "", "", "", "", "", "", ""})
+		for _, m := range t.Members {
+			rows = append(rows, []string{
+				recordMember,
+				t.TeamName,
+				m.UserID,
+				m.Username,
+				strconv.FormatBool(m.IsActive == nil || *m.IsActive),
+				"", "", "", "",
+			})
+		}
+	}
+	for _, pr := range f.PullRequests {
+		rows = append(rows, []string{
+			recordPullRequest,
+			"", "", "", "",
+			pr.PullRequestID,
+			pr.PullRequestName,
+			pr.AuthorID,
+			strings.Join(pr.AssignedReviewers, ";"),
+		})
+	}
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (f file) toDTO() dto.BulkDataDTO {
+	d := dto.BulkDataDTO{
+		Teams:        make([]dto.BulkTeamDTO, len(f.Teams)),
+		PullRequests: make([]dto.BulkPullRequestDTO, len(f.PullRequests)),
+	}
+	for i, t := range f.Teams {
+		members := make([]dto.TeamMemberCmd, len(t.Members))
+		for j, m := range t.Members {
+			members[j] = dto.TeamMemberCmd{
+				UserID:   m.UserID,
+				Username: m.Username,
+				IsActive: m.IsActive == nil || *m.IsActive,
+			}
+		}
+		d.Teams[i] = dto.BulkTeamDTO{TeamName: t.TeamName, Members: members}
+	}
+	for i, pr := range f.PullRequests {
+		d.PullRequests[i] = dto.BulkPullRequestDTO{
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			Reviewers:       pr.AssignedReviewers,
+		}
+	}
+	return d
+}
+
+func fromDTO(d dto.BulkDataDTO) file {
+	f := file{
+		Teams:        make([]team, len(d.Teams)),
+		PullRequests: make([]pullRequest, len(d.PullRequests)),
+	}
+	for i, t := range d.Teams {
+		members := make([]member, len(t.Members))
+		for j, m := range t.Members {
+			active := m.IsActive
+			members[j] = member{UserID: m.UserID, Username: m.Username, IsActive: &active}
+		}
+		f.Teams[i] = team{TeamName: t.TeamName, Members: members}
+	}
+	for i, pr := range d.PullRequests {
+		reviewers := pr.Reviewers
+		if reviewers == nil {
+			reviewers = []string{}
+		}
+		f.PullRequests[i] = pullRequest{
+			PullRequestID:     pr.PullRequestID,
+			PullRequestName:   pr.PullRequestName,
+			AuthorID:          pr.AuthorID,
+			AssignedReviewers: reviewers,
+		}
+	}
+	return f
+}
@@ -1,44 +1,293 @@
 package config
 
 import (
-	"sync"
+	"fmt"
+	"net/url"
+	"time"
 
-	"github.com/Traunin/review-assigner/internal/env"
-	_ "github.com/lib/pq"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
 )
 
 type Config struct {
-	dbHost     string
-	dbPort     string
-	dbUser     string
-	dbPassword string
-	dbName     string
-	port       string
+	DB         DBConfig         `yaml:"db"`
+	Server     ServerConfig     `yaml:"server"`
+	GRPC       GRPCConfig       `yaml:"grpc"`
+	Log        LogConfig        `yaml:"log"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+	Assignment AssignmentConfig `yaml:"assignment"`
+	RateLimit  RateLimitConfig  `yaml:"rate_limit"`
+	Scheduler  SchedulerConfig  `yaml:"scheduler"`
+	Digest     DigestConfig     `yaml:"digest"`
+	Notify     NotifyConfig     `yaml:"notify"`
+	Events     EventsConfig     `yaml:"events"`
+	Features   FeaturesConfig   `yaml:"features"`
 }
 
-var (
-	cfg  *Config
-	once sync.Once
-)
+type DBConfig struct {
+	// DSN takes precedence over the individual connection fields
+	DSN      string `yaml:"dsn"`
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+	SSLMode  string `yaml:"ssl_mode"`
+
+	MaxConns        int32         `yaml:"max_conns"`
+	MinConns        int32         `yaml:"min_conns"`
+	MaxConnLifetime time.Duration `yaml:"max_conn_lifetime"`
+	MaxConnIdleTime time.Duration `yaml:"max_conn_idle_time"`
+
+	AutoMigrate bool `yaml:"auto_migrate"`
+}
+
+type ServerConfig struct {
+	Port            string        `yaml:"port"`
+	ReadTimeout     time.Duration `yaml:"read_timeout"`
+	WriteTimeout    time.Duration `yaml:"write_timeout"`
+	IdleTimeout     time.Duration `yaml:"idle_timeout"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// CIDRs of reverse proxies whose X-Forwarded-For is believed, the
+	// client IP is the connection's address when empty
+	TrustedProxies []string `yaml:"trusted_proxies"`
+}
+
+type GRPCConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Port    string `yaml:"port"`
+	// lets grpcurl and similar tools discover the services
+	Reflection bool `yaml:"reflection"`
+}
+
+type LogConfig struct {
+	Level string `yaml:"level"`
+}
+
+type TracingConfig struct {
+	// none, stdout or otlp
+	Exporter     string `yaml:"exporter"`
+	OTLPEndpoint string `yaml:"otlp_endpoint"`
+}
+
+type AssignmentConfig struct {
+	ReviewersPerPR int `yaml:"reviewers_per_pr"`
+	// lets requests fix the random pick with an X-Assignment-Seed header,
+	// for tests and replaying recorded picks
+	AllowSeed bool `yaml:"allow_seed"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// memory or postgres, the latter is shared by all replicas
+	Store   string      `yaml:"store"`
+	Default LimitConfig `yaml:"default"`
+	// every request per IP, taken before the token is looked up so requests
+	// with bad tokens can't reach the database unthrottled
+	PerIP LimitConfig `yaml:"per_ip"`
+	// per-route limits keyed as "METHOD /path", merged over the built-in ones
+	Routes map[string]LimitConfig `yaml:"routes"`
+}
+
+type LimitConfig struct {
+	// tokens per second
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+type SchedulerConfig struct {
+	Enabled  bool          `yaml:"enabled"`
+	Interval time.Duration `yaml:"interval"`
+	// defaults for teams without their own review SLA
+	RemindAfter time.Duration `yaml:"remind_after"`
+	// 0 turns automatic reassignment off
+	ReassignAfter time.Duration `yaml:"reassign_after"`
+}
+
+type DigestConfig struct {
+	// runs as a scheduler job, so it needs the scheduler enabled too
+	Enabled bool `yaml:"enabled"`
+	// time of day in UTC, HH:MM
+	SendAt string `yaml:"send_at"`
+}
+
+// SendAtOffset returns SendAt as an offset from midnight, it expects a
+// validated config
+func (c DigestConfig) SendAtOffset() time.Duration {
+	t, _ := time.Parse("15:04", c.SendAt)
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+}
+
+type NotifyConfig struct {
+	// default channel for users without preferences: none, log, chat,
+	// email, webhook or file
+	Channel string `yaml:"channel"`
+	// Slack or Mattermost incoming webhook
+	ChatWebhookURL string     `yaml:"chat_webhook_url"`
+	WebhookURL     string     `yaml:"webhook_url"`
+	SMTP           SMTPConfig `yaml:"smtp"`
+	File           string     `yaml:"file"`
+
+	// delivery is asynchronous, each channel has its own queue
+	Workers      int           `yaml:"workers"`
+	QueueSize    int           `yaml:"queue_size"`
+	MaxAttempts  int           `yaml:"max_attempts"`
+	RetryBackoff time.Duration `yaml:"retry_backoff"`
+}
+
+type SMTPConfig struct {
+	Addr     string `yaml:"addr"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	From     string `yaml:"from"`
+	// mail goes to <user_id>@domain
+	Domain string `yaml:"domain"`
+}
+
+type EventsConfig struct {
+	// memory or postgres, the latter relays events between replicas with
+	// LISTEN/NOTIFY
+	Broker string `yaml:"broker"`
+	// events buffered per stream before a slow client starts missing them
+	Buffer int `yaml:"buffer"`
+}
+
+type FeaturesConfig struct {
+	Metrics bool `yaml:"metrics"`
+	// Auth requires a bearer token on every API endpoint
+	Auth bool `yaml:"auth"`
+}
+
+func Default() *Config {
+	return &Config{
+		DB: DBConfig{
+			Port:            "5432",
+			SSLMode:         "disable",
+			MaxConns:        10,
+			MinConns:        0,
+			MaxConnLifetime: time.Hour,
+			MaxConnIdleTime: 30 * time.Minute,
+		},
+		Server: ServerConfig{
+			Port:            "8080",
+			ReadTimeout:     10 * time.Second,
+			WriteTimeout:    10 * time.Second,
+			IdleTimeout:     60 * time.Second,
+			ShutdownTimeout: 15 * time.Second,
+		},
+		GRPC: GRPCConfig{
+			Port:       "9090",
+			Reflection: true,
+		},
+		Log: LogConfig{
+			Level: "info",
+		},
+		Tracing: TracingConfig{
+			Exporter: "none",
+		},
+		Assignment: AssignmentConfig{
+			ReviewersPerPR: entities.MaxReviewers,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: true,
+			Store:   "memory",
+			Default: LimitConfig{Rate: 20, Burst: 40},
+			// above Default, clients behind one NAT share it
+			PerIP: LimitConfig{Rate: 100, Burst: 200},
+			Routes: map[string]LimitConfig{
+				// each call reads or aggregates over every pull request of the org
+				"GET /stats/reviewers":    {Rate: 1, Burst: 5},
+				"GET /stats/pullRequests": {Rate: 1, Burst: 5},
+				"GET /stats/sla":          {Rate: 1, Burst: 5},
+				"GET /stats/fairness":     {Rate: 1, Burst: 5},
+				"POST /admin/import":      {Rate: 1, Burst: 5},
+				"GET /admin/export":       {Rate: 1, Burst: 5},
+			},
+		},
+		Scheduler: SchedulerConfig{
+			Enabled:       true,
+			Interval:      time.Minute,
+			RemindAfter:   24 * time.Hour,
+			ReassignAfter: 72 * time.Hour,
+		},
+		Digest: DigestConfig{
+			Enabled: true,
+			SendAt:  "09:00",
+		},
+		Notify: NotifyConfig{
+			Channel:      "log",
+			Workers:      2,
+			QueueSize:    1000,
+			MaxAttempts:  5,
+			RetryBackoff: 2 * time.Second,
+		},
+		Events: EventsConfig{
+			Broker: "memory",
+			Buffer: 64,
+		},
+		Features: FeaturesConfig{
+			Metrics: true,
+			Auth:    true,
+		},
+	}
+}
+
+// URL returns the connection string for pgx and golang-migrate
+func (c DBConfig) URL() string {
+	if c.DSN != "" {
+		return c.DSN
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Password),
+		Host:     fmt.Sprintf("%s:%s", c.Host, c.Port),
+		Path:     c.Name,
+		RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+	}
+	return u.String()
+}
+
+const mask = "****"
+
+// Masked returns a copy safe to print, with credentials replaced
+func (c Config) Masked() Config {
+	if c.DB.Password != "" {
+		c.DB.Password = mask
+	}
+	if c.DB.DSN != "" {
+		c.DB.DSN = maskDSN(c.DB.DSN)
+	}
+	if c.Notify.SMTP.Password != "" {
+		c.Notify.SMTP.Password = mask
+	}
+	// incoming webhooks authorize by their URL
+	if c.Notify.ChatWebhookURL != "" {
+		c.Notify.ChatWebhookURL = maskURL(c.Notify.ChatWebhookURL)
+	}
+	if c.Notify.WebhookURL != "" {
+		c.Notify.WebhookURL = maskURL(c.Notify.WebhookURL)
+	}
+	return c
+}
+
+// maskURL keeps only the scheme and host, the secret may be in the
+// credentials, path or query
+func maskURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return mask
+	}
+	return u.Scheme + "://" + u.Host + "/" + mask
+}
 
-func (c *Config) DBHost() string     { return c.dbHost }
-func (c *Config) DBPort() string     { return c.dbPort }
-func (c *Config) DBUser() string     { return c.dbUser }
-func (c *Config) DBPassword() string { return c.dbPassword }
-func (c *Config) DBName() string     { return c.dbName }
-func (c *Config) Port() string       { return c.port }
-
-func Load() *Config {
-	once.Do(func() {
-		cfg = &Config{
-			dbHost:     env.Must("DB_HOST"),
-			dbPort:     env.Must("DB_PORT"),
-			dbUser:     env.Must("DB_USER"),
-			dbPassword: env.Must("DB_PASSWORD"),
-			dbName:     env.Must("DB_NAME"),
-			port:       env.Fallback("SERVER_PORT", "8080"),
-		}
-	})
-
-	return cfg
+func maskDSN(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err != nil || u.User == nil {
+		// key=value DSNs may carry a password anywhere, hide it entirely
+		return mask
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), mask)
+	}
+	return u.String()
 }
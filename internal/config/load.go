@@ -0,0 +1,243 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// setting binds one config field to its env variable and CLI flag
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	ptr   any
+}
+
+func (c *Config) settings() []setting {
+	return []setting{
+		{"DB_DSN", "db-dsn", "database connection string, overrides db host/port/user/password/name", &c.DB.DSN},
+		{"DB_HOST", "db-host", "database host", &c.DB.Host},
+		{"DB_PORT", "db-port", "database port", &c.DB.Port},
+		{"DB_USER", "db-user", "database user", &c.DB.User},
+		{"DB_PASSWORD", "db-password", "database password", &c.DB.Password},
+		{"DB_NAME", "db-name", "database name", &c.DB.Name},
+		{"DB_SSL_MODE", "db-ssl-mode", "disable, allow, prefer, require, verify-ca or verify-full", &c.DB.SSLMode},
+		{"DB_MAX_CONNS", "db-max-conns", "maximum pool size", &c.DB.MaxConns},
+		{"DB_MIN_CONNS", "db-min-conns", "minimum pool size", &c.DB.MinConns},
+		{"DB_MAX_CONN_LIFETIME", "db-max-conn-lifetime", "maximum connection lifetime", &c.DB.MaxConnLifetime},
+		{"DB_MAX_CONN_IDLE_TIME", "db-max-conn-idle-time", "maximum connection idle time", &c.DB.MaxConnIdleTime},
+		{"AUTO_MIGRATE", "auto-migrate", "apply migrations on startup", &c.DB.AutoMigrate},
+
+		{"SERVER_PORT", "port", "HTTP port", &c.Server.Port},
+		{"SERVER_READ_TIMEOUT", "read-timeout", "HTTP read timeout", &c.Server.ReadTimeout},
+		{"SERVER_WRITE_TIMEOUT", "write-timeout", "HTTP write timeout", &c.Server.WriteTimeout},
+		{"SERVER_IDLE_TIMEOUT", "idle-timeout", "HTTP keep-alive idle timeout", &c.Server.IdleTimeout},
+		{"SHUTDOWN_TIMEOUT", "shutdown-timeout", "time to drain in-flight requests on shutdown", &c.Server.ShutdownTimeout},
+		{"SERVER_TRUSTED_PROXIES", "trusted-proxies", "comma-separated CIDRs of proxies whose X-Forwarded-For is trusted", &c.Server.TrustedProxies},
+
+		{"GRPC_ENABLED", "grpc", "serve the gRPC API", &c.GRPC.Enabled},
+		{"GRPC_PORT", "grpc-port", "gRPC port", &c.GRPC.Port},
+		{"GRPC_REFLECTION", "grpc-reflection", "enable gRPC server reflection", &c.GRPC.Reflection},
+
+		{"LOG_LEVEL", "log-level", "debug, info, warn or error", &c.Log.Level},
+
+		{"TRACING_EXPORTER", "tracing-exporter", "none, stdout or otlp", &c.Tracing.Exporter},
+		{"TRACING_OTLP_ENDPOINT", "tracing-otlp-endpoint", "OTLP/HTTP collector address", &c.Tracing.OTLPEndpoint},
+
+		{"ASSIGNMENT_REVIEWERS_PER_PR", "reviewers-per-pr", "reviewers assigned to a new pull request", &c.Assignment.ReviewersPerPR},
+		{"ASSIGNMENT_ALLOW_SEED", "assignment-allow-seed", "accept X-Assignment-Seed to make reviewer picks reproducible, for tests", &c.Assignment.AllowSeed},
+
+		{"RATE_LIMIT_ENABLED", "rate-limit", "limit requests per client", &c.RateLimit.Enabled},
+		{"RATE_LIMIT_STORE", "rate-limit-store", "memory or postgres", &c.RateLimit.Store},
+		{"RATE_LIMIT_RATE", "rate-limit-rate", "requests per second for routes without their own limit", &c.RateLimit.Default.Rate},
+		{"RATE_LIMIT_BURST", "rate-limit-burst", "burst for routes without their own limit", &c.RateLimit.Default.Burst},
+		{"RATE_LIMIT_IP_RATE", "rate-limit-ip-rate", "requests per second per IP before authentication", &c.RateLimit.PerIP.Rate},
+		{"RATE_LIMIT_IP_BURST", "rate-limit-ip-burst", "burst per IP before authentication", &c.RateLimit.PerIP.Burst},
+
+		{"SCHEDULER_ENABLED", "scheduler", "run background jobs such as review reminders", &c.Scheduler.Enabled},
+		{"SCHEDULER_INTERVAL", "scheduler-interval", "how often background jobs run", &c.Scheduler.Interval},
+		{"REVIEW_REMIND_AFTER", "review-remind-after", "remind reviewers without a verdict after this long", &c.Scheduler.RemindAfter},
+		{"REVIEW_REASSIGN_AFTER", "review-reassign-after", "reassign reviews without a verdict after this long, 0 disables", &c.Scheduler.ReassignAfter},
+
+		{"DIGEST_ENABLED", "digest", "send each user a daily digest of open reviews", &c.Digest.Enabled},
+		{"DIGEST_SEND_AT", "digest-send-at", "time of day (UTC, HH:MM) to send the digest", &c.Digest.SendAt},
+
+		{"NOTIFY_CHANNEL", "notify-channel", "channel for users without preferences: none, log, chat, email, webhook or file", &c.Notify.Channel},
+		{"NOTIFY_CHAT_WEBHOOK_URL", "notify-chat-webhook-url", "Slack or Mattermost incoming webhook", &c.Notify.ChatWebhookURL},
+		{"NOTIFY_WEBHOOK_URL", "notify-webhook-url", "URL messages are POSTed to as JSON", &c.Notify.WebhookURL},
+		{"NOTIFY_FILE", "notify-file", "file messages are appended to", &c.Notify.File},
+		{"SMTP_ADDR", "smtp-addr", "SMTP relay host:port", &c.Notify.SMTP.Addr},
+		{"SMTP_USERNAME", "smtp-username", "SMTP user, empty disables auth", &c.Notify.SMTP.Username},
+		{"SMTP_PASSWORD", "smtp-password", "SMTP password", &c.Notify.SMTP.Password},
+		{"SMTP_FROM", "smtp-from", "sender address", &c.Notify.SMTP.From},
+		{"SMTP_DOMAIN", "smtp-domain", "mail for users without an address goes to <user_id>@domain", &c.Notify.SMTP.Domain},
+		{"NOTIFY_WORKERS", "notify-workers", "delivery workers per channel", &c.Notify.Workers},
+		{"NOTIFY_QUEUE_SIZE", "notify-queue-size", "messages buffered per channel", &c.Notify.QueueSize},
+		{"NOTIFY_MAX_ATTEMPTS", "notify-max-attempts", "delivery attempts before a message is dropped", &c.Notify.MaxAttempts},
+		{"NOTIFY_RETRY_BACKOFF", "notify-retry-backoff", "delay before the first retry, doubled after each", &c.Notify.RetryBackoff},
+
+		{"EVENTS_BROKER", "events-broker", "memory or postgres, the latter shares events between replicas", &c.Events.Broker},
+		{"EVENTS_BUFFER", "events-buffer", "events buffered per stream for slow clients", &c.Events.Buffer},
+
+		{"FEATURE_METRICS", "feature-metrics", "expose /metrics", &c.Features.Metrics},
+		{"FEATURE_AUTH", "feature-auth", "require API tokens", &c.Features.Auth},
+	}
+}
+
+// Load builds the configuration from defaults, then the YAML file, then
+// environment variables, then CLI flags; later sources win. It returns the
+// arguments left after the flags. Every problem found is reported at once.
+func Load(args []string) (*Config, []string, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet("review-assigner", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	configPath := fs.String(
+		"config",
+		os.Getenv("CONFIG_FILE"),
+		"path to a YAML config file",
+	)
+
+	// flags are parsed first to find the config file, and applied last
+	flagValues := make(map[string]string)
+	settings := cfg.settings()
+	for _, s := range settings {
+		fs.Var(&rawFlag{name: s.flag, values: flagValues, isBool: isBool(s.ptr)}, s.flag, s.usage)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	var errs []error
+
+	if *configPath != "" {
+		if err := cfg.loadFile(*configPath); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, s := range settings {
+		if raw, ok := os.LookupEnv(s.env); ok {
+			if err := parseInto(s.ptr, raw); err != nil {
+				errs = append(errs, fmt.Errorf("env %s: %w", s.env, err))
+			}
+		}
+	}
+
+	for _, s := range settings {
+		if raw, ok := flagValues[s.flag]; ok {
+			if err := parseInto(s.ptr, raw); err != nil {
+				errs = append(errs, fmt.Errorf("flag --%s: %w", s.flag, err))
+			}
+		}
+	}
+
+	errs = append(errs, cfg.validate()...)
+
+	return cfg, fs.Args(), errors.Join(errs...)
+}
+
+// Usage describes the global flags
+func Usage(w io.Writer) {
+	fs := flag.NewFlagSet("review-assigner", flag.ContinueOnError)
+	fs.SetOutput(w)
+	fs.String("config", "", "path to a YAML config file (env CONFIG_FILE)")
+	for _, s := range Default().settings() {
+		fs.Var(&rawFlag{isBool: isBool(s.ptr)}, s.flag, fmt.Sprintf("%s (env %s)", s.usage, s.env))
+	}
+	fs.PrintDefaults()
+}
+
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func parseInto(ptr any, raw string) error {
+	switch p := ptr.(type) {
+	case *string:
+		*p = raw
+	case *bool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid bool %q", raw)
+		}
+		*p = v
+	case *int:
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		*p = v
+	case *int32:
+		v, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		*p = int32(v)
+	case *float64:
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", raw)
+		}
+		*p = v
+	case *[]string:
+		var v []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				v = append(v, item)
+			}
+		}
+		*p = v
+	case *time.Duration:
+		v, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", raw)
+		}
+		*p = v
+	default:
+		return fmt.Errorf("unsupported setting type %T", ptr)
+	}
+	return nil
+}
+
+func isBool(ptr any) bool {
+	_, ok := ptr.(*bool)
+	return ok
+}
+
+// rawFlag records the flag value as given, it's parsed after the other
+// sources are applied
+type rawFlag struct {
+	name   string
+	values map[string]string
+	isBool bool
+}
+
+func (f *rawFlag) String() string { return "" }
+
+func (f *rawFlag) Set(raw string) error {
+	f.values[f.name] = raw
+	return nil
+}
+
+func (f *rawFlag) IsBoolFlag() bool { return f.isBool }
@@ -0,0 +1,141 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadLayering(t *testing.T) {
+	path := writeConfig(t, `
+db:
+  dsn: postgres://u:p@db/app
+server:
+  port: "8081"
+  read_timeout: 3s
+log:
+  level: debug
+`)
+	t.Setenv("SERVER_PORT", "8082")
+	t.Setenv("LOG_LEVEL", "warn")
+
+	cfg, rest, err := Load([]string{"-config", path, "-log-level", "error", "serve"})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if cfg.DB.DSN != "postgres://u:p@db/app" {
+		t.Errorf("db.dsn from file = %q", cfg.DB.DSN)
+	}
+	if cfg.Server.ReadTimeout != 3*time.Second {
+		t.Errorf("server.read_timeout from file = %v", cfg.Server.ReadTimeout)
+	}
+	if cfg.Server.Port != "8082" {
+		t.Errorf("server.port: env should beat file, got %q", cfg.Server.Port)
+	}
+	if cfg.Log.Level != "error" {
+		t.Errorf("log.level: flag should beat env, got %q", cfg.Log.Level)
+	}
+	if cfg.Server.WriteTimeout != Default().Server.WriteTimeout {
+		t.Errorf("server.write_timeout should keep its default, got %v", cfg.Server.WriteTimeout)
+	}
+	if len(rest) != 1 || rest[0] != "serve" {
+		t.Errorf("remaining args = %v", rest)
+	}
+}
+
+func TestLoadReportsEveryError(t *testing.T) {
+	path := writeConfig(t, `
+db:
+  dsn: postgres://u:p@db/app
+server:
+  port: "0"
+`)
+	t.Setenv("DB_MAX_CONNS", "many")
+
+	_, _, err := Load([]string{
+		"-config", path,
+		"-log-level", "loud",
+		"-events-buffer", "0",
+	})
+	if err == nil {
+		t.Fatal("Load succeeded, want errors")
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("error %T doesn't wrap a list", err)
+	}
+	if got := len(joined.Unwrap()); got != 4 {
+		t.Errorf("got %d errors, want 4:\n%v", got, err)
+	}
+	for _, want := range []string{
+		`env DB_MAX_CONNS: invalid integer "many"`,
+		`server.port must be a number between 1 and 65535, got "0"`,
+		`log.level must be one of debug, info, warn, error, got "loud"`,
+		"events.buffer must be at least 1, got 0",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("missing %q in:\n%v", want, err)
+		}
+	}
+}
+
+func TestLoadRejectsUnknownFileKeys(t *testing.T) {
+	path := writeConfig(t, `
+db:
+  dsn: postgres://u:p@db/app
+  hots: localhost
+`)
+
+	_, _, err := Load([]string{"-config", path})
+	if err == nil || !strings.Contains(err.Error(), "hots") {
+		t.Fatalf("Load error = %v, want the unknown key reported", err)
+	}
+}
+
+func TestValidateDefaultsNeedDatabase(t *testing.T) {
+	errs := Default().validate()
+
+	var got []string
+	for _, err := range errs {
+		got = append(got, err.Error())
+	}
+	want := []string{
+		"db.host is required when db.dsn is not set",
+		"db.user is required when db.dsn is not set",
+		"db.name is required when db.dsn is not set",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("validate() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestLoadTrustedProxies(t *testing.T) {
+	t.Setenv("DB_DSN", "postgres://u:p@db/app")
+	t.Setenv("SERVER_TRUSTED_PROXIES", "10.0.0.0/8, 192.168.0.0/16")
+
+	cfg, _, err := Load(nil)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got := strings.Join(cfg.Server.TrustedProxies, " "); got != "10.0.0.0/8 192.168.0.0/16" {
+		t.Errorf("server.trusted_proxies = %q", got)
+	}
+
+	t.Setenv("SERVER_TRUSTED_PROXIES", "10.0.0.1")
+	if _, _, err := Load(nil); err == nil || !strings.Contains(err.Error(), "server.trusted_proxies") {
+		t.Errorf("Load error = %v, want a bad CIDR reported", err)
+	}
+}
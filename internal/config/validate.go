@@ -0,0 +1,207 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+var (
+	sslModes     = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+	logLevels    = []string{"debug", "info", "warn", "warning", "error"}
+	tracingKinds = []string{"none", "stdout", "otlp"}
+	limitStores  = []string{"memory", "postgres"}
+	notifyKinds  = []string{"none", "log", "chat", "email", "webhook", "file"}
+	eventBrokers = []string{"memory", "postgres"}
+)
+
+func (c *Config) validate() []error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.DB.DSN == "" {
+		if c.DB.Host == "" {
+			fail("db.host is required when db.dsn is not set")
+		}
+		if c.DB.User == "" {
+			fail("db.user is required when db.dsn is not set")
+		}
+		if c.DB.Name == "" {
+			fail("db.name is required when db.dsn is not set")
+		}
+		if !validPort(c.DB.Port) {
+			fail("db.port must be a number between 1 and 65535, got %q", c.DB.Port)
+		}
+		if !slices.Contains(sslModes, c.DB.SSLMode) {
+			fail("db.ssl_mode must be one of %s, got %q", strings.Join(sslModes, ", "), c.DB.SSLMode)
+		}
+	}
+	if c.DB.MaxConns < 1 {
+		fail("db.max_conns must be positive, got %d", c.DB.MaxConns)
+	}
+	if c.DB.MinConns < 0 || c.DB.MinConns > c.DB.MaxConns {
+		fail("db.min_conns must be between 0 and db.max_conns, got %d", c.DB.MinConns)
+	}
+	if c.DB.MaxConnLifetime <= 0 {
+		fail("db.max_conn_lifetime must be positive")
+	}
+	if c.DB.MaxConnIdleTime <= 0 {
+		fail("db.max_conn_idle_time must be positive")
+	}
+
+	if !validPort(c.Server.Port) {
+		fail("server.port must be a number between 1 and 65535, got %q", c.Server.Port)
+	}
+	if c.Server.ReadTimeout < 0 || c.Server.WriteTimeout < 0 || c.Server.IdleTimeout < 0 {
+		fail("server timeouts can't be negative")
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		fail("server.shutdown_timeout must be positive")
+	}
+	for _, cidr := range c.Server.TrustedProxies {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			fail("server.trusted_proxies must be CIDRs, got %q", cidr)
+		}
+	}
+
+	if c.GRPC.Enabled {
+		if !validPort(c.GRPC.Port) {
+			fail("grpc.port must be a number between 1 and 65535, got %q", c.GRPC.Port)
+		}
+		if c.GRPC.Port == c.Server.Port {
+			fail("grpc.port must differ from server.port")
+		}
+	}
+
+	if !slices.Contains(logLevels, strings.ToLower(c.Log.Level)) {
+		fail("log.level must be one of debug, info, warn, error, got %q", c.Log.Level)
+	}
+
+	if !slices.Contains(tracingKinds, c.Tracing.Exporter) {
+		fail("tracing.exporter must be one of %s, got %q", strings.Join(tracingKinds, ", "), c.Tracing.Exporter)
+	}
+
+	if c.Assignment.ReviewersPerPR < 1 || c.Assignment.ReviewersPerPR > entities.MaxReviewers {
+		fail("assignment.reviewers_per_pr must be between 1 and %d, got %d", entities.MaxReviewers, c.Assignment.ReviewersPerPR)
+	}
+
+	if c.RateLimit.Enabled {
+		if !slices.Contains(limitStores, c.RateLimit.Store) {
+			fail("rate_limit.store must be one of %s, got %q", strings.Join(limitStores, ", "), c.RateLimit.Store)
+		}
+		errs = append(errs, c.RateLimit.Default.validate("rate_limit.default")...)
+		errs = append(errs, c.RateLimit.PerIP.validate("rate_limit.per_ip")...)
+		for _, route := range slices.Sorted(maps.Keys(c.RateLimit.Routes)) {
+			limit := c.RateLimit.Routes[route]
+			method, path, ok := strings.Cut(route, " ")
+			if !ok || method == "" || !strings.HasPrefix(path, "/") {
+				fail("rate_limit.routes key must look like \"GET /path\", got %q", route)
+			}
+			errs = append(errs, limit.validate(fmt.Sprintf("rate_limit.routes[%s]", route))...)
+		}
+	}
+
+	if c.Scheduler.Enabled {
+		if c.Scheduler.Interval <= 0 {
+			fail("scheduler.interval must be positive")
+		}
+		if _, err := entities.NewReviewSLA(c.Scheduler.RemindAfter, c.Scheduler.ReassignAfter); err != nil {
+			fail("scheduler: %v", err)
+		}
+	}
+
+	if c.Digest.Enabled {
+		if _, err := time.Parse("15:04", c.Digest.SendAt); err != nil {
+			fail("digest.send_at must look like HH:MM, got %q", c.Digest.SendAt)
+		}
+	}
+
+	switch c.Notify.Channel {
+	case "chat":
+		if c.Notify.ChatWebhookURL == "" {
+			fail("notify.chat_webhook_url is required for the chat channel")
+		}
+	case "email":
+		if c.Notify.SMTP.Addr == "" {
+			fail("notify.smtp.addr is required for the email channel")
+		}
+		if c.Notify.SMTP.Domain == "" {
+			fail("notify.smtp.domain is required for the email channel")
+		}
+	case "webhook":
+		if !validURL(c.Notify.WebhookURL) {
+			fail("notify.webhook_url must be an absolute URL, got %q", c.Notify.WebhookURL)
+		}
+	case "file":
+		if c.Notify.File == "" {
+			fail("notify.file is required")
+		}
+	case "none", "log":
+	default:
+		fail("notify.channel must be one of %s, got %q", strings.Join(notifyKinds, ", "), c.Notify.Channel)
+	}
+	if c.Notify.ChatWebhookURL != "" && !validURL(c.Notify.ChatWebhookURL) {
+		fail("notify.chat_webhook_url must be an absolute URL, got %q", c.Notify.ChatWebhookURL)
+	}
+	// users may pick email in their preferences, so the relay is checked
+	// whenever it's set
+	if c.Notify.SMTP.Addr != "" {
+		if _, _, err := net.SplitHostPort(c.Notify.SMTP.Addr); err != nil {
+			fail("notify.smtp.addr must be host:port, got %q", c.Notify.SMTP.Addr)
+		}
+		if c.Notify.SMTP.From == "" {
+			fail("notify.smtp.from is required")
+		}
+	}
+	if c.Notify.Workers < 1 {
+		fail("notify.workers must be at least 1, got %d", c.Notify.Workers)
+	}
+	if c.Notify.QueueSize < 1 {
+		fail("notify.queue_size must be at least 1, got %d", c.Notify.QueueSize)
+	}
+	if c.Notify.MaxAttempts < 1 {
+		fail("notify.max_attempts must be at least 1, got %d", c.Notify.MaxAttempts)
+	}
+	if c.Notify.RetryBackoff <= 0 {
+		fail("notify.retry_backoff must be positive")
+	}
+
+	if !slices.Contains(eventBrokers, c.Events.Broker) {
+		fail("events.broker must be one of %s, got %q", strings.Join(eventBrokers, ", "), c.Events.Broker)
+	}
+	if c.Events.Buffer < 1 {
+		fail("events.buffer must be at least 1, got %d", c.Events.Buffer)
+	}
+
+	return errs
+}
+
+func (l LimitConfig) validate(name string) []error {
+	var errs []error
+	if l.Rate <= 0 {
+		errs = append(errs, fmt.Errorf("%s.rate must be positive, got %v", name, l.Rate))
+	}
+	if l.Burst < 1 {
+		errs = append(errs, fmt.Errorf("%s.burst must be at least 1, got %d", name, l.Burst))
+	}
+	return errs
+}
+
+func validURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+func validPort(port string) bool {
+	p, err := strconv.Atoi(port)
+	return err == nil && p > 0 && p <= 65535
+}
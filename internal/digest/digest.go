@@ -0,0 +1,122 @@
+// Package digest renders the daily list of reviews waiting for a user.
+package digest
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type Format string
+
+const (
+	FormatText     Format = "text"
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+)
+
+var ErrUnknownFormat = errors.New("format must be text, markdown or html")
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatText, FormatMarkdown, FormatHTML:
+		return f, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// ContentType is the MIME type of a rendered digest
+func (f Format) ContentType() string {
+	switch f {
+	case FormatMarkdown:
+		return "text/markdown; charset=utf-8"
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+//go:embed templates
+var templateFS embed.FS
+
+var funcs = map[string]any{
+	"age":     formatAge,
+	"verdict": formatVerdict,
+	"date":    date,
+}
+
+var (
+	textTmpl = texttemplate.Must(
+		texttemplate.New("digest.txt.tmpl").Funcs(funcs).ParseFS(templateFS, "templates/digest.txt.tmpl"),
+	)
+	markdownTmpl = texttemplate.Must(
+		texttemplate.New("digest.md.tmpl").Funcs(funcs).ParseFS(templateFS, "templates/digest.md.tmpl"),
+	)
+	htmlTmpl = htmltemplate.Must(
+		htmltemplate.New("digest.html.tmpl").Funcs(funcs).ParseFS(templateFS, "templates/digest.html.tmpl"),
+	)
+)
+
+func Render(format Format, d dto.DigestDTO) (string, error) {
+	var b strings.Builder
+	var err error
+	switch format {
+	case FormatText:
+		err = textTmpl.Execute(&b, d)
+	case FormatMarkdown:
+		err = markdownTmpl.Execute(&b, d)
+	case FormatHTML:
+		err = htmlTmpl.Execute(&b, d)
+	default:
+		return "", ErrUnknownFormat
+	}
+	if err != nil {
+		return "", fmt.Errorf("render %s digest: %w", format, err)
+	}
+	return b.String(), nil
+}
+
+// Subject is the one-line summary used as a mail subject
+func Subject(d dto.DigestDTO) string {
+	return fmt.Sprintf("Review digest for %s: %d open pull request(s)", date(d.GeneratedAt), len(d.Items))
+}
+
+func date(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+// formatAge keeps two units at most, e.g. 3d 4h or 2h 15m
+func formatAge(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+func formatVerdict(v entities.Verdict) string {
+	switch v {
+	case entities.VerdictApproved:
+		return "approved"
+	case entities.VerdictChangesRequested:
+		return "changes requested"
+	default:
+		return "pending"
+	}
+}
@@ -0,0 +1,28 @@
+package entities
+
+// ExclusionReason says why a team member can't review a pull request
+type ExclusionReason string
+
+const (
+	ExcludedAuthor      ExclusionReason = "author"
+	ExcludedInactive    ExclusionReason = "inactive"
+	ExcludedOutOfOffice ExclusionReason = "out_of_office"
+	ExcludedAtCapacity  ExclusionReason = "at_capacity"
+)
+
+// Exclusion is a team member left out of the candidate pool
+type Exclusion struct {
+	UserID UserID
+	Reason ExclusionReason
+}
+
+// AssignmentPreview is what assigning reviewers to a new pull request by the
+// author would do right now, nothing of it is persisted
+type AssignmentPreview struct {
+	AuthorID   UserID
+	TeamName   string
+	Candidates []UserID
+	Excluded   []Exclusion
+	Reviewers  []UserID
+	Seed       uint64
+}
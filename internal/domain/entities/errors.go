@@ -5,8 +5,10 @@ import "errors"
 var (
 	ErrPRMerged            = errors.New("pull request is already merged")
 	ErrReviewerNotAssigned = errors.New("reviewer is not assigned to this PR")
+	ErrReviewerAssigned    = errors.New("reviewer is already assigned to this PR")
 	ErrUserNoID            = errors.New("user: no user_id")
 	ErrUserNoUsername      = errors.New("user: no username")
+	ErrUserMaxOpenReviews  = errors.New("user: max_open_reviews must be between 0 and 2147483647")
 	ErrTeamNoName          = errors.New("team: no team name")
 	ErrTeamPresent         = errors.New("team: user already in this team")
 	ErrPRNoID              = errors.New("pr: no pull_request_id")
@@ -14,4 +16,13 @@ var (
 	ErrPRNoAuthor          = errors.New("pr: no author_id")
 	ErrPRTooManyReviewers  = errors.New("pr: too many reviewers")
 	ErrAuthorIsReviewer    = errors.New("pr: can't assign author as reviewer")
+	ErrInvalidVerdict      = errors.New("pr: verdict must be APPROVED or CHANGES_REQUESTED")
+	ErrTokenNoName         = errors.New("token: no name")
+	ErrTokenInvalidRole    = errors.New("token: role must be admin, bot or member")
+	ErrTokenNoUser         = errors.New("token: member token needs a user_id")
+	ErrTokenUserNoOrg      = errors.New("token: a token tied to a user needs an organization")
+	ErrOrgNoName           = errors.New("organization: no name")
+	ErrInvalidChannel      = errors.New("notification: channel must be chat or email")
+	ErrReviewSLARemind     = errors.New("review sla: remind_after must be positive")
+	ErrReviewSLAReassign   = errors.New("review sla: reassign_after must be 0 or greater than remind_after")
 )
@@ -0,0 +1,37 @@
+package entities
+
+type NotificationChannel string
+
+const (
+	// ChannelChat posts to a Slack or Mattermost compatible incoming webhook
+	ChannelChat  NotificationChannel = "chat"
+	ChannelEmail NotificationChannel = "email"
+)
+
+func (c NotificationChannel) Valid() bool {
+	return c == ChannelChat || c == ChannelEmail
+}
+
+// NotificationPreference is how a user wants to hear about their reviews on
+// one channel, an empty Address falls back to the service default
+type NotificationPreference struct {
+	Channel NotificationChannel
+	Address string
+	Enabled bool
+}
+
+func NewNotificationPreference(
+	channel NotificationChannel,
+	address string,
+	enabled bool,
+) (NotificationPreference, error) {
+	if !channel.Valid() {
+		return NotificationPreference{}, ErrInvalidChannel
+	}
+
+	return NotificationPreference{
+		Channel: channel,
+		Address: address,
+		Enabled: enabled,
+	}, nil
+}
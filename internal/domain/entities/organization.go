@@ -0,0 +1,43 @@
+package entities
+
+import "time"
+
+type OrgID int
+
+// DefaultOrgID is the organization pre-existing data was migrated into, it's
+// used when a request doesn't name one
+const DefaultOrgID OrgID = 1
+
+type Organization struct {
+	id        OrgID
+	name      string
+	createdAt time.Time
+}
+
+func NewOrganization(
+	id OrgID,
+	name string,
+	createdAt time.Time,
+) (*Organization, error) {
+	if name == "" {
+		return nil, ErrOrgNoName
+	}
+
+	return &Organization{
+		id:        id,
+		name:      name,
+		createdAt: createdAt,
+	}, nil
+}
+
+func (o *Organization) ID() OrgID {
+	return o.id
+}
+
+func (o *Organization) Name() string {
+	return o.name
+}
+
+func (o *Organization) CreatedAt() time.Time {
+	return o.createdAt
+}
@@ -10,6 +10,25 @@ const MaxReviewers = 2
 type Reviewer struct {
 	UserID     UserID
 	AssignedAt time.Time
+	// Verdict is empty until the reviewer responds
+	Verdict   Verdict
+	VerdictAt *time.Time
+	// Seed of the random pick that chose the reviewer, nil when the
+	// reviewer wasn't picked at random
+	Seed *uint64
+	// AssignedBy is the actor that assigned the reviewer, empty when unknown
+	AssignedBy string
+}
+
+// ReviewerRemoval is a reviewer taken off the pull request, repositories
+// record it when they save the pull request without the reviewer
+type ReviewerRemoval struct {
+	UserID UserID
+	// ReplacedBy is the reviewer taking over, empty when nobody does
+	ReplacedBy UserID
+	// RemovedBy is the actor that removed the reviewer, empty when unknown
+	RemovedBy string
+	RemovedAt time.Time
 }
 
 type PullRequest struct {
@@ -20,6 +39,8 @@ type PullRequest struct {
 	reviewers []Reviewer
 	createdAt time.Time
 	mergedAt  *time.Time
+	// removals since the pull request was loaded
+	removals []ReviewerRemoval
 }
 
 func NewPullRequest(
@@ -96,63 +117,92 @@ func (pr *PullRequest) IsAssigneeValid(id UserID) error {
 	return nil
 }
 
-func (pr *PullRequest) AssignReviewer(id UserID) error {
-	if err := pr.IsAssigneeValid(id); err != nil {
-		return err
+// AssignReviewer assigns the reviewer on behalf of the actor
+func (pr *PullRequest) AssignReviewer(id UserID, actor string) error {
+	if pr.HasReviewer(id) {
+		return ErrReviewerAssigned
 	}
 
-	if slices.Contains(pr.reviewers, Reviewer{UserID: id}) {
-		return ErrTeamPresent
+	if err := pr.IsAssigneeValid(id); err != nil {
+		return err
 	}
 
 	pr.reviewers = append(pr.reviewers, Reviewer{
 		UserID:     id,
 		AssignedAt: time.Now(),
+		AssignedBy: actor,
 	})
 
 	return nil
 }
 
-func (pr *PullRequest) ReassignReviewer(
-	oldUserID UserID,
-	newUserID UserID,
+// AssignSelectedReviewer assigns a reviewer picked at random and keeps the
+// seed so the pick can be replayed
+func (pr *PullRequest) AssignSelectedReviewer(
+	id UserID,
+	seed uint64,
+	actor string,
+) error {
+	if err := pr.AssignReviewer(id, actor); err != nil {
+		return err
+	}
+	pr.reviewers[len(pr.reviewers)-1].Seed = &seed
+	return nil
+}
+
+// RecordVerdict stores the reviewer's latest verdict
+func (pr *PullRequest) RecordVerdict(
+	id UserID,
+	verdict Verdict,
+	at time.Time,
 ) error {
 	if pr.status == StatusMerged {
 		return ErrPRMerged
 	}
+	if !verdict.Valid() {
+		return ErrInvalidVerdict
+	}
 
-	idx := -1
 	for i, r := range pr.reviewers {
-		if r.UserID == oldUserID {
-			idx = i
-			break
+		if r.UserID == id {
+			pr.reviewers[i].Verdict = verdict
+			pr.reviewers[i].VerdictAt = &at
+			return nil
 		}
 	}
 
-	if idx == -1 {
-		return ErrReviewerNotAssigned
-	}
-
-	pr.reviewers[idx] = Reviewer{
-		UserID:     newUserID,
-		AssignedAt: time.Now(),
-	}
-
-	return nil
+	return ErrReviewerNotAssigned
 }
 
-func (pr *PullRequest) UnassignReviewer(id UserID) error {
+// UnassignReviewer removes the reviewer on behalf of the actor, replacedBy
+// is the reviewer taking over or empty
+func (pr *PullRequest) UnassignReviewer(id UserID, replacedBy UserID, actor string) error {
 	if pr.status == StatusMerged {
 		return ErrPRMerged
 	}
+	if !pr.HasReviewer(id) {
+		return nil
+	}
 
 	pr.reviewers = slices.DeleteFunc(pr.reviewers, func(r Reviewer) bool {
 		return r.UserID == id
 	})
+	pr.removals = append(pr.removals, ReviewerRemoval{
+		UserID:     id,
+		ReplacedBy: replacedBy,
+		RemovedBy:  actor,
+		RemovedAt:  time.Now(),
+	})
 
 	return nil
 }
 
+// Removals returns the reviewers unassigned since the pull request was
+// loaded, oldest first
+func (pr *PullRequest) Removals() []ReviewerRemoval {
+	return pr.removals
+}
+
 func (pr *PullRequest) ReviewerIDs() []UserID {
 	ids := make([]UserID, len(pr.reviewers))
 	for i, r := range pr.reviewers {
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func newTestPR(t *testing.T, reviewers ...UserID) *PullRequest {
+	t.Helper()
+	assigned := make([]Reviewer, len(reviewers))
+	for i, id := range reviewers {
+		assigned[i] = Reviewer{UserID: id, AssignedAt: time.Now()}
+	}
+	pr, err := NewPullRequest("pr-1", "Fix", "u1", StatusOpen, assigned, time.Now(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pr
+}
+
+func TestUnassignReviewerRecordsRemoval(t *testing.T) {
+	pr := newTestPR(t, "u2", "u3")
+
+	if err := pr.UnassignReviewer("u2", "u4", "u9"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pr.UnassignReviewer("u3", "", "token:ci"); err != nil {
+		t.Fatal(err)
+	}
+	// not a reviewer, nothing to record
+	if err := pr.UnassignReviewer("u5", "", "u9"); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := pr.ReviewerIDs(); len(ids) != 0 {
+		t.Errorf("reviewers left: %v", ids)
+	}
+	removals := pr.Removals()
+	if len(removals) != 2 {
+		t.Fatalf("got %d removals, want 2: %+v", len(removals), removals)
+	}
+	want := []ReviewerRemoval{
+		{UserID: "u2", ReplacedBy: "u4", RemovedBy: "u9"},
+		{UserID: "u3", ReplacedBy: "", RemovedBy: "token:ci"},
+	}
+	for i, removal := range removals {
+		if removal.RemovedAt.IsZero() {
+			t.Errorf("removal %d has no time", i)
+		}
+		removal.RemovedAt = time.Time{}
+		if removal != want[i] {
+			t.Errorf("removal %d = %+v, want %+v", i, removal, want[i])
+		}
+	}
+}
+
+func TestUnassignReviewerMerged(t *testing.T) {
+	pr := newTestPR(t, "u2")
+	pr.Merge()
+
+	if err := pr.UnassignReviewer("u2", "", "u9"); !errors.Is(err, ErrPRMerged) {
+		t.Fatalf("got %v, want ErrPRMerged", err)
+	}
+	if !slices.Equal(pr.ReviewerIDs(), []UserID{"u2"}) || len(pr.Removals()) != 0 {
+		t.Errorf("merged pull request changed: %v, %+v", pr.ReviewerIDs(), pr.Removals())
+	}
+}
@@ -0,0 +1,50 @@
+package entities
+
+import "time"
+
+// ReviewSLA is how long a reviewer may stay silent before being reminded and
+// before the review is handed to someone else
+type ReviewSLA struct {
+	RemindAfter time.Duration
+	// zero turns automatic reassignment off
+	ReassignAfter time.Duration
+}
+
+func NewReviewSLA(remindAfter, reassignAfter time.Duration) (ReviewSLA, error) {
+	if remindAfter <= 0 {
+		return ReviewSLA{}, ErrReviewSLARemind
+	}
+	if reassignAfter != 0 && reassignAfter <= remindAfter {
+		return ReviewSLA{}, ErrReviewSLAReassign
+	}
+
+	return ReviewSLA{
+		RemindAfter:   remindAfter,
+		ReassignAfter: reassignAfter,
+	}, nil
+}
+
+// OverdueReview is an assignment on an open pull request with no verdict
+// past its team's reminder threshold
+type OverdueReview struct {
+	PullRequestID   PullRequestID
+	PullRequestName string
+	AuthorID        UserID
+	ReviewerID      UserID
+	TeamName        string
+	AssignedAt      time.Time
+	RemindedAt      *time.Time
+	SLA             ReviewSLA
+}
+
+func (r OverdueReview) Waiting(now time.Time) time.Duration {
+	return now.Sub(r.AssignedAt)
+}
+
+func (r OverdueReview) NeedsReminder(now time.Time) bool {
+	return r.RemindedAt == nil && r.Waiting(now) >= r.SLA.RemindAfter
+}
+
+func (r OverdueReview) NeedsReassign(now time.Time) bool {
+	return r.SLA.ReassignAfter > 0 && r.Waiting(now) >= r.SLA.ReassignAfter
+}
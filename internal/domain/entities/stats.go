@@ -0,0 +1,144 @@
+package entities
+
+import (
+	"math"
+	"time"
+)
+
+// TimeWindow bounds reports by pull request creation time, nil ends are open
+type TimeWindow struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// DurationPercentiles summarises a set of durations, percentiles are zero
+// when Samples is zero
+type DurationPercentiles struct {
+	Samples int
+	P50     time.Duration
+	P90     time.Duration
+	P99     time.Duration
+}
+
+// SLABreakdown is one SLA metric overall, per team and per reviewer
+type SLABreakdown struct {
+	Overall    DurationPercentiles
+	ByTeam     map[string]DurationPercentiles
+	ByReviewer map[UserID]DurationPercentiles
+}
+
+type SLAReport struct {
+	TimeToMerge         SLABreakdown
+	TimeToFirstReview   SLABreakdown
+	AssignmentToVerdict SLABreakdown
+}
+
+// ReviewerStats counts a user's review assignments by pull request status
+type ReviewerStats struct {
+	UserID   UserID
+	Username string
+	TeamName string
+	IsActive bool
+	Total    int
+	Open     int
+	Merged   int
+}
+
+// PullRequestCounts counts pull requests by status and the reviewers
+// assigned to them
+type PullRequestCounts struct {
+	Total     int
+	Open      int
+	Merged    int
+	Reviewers int
+}
+
+// PullRequestStats is PullRequestCounts overall and per author team
+type PullRequestStats struct {
+	PullRequestCounts
+	ByTeam map[string]PullRequestCounts
+}
+
+// MemberWorkload is a team member's active time and review assignments
+// inside a report window
+type MemberWorkload struct {
+	UserID      UserID
+	Username    string
+	IsActive    bool
+	ActiveTime  time.Duration
+	Assignments int
+}
+
+// MemberFairness compares a member's assignments to their share of the
+// team's active time
+type MemberFairness struct {
+	MemberWorkload
+	ExpectedShare       float64
+	ExpectedAssignments float64
+	// Assignments minus ExpectedAssignments
+	Deviation float64
+}
+
+type FairnessReport struct {
+	TeamName         string
+	TotalAssignments int
+	// Gini coefficient of assignments per active hour, 0 is perfectly even
+	Gini    float64
+	Members []MemberFairness
+}
+
+// NewFairnessReport spreads the team's assignments over members in
+// proportion to their active time and measures how far reality is from that
+func NewFairnessReport(teamName string, members []MemberWorkload) *FairnessReport {
+	report := &FairnessReport{
+		TeamName: teamName,
+		Members:  make([]MemberFairness, len(members)),
+	}
+
+	var activeTotal time.Duration
+	for _, m := range members {
+		report.TotalAssignments += m.Assignments
+		activeTotal += m.ActiveTime
+	}
+
+	// members who were never active in the window take no part in the
+	// coefficient, their assignments still show up as deviation
+	rates := make([]float64, 0, len(members))
+	for i, m := range members {
+		mf := MemberFairness{MemberWorkload: m}
+		if activeTotal > 0 {
+			mf.ExpectedShare = float64(m.ActiveTime) / float64(activeTotal)
+		}
+		mf.ExpectedAssignments = mf.ExpectedShare * float64(report.TotalAssignments)
+		mf.Deviation = float64(m.Assignments) - mf.ExpectedAssignments
+		report.Members[i] = mf
+
+		if m.ActiveTime > 0 {
+			rates = append(rates, float64(m.Assignments)/m.ActiveTime.Hours())
+		}
+	}
+	report.Gini = gini(rates)
+
+	return report
+}
+
+// gini is the mean absolute difference between all pairs over twice the mean
+func gini(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var sum, diffs float64
+	for _, a := range values {
+		sum += a
+		for _, b := range values {
+			diffs += math.Abs(a - b)
+		}
+	}
+	if sum == 0 {
+		return 0
+	}
+
+	n := float64(len(values))
+	return diffs / (2 * n * sum)
+}
@@ -0,0 +1,150 @@
+package entities
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+const epsilon = 1e-9
+
+func approx(a, b float64) bool {
+	return math.Abs(a-b) < epsilon
+}
+
+func TestGini(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   float64
+	}{
+		{"empty", nil, 0},
+		{"single", []float64{3}, 0},
+		{"all zero", []float64{0, 0, 0}, 0},
+		{"even", []float64{2, 2, 2, 2}, 0},
+		{"one of two takes all", []float64{0, 4}, 0.5},
+		{"one of four takes all", []float64{0, 0, 0, 1}, 0.75},
+		{"uneven", []float64{1, 2, 3}, 2.0 / 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gini(tt.values); !approx(got, tt.want) {
+				t.Errorf("gini(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFairnessReport(t *testing.T) {
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name      string
+		members   []MemberWorkload
+		wantGini  float64
+		wantTotal int
+		// per member, in order
+		wantShare     []float64
+		wantDeviation []float64
+	}{
+		{
+			name:          "no members",
+			members:       nil,
+			wantShare:     []float64{},
+			wantDeviation: []float64{},
+		},
+		{
+			name: "single member",
+			members: []MemberWorkload{
+				{UserID: "u1", ActiveTime: day, Assignments: 5},
+			},
+			wantTotal:     5,
+			wantShare:     []float64{1},
+			wantDeviation: []float64{0},
+		},
+		{
+			name: "proportional to active time",
+			members: []MemberWorkload{
+				{UserID: "u1", ActiveTime: 2 * day, Assignments: 4},
+				{UserID: "u2", ActiveTime: day, Assignments: 2},
+			},
+			wantTotal:     6,
+			wantShare:     []float64{2.0 / 3, 1.0 / 3},
+			wantDeviation: []float64{0, 0},
+		},
+		{
+			name: "nobody was assigned",
+			members: []MemberWorkload{
+				{UserID: "u1", ActiveTime: day},
+				{UserID: "u2", ActiveTime: 3 * day},
+			},
+			wantShare:     []float64{0.25, 0.75},
+			wantDeviation: []float64{0, 0},
+		},
+		{
+			// u2 gets no share, but their assignments count as deviation
+			// and stay out of the coefficient
+			name: "member with zero active time",
+			members: []MemberWorkload{
+				{UserID: "u1", ActiveTime: day, Assignments: 3},
+				{UserID: "u2", Assignments: 1},
+			},
+			wantTotal:     4,
+			wantShare:     []float64{1, 0},
+			wantDeviation: []float64{-1, 1},
+		},
+		{
+			name: "everyone had zero active time",
+			members: []MemberWorkload{
+				{UserID: "u1", Assignments: 2},
+				{UserID: "u2"},
+			},
+			wantTotal:     2,
+			wantShare:     []float64{0, 0},
+			wantDeviation: []float64{2, 0},
+		},
+		{
+			name: "one member takes every review",
+			members: []MemberWorkload{
+				{UserID: "u1", ActiveTime: day, Assignments: 4},
+				{UserID: "u2", ActiveTime: day},
+			},
+			wantGini:      0.5,
+			wantTotal:     4,
+			wantShare:     []float64{0.5, 0.5},
+			wantDeviation: []float64{2, -2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewFairnessReport("backend", tt.members)
+
+			if report.TeamName != "backend" {
+				t.Errorf("TeamName = %q", report.TeamName)
+			}
+			if report.TotalAssignments != tt.wantTotal {
+				t.Errorf("TotalAssignments = %d, want %d", report.TotalAssignments, tt.wantTotal)
+			}
+			if !approx(report.Gini, tt.wantGini) {
+				t.Errorf("Gini = %v, want %v", report.Gini, tt.wantGini)
+			}
+			if len(report.Members) != len(tt.wantShare) {
+				t.Fatalf("got %d members, want %d", len(report.Members), len(tt.wantShare))
+			}
+			for i, m := range report.Members {
+				if m.UserID != tt.members[i].UserID {
+					t.Errorf("member %d is %s, want %s", i, m.UserID, tt.members[i].UserID)
+				}
+				if !approx(m.ExpectedShare, tt.wantShare[i]) {
+					t.Errorf("%s: ExpectedShare = %v, want %v", m.UserID, m.ExpectedShare, tt.wantShare[i])
+				}
+				if !approx(m.ExpectedAssignments, tt.wantShare[i]*float64(tt.wantTotal)) {
+					t.Errorf("%s: ExpectedAssignments = %v", m.UserID, m.ExpectedAssignments)
+				}
+				if !approx(m.Deviation, tt.wantDeviation[i]) {
+					t.Errorf("%s: Deviation = %v, want %v", m.UserID, m.Deviation, tt.wantDeviation[i])
+				}
+			}
+		})
+	}
+}
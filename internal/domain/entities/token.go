@@ -0,0 +1,147 @@
+package entities
+
+import "time"
+
+type TokenID int
+
+type Role string
+
+const (
+	// RoleAdmin manages teams, users and tokens and can do anything a bot can
+	RoleAdmin Role = "admin"
+	// RoleBot drives the pull request lifecycle from CI
+	RoleBot Role = "bot"
+	// RoleMember is a person reading their own reviews
+	RoleMember Role = "member"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) Valid() bool {
+	switch r {
+	case RoleAdmin, RoleBot, RoleMember:
+		return true
+	}
+	return false
+}
+
+type APIToken struct {
+	id        TokenID
+	name      string
+	role      Role
+	userID    *UserID
+	orgID     *OrgID
+	createdAt time.Time
+	revokedAt *time.Time
+}
+
+func NewAPIToken(
+	id TokenID,
+	name string,
+	role Role,
+	userID *UserID,
+	orgID *OrgID,
+	createdAt time.Time,
+	revokedAt *time.Time,
+) (*APIToken, error) {
+	token := &APIToken{
+		id:        id,
+		name:      name,
+		role:      role,
+		userID:    userID,
+		orgID:     orgID,
+		createdAt: createdAt,
+		revokedAt: revokedAt,
+	}
+
+	if err := token.validate(); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (t *APIToken) validate() error {
+	if t.name == "" {
+		return ErrTokenNoName
+	}
+	if !t.role.Valid() {
+		return ErrTokenInvalidRole
+	}
+	if t.role == RoleMember && t.userID == nil {
+		return ErrTokenNoUser
+	}
+	if t.userID != nil && t.orgID == nil {
+		return ErrTokenUserNoOrg
+	}
+	return nil
+}
+
+func (t *APIToken) ID() TokenID {
+	return t.id
+}
+
+func (t *APIToken) Name() string {
+	return t.name
+}
+
+func (t *APIToken) Role() Role {
+	return t.role
+}
+
+// UserID is the user a member token acts as, nil for admin and bot tokens
+// that aren't tied to a person
+func (t *APIToken) UserID() *UserID {
+	return t.userID
+}
+
+// OrgID is the organization the token is bound to, nil for instance-wide
+// tokens that choose one per request
+func (t *APIToken) OrgID() *OrgID {
+	return t.orgID
+}
+
+func (t *APIToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *APIToken) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
+func (t *APIToken) IsRevoked() bool {
+	return t.revokedAt != nil
+}
+
+// HasRole reports whether the token may act with the given role, admins
+// pass every check
+func (t *APIToken) HasRole(roles ...Role) bool {
+	if t.role == RoleAdmin {
+		return true
+	}
+	for _, r := range roles {
+		if t.role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// ActsAs reports whether the token may access data owned by the user,
+// only member tokens are restricted to themselves
+func (t *APIToken) ActsAs(id UserID) bool {
+	if t.role != RoleMember {
+		return true
+	}
+	return t.userID != nil && *t.userID == id
+}
+
+// Actor names the token in records of who changed what: the user_id of a
+// token tied to a user, token:NAME otherwise
+func (t *APIToken) Actor() string {
+	if t.userID != nil {
+		return t.userID.String()
+	}
+	return "token:" + t.name
+}
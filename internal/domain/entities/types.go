@@ -11,6 +11,17 @@ const (
 	StatusMerged PRStatus = "MERGED"
 )
 
+type Verdict string
+
+const (
+	VerdictApproved         Verdict = "APPROVED"
+	VerdictChangesRequested Verdict = "CHANGES_REQUESTED"
+)
+
+func (v Verdict) Valid() bool {
+	return v == VerdictApproved || v == VerdictChangesRequested
+}
+
 func (s PRStatus) String() string {
 	return string(s)
 }
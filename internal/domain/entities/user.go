@@ -1,10 +1,22 @@
 package entities
 
+import (
+	"math"
+	"time"
+)
+
+// MaxOpenReviewsLimit is the largest max_open_reviews, it's stored as INTEGER
+const MaxOpenReviewsLimit = math.MaxInt32
+
 type User struct {
 	user_id   UserID
 	username  string
 	is_active bool
 	team_id   *TeamID
+	// nil for no limit
+	maxOpenReviews *int
+	// nil when the user isn't away
+	outOfOfficeUntil *time.Time
 }
 
 func NewUser(
@@ -71,3 +83,38 @@ func (user *User) SetTeamID(teamID *TeamID) {
 func (user *User) SetUsername(username string) {
 	user.username = username
 }
+
+// MaxOpenReviews is how many open pull requests the user reviews at most
+// before automatic selection skips them, nil for no limit
+func (user *User) MaxOpenReviews() *int {
+	return user.maxOpenReviews
+}
+
+func (user *User) SetMaxOpenReviews(max *int) error {
+	if max != nil && (*max < 0 || *max > MaxOpenReviewsLimit) {
+		return ErrUserMaxOpenReviews
+	}
+	user.maxOpenReviews = max
+	return nil
+}
+
+// OutOfOfficeUntil is when the user is back, automatic selection skips them
+// until then, nil when they aren't away
+func (user *User) OutOfOfficeUntil() *time.Time {
+	return user.outOfOfficeUntil
+}
+
+func (user *User) SetOutOfOfficeUntil(until *time.Time) {
+	user.outOfOfficeUntil = until
+}
+
+// IsOutOfOffice reports whether the user is away at now
+func (user *User) IsOutOfOffice(now time.Time) bool {
+	return user.outOfOfficeUntil != nil && now.Before(*user.outOfOfficeUntil)
+}
+
+// AtCapacity reports whether the user can't take another review on top of
+// openReviews
+func (user *User) AtCapacity(openReviews int) bool {
+	return user.maxOpenReviews != nil && openReviews >= *user.maxOpenReviews
+}
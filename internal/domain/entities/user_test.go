@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestUserSetMaxOpenReviews(t *testing.T) {
+	tests := []struct {
+		name    string
+		max     *int
+		wantErr error
+	}{
+		{"no limit", nil, nil},
+		{"zero", intPtr(0), nil},
+		{"positive", intPtr(3), nil},
+		{"largest", intPtr(MaxOpenReviewsLimit), nil},
+		{"negative", intPtr(-1), ErrUserMaxOpenReviews},
+		{"too large", intPtr(MaxOpenReviewsLimit + 1), ErrUserMaxOpenReviews},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := NewUser("u1", "alice", true, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := user.SetMaxOpenReviews(intPtr(5)); err != nil {
+				t.Fatal(err)
+			}
+
+			err = user.SetMaxOpenReviews(tt.max)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got %v, want %v", err, tt.wantErr)
+			}
+
+			got := user.MaxOpenReviews()
+			if tt.wantErr != nil {
+				// a rejected limit keeps the previous one
+				if got == nil || *got != 5 {
+					t.Errorf("limit changed to %v", got)
+				}
+				return
+			}
+			if (got == nil) != (tt.max == nil) || got != nil && *got != *tt.max {
+				t.Errorf("limit %v, want %v", got, tt.max)
+			}
+		})
+	}
+}
+
+func TestUserAtCapacity(t *testing.T) {
+	tests := []struct {
+		name        string
+		max         *int
+		openReviews int
+		want        bool
+	}{
+		{"no limit", nil, 100, false},
+		{"below", intPtr(2), 1, false},
+		{"at the limit", intPtr(2), 2, true},
+		{"over the limit", intPtr(2), 3, true},
+		{"zero takes nothing", intPtr(0), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := NewUser("u1", "alice", true, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := user.SetMaxOpenReviews(tt.max); err != nil {
+				t.Fatal(err)
+			}
+			if got := user.AtCapacity(tt.openReviews); got != tt.want {
+				t.Errorf("AtCapacity(%d) = %t, want %t", tt.openReviews, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserIsOutOfOffice(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		until *time.Time
+		want  bool
+	}{
+		{"not away", nil, false},
+		{"away", timePtr(now.Add(time.Hour)), true},
+		{"back at until", timePtr(now), false},
+		{"back", timePtr(now.Add(-time.Hour)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := NewUser("u1", "alice", true, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			user.SetOutOfOfficeUntil(tt.until)
+			if got := user.IsOutOfOffice(now); got != tt.want {
+				t.Errorf("IsOutOfOffice = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type BulkRepository interface {
+	// Apply writes the whole import in one transaction
+	Apply(ctx context.Context, plan BulkPlan) error
+}
+
+// BulkPlan is the set of writes for a bulk import, teams are referenced by
+// name since the new ones have no ID yet
+type BulkPlan struct {
+	// teams to create
+	Teams []string
+	Users []BulkUser
+	// pull requests to create with their reviewers
+	PullRequests []*entities.PullRequest
+}
+
+type BulkUser struct {
+	User     *entities.User
+	TeamName string
+	// New users are created, the rest updated
+	New bool
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+type DigestRepository interface {
+	// ClaimRun marks the day's digest as sent, false means someone else
+	// already claimed it
+	ClaimRun(ctx context.Context, day time.Time) (bool, error)
+}
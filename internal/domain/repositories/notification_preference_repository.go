@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type NotificationPreferenceRepository interface {
+	FindByUserID(
+		ctx context.Context,
+		userID entities.UserID,
+	) ([]entities.NotificationPreference, error)
+	// Replace swaps all of a user's preferences, an empty slice restores the
+	// default channel
+	Replace(
+		ctx context.Context,
+		userID entities.UserID,
+		prefs []entities.NotificationPreference,
+	) error
+}
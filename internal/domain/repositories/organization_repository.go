@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *entities.Organization) (*entities.Organization, error)
+	FindByID(ctx context.Context, id entities.OrgID) (*entities.Organization, error)
+	FindAll(ctx context.Context) ([]*entities.Organization, error)
+}
@@ -13,4 +13,15 @@ type PullRequestRepository interface {
 		id entities.UserID,
 	) ([]*entities.PullRequest, error)
 	FindOpenPullRequests(ctx context.Context) ([]*entities.PullRequest, error)
+	// FindOpenPullRequestsByTeamID returns the open pull requests whose
+	// author is in the team
+	FindOpenPullRequestsByTeamID(
+		ctx context.Context,
+		id entities.TeamID,
+	) ([]*entities.PullRequest, error)
+	CountOpenReviewsByUser(
+		ctx context.Context,
+	) (map[entities.UserID]int, error)
+	// CountOpenReviews is CountOpenReviewsByUser for one user
+	CountOpenReviews(ctx context.Context, id entities.UserID) (int, error)
 }
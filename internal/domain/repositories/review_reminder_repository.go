@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type ReviewReminderRepository interface {
+	// FindOverdue applies defaults to teams without their own SLA
+	FindOverdue(
+		ctx context.Context,
+		defaults entities.ReviewSLA,
+	) ([]entities.OverdueReview, error)
+	MarkReminded(
+		ctx context.Context,
+		prID entities.PullRequestID,
+		reviewerID entities.UserID,
+		at time.Time,
+	) error
+	// SetTeamSLA stores a team's own SLA, nil resets it to the defaults
+	SetTeamSLA(ctx context.Context, teamID entities.TeamID, sla *entities.ReviewSLA) error
+}
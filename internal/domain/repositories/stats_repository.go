@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+// StatsRepository runs reporting aggregates in the database
+type StatsRepository interface {
+	SLA(ctx context.Context, window entities.TimeWindow) (*entities.SLAReport, error)
+	// ReviewerStats returns every user of the organization, nil userID
+	// means all of them
+	ReviewerStats(ctx context.Context, userID *entities.UserID) ([]entities.ReviewerStats, error)
+	PullRequestStats(ctx context.Context) (*entities.PullRequestStats, error)
+	// Fairness returns nil when the team does not exist
+	Fairness(
+		ctx context.Context,
+		teamName string,
+		window entities.TimeWindow,
+	) (*entities.FairnessReport, error)
+}
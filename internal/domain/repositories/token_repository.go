@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+// TokenRepository stores API tokens, only the hash of the secret is kept
+type TokenRepository interface {
+	Create(ctx context.Context, token *entities.APIToken, hash string) (*entities.APIToken, error)
+	FindActiveByHash(ctx context.Context, hash string) (*entities.APIToken, error)
+	FindAll(ctx context.Context) ([]*entities.APIToken, error)
+	// Revoke returns false when there was no active token with this id
+	Revoke(ctx context.Context, id entities.TokenID) (bool, error)
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/Traunin/review-assigner/internal/domain/entities"
 )
@@ -10,4 +11,10 @@ type UserRepository interface {
 	Repository[entities.User, entities.UserID]
 	GetActiveUsers(ctx context.Context) ([]*entities.User, error)
 	GetByTeamID(ctx context.Context, id entities.TeamID) ([]*entities.User, error)
+	// SetMaxOpenReviews changes only the user's capacity, false when there
+	// is no such user
+	SetMaxOpenReviews(ctx context.Context, id entities.UserID, max *int) (bool, error)
+	// SetOutOfOffice changes only when the user is back, nil when they
+	// aren't away, false when there is no such user
+	SetOutOfOffice(ctx context.Context, id entities.UserID, until *time.Time) (bool, error)
 }
@@ -0,0 +1,18 @@
+package services
+
+import "context"
+
+type actorKey struct{}
+
+// WithActor records who the changes made in ctx are made by, see
+// entities.APIToken.Actor
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor returns who ctx acts on behalf of, empty when nobody set it, e.g.
+// with auth disabled
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type seedKey struct{}
+
+// WithSeed makes every reviewer pick under ctx use seed instead of a fresh
+// random one, for tests and for replaying a recorded pick. A call that picks
+// for several pull requests, like filling all of them, uses it for each.
+// The pick repeats only while the candidates are the same: a member who
+// joined, left, was deactivated or reached their capacity changes it.
+func WithSeed(ctx context.Context, seed uint64) context.Context {
+	return context.WithValue(ctx, seedKey{}, seed)
+}
+
+func seedFromContext(ctx context.Context) (uint64, bool) {
+	seed, ok := ctx.Value(seedKey{}).(uint64)
+	return seed, ok
+}
+
+// newSeed returns the seed from ctx or reads one from random
+func newSeed(ctx context.Context, random io.Reader) (uint64, error) {
+	if seed, ok := seedFromContext(ctx); ok {
+		return seed, nil
+	}
+
+	var b [8]byte
+	if _, err := io.ReadFull(random, b[:]); err != nil {
+		return 0, fmt.Errorf("read seed: %w", err)
+	}
+	return binary.LittleEndian.Uint64(b[:]), nil
+}
+
+// shuffle is a Fisher-Yates shuffle driven by PCG, whose output is fixed for
+// a seed, so the same candidates and seed always give the same order
+func shuffle(slice []entities.UserID, seed uint64) {
+	src := rand.NewPCG(seed, 0)
+	for i := len(slice) - 1; i > 0; i-- {
+		// the modulo bias is negligible for team-sized slices
+		j := int(src.Uint64() % uint64(i+1))
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
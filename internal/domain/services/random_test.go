@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+func TestShuffle(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []entities.UserID
+		seed  uint64
+		want  []entities.UserID
+	}{
+		{"empty", []entities.UserID{}, 42, []entities.UserID{}},
+		{"single", []entities.UserID{"u1"}, 42, []entities.UserID{"u1"}},
+		// recorded seeds replay only while these stay the same
+		{"seed 0", []entities.UserID{"u1", "u2", "u3", "u4", "u5"}, 0, []entities.UserID{"u5", "u4", "u3", "u2", "u1"}},
+		{"seed 42", []entities.UserID{"u1", "u2", "u3", "u4", "u5"}, 42, []entities.UserID{"u3", "u2", "u5", "u1", "u4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Clone(tt.slice)
+			shuffle(got, tt.seed)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("shuffle(%v, %d) = %v, want %v", tt.slice, tt.seed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShuffleIsAPermutation(t *testing.T) {
+	ids := []entities.UserID{"u1", "u2", "u3", "u4", "u5", "u6", "u7"}
+	orders := make(map[string]bool)
+	for seed := range uint64(20) {
+		got := slices.Clone(ids)
+		shuffle(got, seed)
+
+		again := slices.Clone(ids)
+		shuffle(again, seed)
+		if !slices.Equal(got, again) {
+			t.Fatalf("seed %d gave %v and %v", seed, got, again)
+		}
+
+		sorted := slices.Clone(got)
+		slices.Sort(sorted)
+		if !slices.Equal(sorted, ids) {
+			t.Fatalf("seed %d: %v isn't a permutation of %v", seed, got, ids)
+		}
+
+		var key strings.Builder
+		for _, id := range got {
+			key.WriteString(string(id) + ",")
+		}
+		orders[key.String()] = true
+	}
+	if len(orders) < 10 {
+		t.Errorf("20 seeds gave only %d distinct orders", len(orders))
+	}
+}
+
+func TestNewSeed(t *testing.T) {
+	random := strings.NewReader("\x2a\x00\x00\x00\x00\x00\x00\x00")
+	seed, err := newSeed(context.Background(), random)
+	if err != nil || seed != 42 {
+		t.Errorf("newSeed from random = %d, %v, want 42", seed, err)
+	}
+
+	// every pick under the context gets the same seed
+	ctx := WithSeed(context.Background(), 7)
+	for range 2 {
+		seed, err := newSeed(ctx, strings.NewReader(""))
+		if err != nil || seed != 7 {
+			t.Errorf("newSeed with WithSeed(7) = %d, %v", seed, err)
+		}
+	}
+
+	if _, err := newSeed(context.Background(), strings.NewReader("")); err == nil {
+		t.Error("newSeed from an empty reader succeeded")
+	}
+}
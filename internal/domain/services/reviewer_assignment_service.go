@@ -4,10 +4,15 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
-	"math/big"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/Traunin/review-assigner/internal/domain/entities"
 	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/logging"
 )
 
 var (
@@ -18,6 +23,12 @@ var (
 	ErrPRAlreadyExists = errors.New("pull request already exists")
 	ErrAuthorNotFound  = errors.New("author not found")
 	ErrNoCandidate     = errors.New("no candidate")
+	// ErrAtCapacity is an ErrNoCandidate where some members would have
+	// been candidates if they weren't at capacity
+	ErrAtCapacity    = fmt.Errorf("%w: every other active member is at capacity", ErrNoCandidate)
+	ErrUserNotFound  = errors.New("user not found")
+	ErrUserInactive  = errors.New("user is inactive")
+	ErrUserNotInTeam = errors.New("user is not in the author's team")
 )
 
 type ReviewerAssignmentService interface {
@@ -29,28 +40,61 @@ type ReviewerAssignmentService interface {
 		ctx context.Context,
 		prID entities.PullRequestID,
 		oldReviewerID entities.UserID,
+		newReviewerID entities.UserID,
 	) (entities.UserID, *entities.PullRequest, error)
+	AddReviewer(
+		ctx context.Context,
+		prID entities.PullRequestID,
+		reviewerID entities.UserID,
+	) (*entities.PullRequest, error)
+	RemoveReviewer(
+		ctx context.Context,
+		prID entities.PullRequestID,
+		reviewerID entities.UserID,
+	) (*entities.PullRequest, error)
 	Merge(
 		ctx context.Context,
 		prID entities.PullRequestID,
 	) (*entities.PullRequest, error)
+	Preview(
+		ctx context.Context,
+		authorID entities.UserID,
+	) (*entities.AssignmentPreview, error)
+	FillReviewers(
+		ctx context.Context,
+		prID entities.PullRequestID,
+	) ([]entities.UserID, *entities.PullRequest, error)
 }
 
 type reviewerAssignmentService struct {
 	prRepo   repositories.PullRequestRepository
 	userRepo repositories.UserRepository
 	teamRepo repositories.TeamRepository
+
+	// how many reviewers a new PR gets, at most entities.MaxReviewers
+	reviewersPerPR int
+	// seeds each pick unless the context carries one
+	random io.Reader
 }
 
+// NewReviewerAssignmentService picks reviewers with seeds read from random,
+// crypto/rand when it's nil
 func NewReviewerAssignmentService(
 	userRepo repositories.UserRepository,
 	prRepo repositories.PullRequestRepository,
 	teamRepo repositories.TeamRepository,
+	reviewersPerPR int,
+	random io.Reader,
 ) ReviewerAssignmentService {
+	if random == nil {
+		random = rand.Reader
+	}
 	return &reviewerAssignmentService{
-		userRepo: userRepo,
-		prRepo:   prRepo,
-		teamRepo: teamRepo,
+		userRepo:       userRepo,
+		prRepo:         prRepo,
+		teamRepo:       teamRepo,
+		reviewersPerPR: min(reviewersPerPR, entities.MaxReviewers),
+		random:         random,
 	}
 }
 
@@ -66,7 +110,59 @@ func (s *reviewerAssignmentService) CreateAndAssign(
 		return nil, ErrPRAlreadyExists
 	}
 
-	author, err := s.userRepo.FindByID(ctx, pr.AuthorID())
+	picked, err := s.pickReviewers(ctx, pr.AuthorID())
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logging.FromContext(ctx)
+	if len(picked.Reviewers) < s.reviewersPerPR {
+		logger.Warn("not enough reviewer candidates",
+			"pr_id", pr.ID(),
+			"team", picked.TeamName,
+			"candidates", len(picked.Candidates),
+			"wanted", s.reviewersPerPR,
+		)
+	}
+
+	for _, rid := range picked.Reviewers {
+		err := pr.AssignSelectedReviewer(rid, picked.Seed, Actor(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.prRepo.Create(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	logger.Info("reviewers assigned",
+		"pr_id", pr.ID(),
+		"author_id", picked.AuthorID,
+		"team", picked.TeamName,
+		"reviewers", picked.Reviewers,
+		"candidates", len(picked.Candidates),
+		"seed", picked.Seed,
+		"actor", Actor(ctx),
+	)
+
+	return pr, nil
+}
+
+// Preview picks reviewers for a pull request by the author the way
+// CreateAndAssign would, without creating anything
+func (s *reviewerAssignmentService) Preview(
+	ctx context.Context,
+	authorID entities.UserID,
+) (*entities.AssignmentPreview, error) {
+	return s.pickReviewers(ctx, authorID)
+}
+
+func (s *reviewerAssignmentService) pickReviewers(
+	ctx context.Context,
+	authorID entities.UserID,
+) (*entities.AssignmentPreview, error) {
+	author, err := s.userRepo.FindByID(ctx, authorID)
 	if err != nil {
 		return nil, err
 	}
@@ -82,88 +178,125 @@ func (s *reviewerAssignmentService) CreateAndAssign(
 		return nil, ErrTeamNotFound
 	}
 
-	activeMembers, err := s.teamRepo.FindActiveReviewersByTeamID(ctx, team.ID())
+	members, load, err := s.teamLoad(ctx, team.ID())
 	if err != nil {
 		return nil, err
 	}
 
-	reviewerIDs := selectReviewers(
-		activeMembers,
-		author.ID(),
-		nil,
-		entities.MaxReviewers,
-	)
-
-	for _, rid := range reviewerIDs {
-		err := pr.AssignReviewer(rid)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if err := s.prRepo.Create(ctx, pr); err != nil {
+	seed, err := newSeed(ctx, s.random)
+	if err != nil {
 		return nil, err
 	}
 
-	return pr, nil
+	candidates, excluded := gatherCandidates(members, load, author.ID(), nil, time.Now())
+	return &entities.AssignmentPreview{
+		AuthorID:   author.ID(),
+		TeamName:   team.Name(),
+		Candidates: candidates,
+		Excluded:   excluded,
+		Reviewers:  selectReviewers(candidates, s.reviewersPerPR, seed),
+		Seed:       seed,
+	}, nil
 }
 
-func selectReviewers(
-	activeMembers []*entities.User,
-	authorID entities.UserID,
-	excludeUserIDs []entities.UserID,
-	maxReviewers int,
-) []entities.UserID {
-	excluded := make(map[entities.UserID]bool)
-	excluded[authorID] = true
-	for _, uid := range excludeUserIDs {
-		excluded[uid] = true
+// teamLoad returns the team's members and how many open pull requests each
+// member of the organization reviews
+func (s *reviewerAssignmentService) teamLoad(
+	ctx context.Context,
+	teamID entities.TeamID,
+) ([]*entities.User, map[entities.UserID]int, error) {
+	members, err := s.userRepo.GetByTeamID(ctx, teamID)
+	if err != nil {
+		return nil, nil, err
 	}
+	load, err := s.prRepo.CountOpenReviewsByUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return members, load, nil
+}
 
-	candidates := make([]entities.UserID, 0)
-	for _, member := range activeMembers {
-		if !excluded[member.ID()] {
+// gatherCandidates splits team members into those who may review a pull
+// request by the author at now, sorted, and those who may not. Members in
+// skipUserIDs are dropped from both.
+func gatherCandidates(
+	members []*entities.User,
+	load map[entities.UserID]int,
+	authorID entities.UserID,
+	skipUserIDs []entities.UserID,
+	now time.Time,
+) ([]entities.UserID, []entities.Exclusion) {
+	candidates := make([]entities.UserID, 0, len(members))
+	excluded := make([]entities.Exclusion, 0)
+	exclude := func(id entities.UserID, reason entities.ExclusionReason) {
+		excluded = append(excluded, entities.Exclusion{UserID: id, Reason: reason})
+	}
+	for _, member := range members {
+		switch {
+		case member.ID() == authorID:
+			exclude(member.ID(), entities.ExcludedAuthor)
+		case slices.Contains(skipUserIDs, member.ID()):
+		case !member.IsActive():
+			exclude(member.ID(), entities.ExcludedInactive)
+		case member.IsOutOfOffice(now):
+			exclude(member.ID(), entities.ExcludedOutOfOffice)
+		case member.AtCapacity(load[member.ID()]):
+			exclude(member.ID(), entities.ExcludedAtCapacity)
+		default:
 			candidates = append(candidates, member.ID())
 		}
 	}
 
-	shuffle(candidates)
-	selectCount := min(len(candidates), maxReviewers)
-	return candidates[:selectCount]
+	slices.Sort(candidates)
+	slices.SortFunc(excluded, func(a, b entities.Exclusion) int {
+		return strings.Compare(string(a.UserID), string(b.UserID))
+	})
+	return candidates, excluded
+}
+
+// selectReviewers is deterministic for the same sorted candidates and seed
+func selectReviewers(
+	candidates []entities.UserID,
+	maxReviewers int,
+	seed uint64,
+) []entities.UserID {
+	shuffled := slices.Clone(candidates)
+	shuffle(shuffled, seed)
+	return shuffled[:min(len(shuffled), maxReviewers)]
 }
 
 func selectReplacementReviewer(
-	activeMembers []*entities.User,
-	authorID entities.UserID,
-	excludeUserIDs []entities.UserID,
+	candidates []entities.UserID,
+	seed uint64,
 ) (entities.UserID, error) {
-	selected := selectReviewers(activeMembers, authorID, excludeUserIDs, 1)
+	selected := selectReviewers(candidates, 1, seed)
 	if len(selected) == 0 {
 		return "", ErrNoCandidate
 	}
 	return selected[0], nil
 }
 
+// ReassignReviewer replaces the reviewer with newReviewerID, or with a
+// random active team member when it's empty
 func (s *reviewerAssignmentService) ReassignReviewer(
 	ctx context.Context,
 	prID entities.PullRequestID,
 	oldReviewerID entities.UserID,
+	newReviewerID entities.UserID,
 ) (entities.UserID, *entities.PullRequest, error) {
-	pr, err := s.prRepo.FindByID(ctx, prID)
+	pr, err := s.findOpen(ctx, prID)
 	if err != nil {
 		return "", nil, err
 	}
-	if pr == nil {
-		return "", nil, ErrPRNotFound
-	}
-	if pr.IsMerged() {
-		return "", nil, ErrPRAlreadyMerged
-	}
 
 	if !pr.HasReviewer(oldReviewerID) {
 		return "", nil, ErrUserNotReviewer
 	}
 
+	if newReviewerID != "" {
+		return s.replaceReviewer(ctx, pr, oldReviewerID, newReviewerID)
+	}
+
 	team, err := s.teamRepo.FindByUserID(ctx, pr.AuthorID())
 	if err != nil {
 		return "", nil, err
@@ -172,29 +305,43 @@ func (s *reviewerAssignmentService) ReassignReviewer(
 		return "", nil, ErrTeamNotFound
 	}
 
-	active, err := s.teamRepo.FindActiveReviewersByTeamID(ctx, team.ID())
+	members, load, err := s.teamLoad(ctx, team.ID())
 	if err != nil {
 		return "", nil, err
 	}
 
-	exclude := make([]entities.UserID, 0, len(pr.ReviewerIDs())+2)
-	exclude = append(exclude, pr.ReviewerIDs()...)
-	exclude = append(exclude, pr.AuthorID(), oldReviewerID)
+	skip := make([]entities.UserID, 0, len(pr.ReviewerIDs())+1)
+	skip = append(skip, pr.ReviewerIDs()...)
+	skip = append(skip, oldReviewerID)
+	candidates, excluded := gatherCandidates(members, load, pr.AuthorID(), skip, time.Now())
 
-	newReviewerID, err := selectReplacementReviewer(
-		active,
-		pr.AuthorID(),
-		exclude,
-	)
+	seed, err := newSeed(ctx, s.random)
 	if err != nil {
 		return "", nil, err
 	}
 
-	err = pr.UnassignReviewer(oldReviewerID)
+	logger := logging.FromContext(ctx)
+	newReviewerID, err = selectReplacementReviewer(candidates, seed)
 	if err != nil {
+		if slices.ContainsFunc(excluded, func(e entities.Exclusion) bool {
+			return e.Reason == entities.ExcludedAtCapacity
+		}) {
+			err = ErrAtCapacity
+		}
+		logger.Warn("no replacement reviewer",
+			"pr_id", prID,
+			"old_reviewer_id", oldReviewerID,
+			"team", team.Name(),
+			"error", err,
+		)
 		return "", nil, err
 	}
-	err = pr.AssignReviewer(newReviewerID)
+
+	err = pr.UnassignReviewer(oldReviewerID, newReviewerID, Actor(ctx))
+	if err != nil {
+		return "", nil, err
+	}
+	err = pr.AssignSelectedReviewer(newReviewerID, seed, Actor(ctx))
 	if err != nil {
 		return "", nil, err
 	}
@@ -203,9 +350,230 @@ func (s *reviewerAssignmentService) ReassignReviewer(
 		return "", nil, err
 	}
 
+	logger.Info("reviewer reassigned",
+		"pr_id", prID,
+		"old_reviewer_id", oldReviewerID,
+		"new_reviewer_id", newReviewerID,
+		"candidates", len(candidates),
+		"seed", seed,
+		"actor", Actor(ctx),
+	)
+
+	return newReviewerID, pr, nil
+}
+
+func (s *reviewerAssignmentService) replaceReviewer(
+	ctx context.Context,
+	pr *entities.PullRequest,
+	oldReviewerID entities.UserID,
+	newReviewerID entities.UserID,
+) (entities.UserID, *entities.PullRequest, error) {
+	if err := s.checkAssignee(ctx, pr, newReviewerID); err != nil {
+		return "", nil, err
+	}
+
+	if err := pr.UnassignReviewer(oldReviewerID, newReviewerID, Actor(ctx)); err != nil {
+		return "", nil, err
+	}
+	if err := pr.AssignReviewer(newReviewerID, Actor(ctx)); err != nil {
+		return "", nil, err
+	}
+
+	if err := s.prRepo.Update(ctx, pr); err != nil {
+		return "", nil, err
+	}
+
+	logging.FromContext(ctx).Info("reviewer reassigned",
+		"pr_id", pr.ID(),
+		"old_reviewer_id", oldReviewerID,
+		"new_reviewer_id", newReviewerID,
+		"actor", Actor(ctx),
+	)
+
 	return newReviewerID, pr, nil
 }
 
+// AddReviewer assigns the user on top of the current reviewers, up to
+// entities.MaxReviewers
+func (s *reviewerAssignmentService) AddReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (*entities.PullRequest, error) {
+	pr, err := s.findOpen(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkAssignee(ctx, pr, reviewerID); err != nil {
+		return nil, err
+	}
+	if err := pr.AssignReviewer(reviewerID, Actor(ctx)); err != nil {
+		return nil, err
+	}
+
+	if err := s.prRepo.Update(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("reviewer added",
+		"pr_id", prID,
+		"reviewer_id", reviewerID,
+		"actor", Actor(ctx),
+	)
+
+	return pr, nil
+}
+
+// RemoveReviewer unassigns the reviewer without picking a replacement
+func (s *reviewerAssignmentService) RemoveReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (*entities.PullRequest, error) {
+	pr, err := s.findOpen(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pr.HasReviewer(reviewerID) {
+		return nil, ErrUserNotReviewer
+	}
+	if err := pr.UnassignReviewer(reviewerID, "", Actor(ctx)); err != nil {
+		return nil, err
+	}
+
+	if err := s.prRepo.Update(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("reviewer removed",
+		"pr_id", prID,
+		"reviewer_id", reviewerID,
+		"actor", Actor(ctx),
+	)
+
+	return pr, nil
+}
+
+// FillReviewers picks more reviewers for an open pull request that has fewer
+// than a new one would get, as many as the team has candidates for
+func (s *reviewerAssignmentService) FillReviewers(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) ([]entities.UserID, *entities.PullRequest, error) {
+	pr, err := s.findOpen(ctx, prID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missing := s.reviewersPerPR - len(pr.Reviewers())
+	if missing <= 0 {
+		return nil, pr, nil
+	}
+
+	team, err := s.teamRepo.FindByUserID(ctx, pr.AuthorID())
+	if err != nil {
+		return nil, nil, err
+	}
+	if team == nil {
+		return nil, nil, ErrTeamNotFound
+	}
+
+	members, load, err := s.teamLoad(ctx, team.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+	candidates, _ := gatherCandidates(members, load, pr.AuthorID(), pr.ReviewerIDs(), time.Now())
+
+	seed, err := newSeed(ctx, s.random)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	added := selectReviewers(candidates, missing, seed)
+	if len(added) == 0 {
+		return nil, pr, nil
+	}
+	for _, rid := range added {
+		if err := pr.AssignSelectedReviewer(rid, seed, Actor(ctx)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := s.prRepo.Update(ctx, pr); err != nil {
+		return nil, nil, err
+	}
+
+	logging.FromContext(ctx).Info("reviewers filled",
+		"pr_id", prID,
+		"team", team.Name(),
+		"reviewers", added,
+		"candidates", len(candidates),
+		"seed", seed,
+		"actor", Actor(ctx),
+	)
+
+	return added, pr, nil
+}
+
+func (s *reviewerAssignmentService) findOpen(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) (*entities.PullRequest, error) {
+	pr, err := s.prRepo.FindByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrPRNotFound
+	}
+	if pr.IsMerged() {
+		return nil, ErrPRAlreadyMerged
+	}
+	return pr, nil
+}
+
+// checkAssignee makes sure a user picked by hand could have been picked
+// automatically: an active member of the author's team other than the
+// author, not reviewing the pull request yet
+func (s *reviewerAssignmentService) checkAssignee(
+	ctx context.Context,
+	pr *entities.PullRequest,
+	userID entities.UserID,
+) error {
+	if pr.HasReviewer(userID) {
+		return entities.ErrReviewerAssigned
+	}
+	if userID == pr.AuthorID() {
+		return entities.ErrAuthorIsReviewer
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+	if !user.IsActive() {
+		return ErrUserInactive
+	}
+
+	team, err := s.teamRepo.FindByUserID(ctx, pr.AuthorID())
+	if err != nil {
+		return err
+	}
+	if team == nil {
+		return ErrTeamNotFound
+	}
+	if user.TeamID() == nil || *user.TeamID() != team.ID() {
+		return ErrUserNotInTeam
+	}
+
+	return nil
+}
+
 func (s *reviewerAssignmentService) Merge(
 	ctx context.Context,
 	prID entities.PullRequestID,
@@ -233,18 +601,9 @@ func (s *reviewerAssignmentService) Merge(
 		return nil, err
 	}
 
-	return pr, nil
-}
+	logging.FromContext(ctx).Info("pull request merged", "pr_id", prID)
 
-// Fisher-Yates shuffle to avoid rand seed shenanigans
-func shuffle(slice []entities.UserID) {
-	n := len(slice)
-	for i := n - 1; i > 0; i-- {
-		// as per docs, "Int cannot return an error when using rand.Reader"
-		jBig, _ := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
-		j := int(jBig.Int64())
-		slice[i], slice[j] = slice[j], slice[i]
-	}
+	return pr, nil
 }
 
 func min(a, b int) int {
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+func TestGatherCandidates(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	back, away := now.Add(-time.Hour), now.Add(time.Hour)
+	member := func(id entities.UserID, active bool, max *int, until *time.Time) *entities.User {
+		user, err := entities.NewUser(id, string(id), active, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := user.SetMaxOpenReviews(max); err != nil {
+			t.Fatal(err)
+		}
+		user.SetOutOfOfficeUntil(until)
+		return user
+	}
+	two := 2
+
+	// out of id order to check the sorting, u5 is back from an absence
+	members := []*entities.User{
+		member("u5", true, &two, &back),
+		member("u1", true, nil, nil),
+		member("u4", false, nil, nil),
+		member("u3", true, &two, nil),
+		member("u2", true, nil, nil),
+		member("u6", false, &two, &away),
+		member("u7", true, &two, &away),
+	}
+	load := map[entities.UserID]int{"u3": 2, "u5": 1, "u6": 5, "u7": 2}
+
+	tests := []struct {
+		name     string
+		authorID entities.UserID
+		skip     []entities.UserID
+		want     []entities.UserID
+		excluded []entities.Exclusion
+	}{
+		{
+			name:     "every reason",
+			authorID: "u1",
+			want:     []entities.UserID{"u2", "u5"},
+			excluded: []entities.Exclusion{
+				{UserID: "u1", Reason: entities.ExcludedAuthor},
+				{UserID: "u3", Reason: entities.ExcludedAtCapacity},
+				{UserID: "u4", Reason: entities.ExcludedInactive},
+				// inactive wins over away and at capacity
+				{UserID: "u6", Reason: entities.ExcludedInactive},
+				// away wins over at capacity
+				{UserID: "u7", Reason: entities.ExcludedOutOfOffice},
+			},
+		},
+		{
+			name:     "skipped members are in neither",
+			authorID: "u1",
+			skip:     []entities.UserID{"u2", "u3"},
+			want:     []entities.UserID{"u5"},
+			excluded: []entities.Exclusion{
+				{UserID: "u1", Reason: entities.ExcludedAuthor},
+				{UserID: "u4", Reason: entities.ExcludedInactive},
+				{UserID: "u6", Reason: entities.ExcludedInactive},
+				{UserID: "u7", Reason: entities.ExcludedOutOfOffice},
+			},
+		},
+		{
+			name:     "author from another team",
+			authorID: "u9",
+			want:     []entities.UserID{"u1", "u2", "u5"},
+			excluded: []entities.Exclusion{
+				{UserID: "u3", Reason: entities.ExcludedAtCapacity},
+				{UserID: "u4", Reason: entities.ExcludedInactive},
+				{UserID: "u6", Reason: entities.ExcludedInactive},
+				{UserID: "u7", Reason: entities.ExcludedOutOfOffice},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, excluded := gatherCandidates(members, load, tt.authorID, tt.skip, now)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("candidates %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(excluded, tt.excluded) {
+				t.Errorf("excluded %v, want %v", excluded, tt.excluded)
+			}
+		})
+	}
+}
+
+func TestSelectReviewers(t *testing.T) {
+	five := []entities.UserID{"u1", "u2", "u3", "u4", "u5"}
+
+	tests := []struct {
+		name         string
+		candidates   []entities.UserID
+		maxReviewers int
+		seed         uint64
+		want         []entities.UserID
+	}{
+		{"no candidates", nil, 2, 42, []entities.UserID{}},
+		{"fewer candidates than wanted", []entities.UserID{"u1"}, 2, 42, []entities.UserID{"u1"}},
+		{"none wanted", five, 0, 42, []entities.UserID{}},
+		{"two of five", five, 2, 42, []entities.UserID{"u3", "u2"}},
+		{"one of five is the first of two", five, 1, 42, []entities.UserID{"u3"}},
+		{"other seed", five, 2, 12769832743090473520, []entities.UserID{"u2", "u1"}},
+		{"all of them", five, 5, 42, []entities.UserID{"u3", "u2", "u5", "u1", "u4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := slices.Clone(tt.candidates)
+			got := selectReviewers(tt.candidates, tt.maxReviewers, tt.seed)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("selectReviewers(%v, %d, %d) = %v, want %v",
+					tt.candidates, tt.maxReviewers, tt.seed, got, tt.want)
+			}
+			if !slices.Equal(tt.candidates, before) {
+				t.Errorf("candidates changed to %v", tt.candidates)
+			}
+
+			// the same sorted candidates and seed give the same picks
+			if again := selectReviewers(before, tt.maxReviewers, tt.seed); !slices.Equal(again, got) {
+				t.Errorf("second pick %v differs from %v", again, got)
+			}
+		})
+	}
+}
+
+func TestSelectReplacementReviewer(t *testing.T) {
+	if _, err := selectReplacementReviewer(nil, 42); err != ErrNoCandidate {
+		t.Errorf("no candidates: got %v, want ErrNoCandidate", err)
+	}
+
+	got, err := selectReplacementReviewer([]entities.UserID{"u1", "u2", "u3", "u4", "u5"}, 42)
+	if err != nil || got != "u3" {
+		t.Errorf("got %q, %v, want u3", got, err)
+	}
+}
@@ -0,0 +1,199 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	ds "github.com/Traunin/review-assigner/internal/domain/services"
+	"github.com/Traunin/review-assigner/internal/logging"
+	"github.com/Traunin/review-assigner/internal/tenant"
+)
+
+type publishingAssignmentService struct {
+	next      ds.ReviewerAssignmentService
+	prs       repositories.PullRequestRepository
+	teams     repositories.TeamRepository
+	publisher Publisher
+}
+
+// PublishAssignment raises an event for every created, assigned, reassigned,
+// unassigned and merged pull request. Failing to publish never fails the call.
+func PublishAssignment(
+	next ds.ReviewerAssignmentService,
+	prs repositories.PullRequestRepository,
+	teams repositories.TeamRepository,
+	publisher Publisher,
+) ds.ReviewerAssignmentService {
+	return &publishingAssignmentService{
+		next:      next,
+		prs:       prs,
+		teams:     teams,
+		publisher: publisher,
+	}
+}
+
+func (s *publishingAssignmentService) CreateAndAssign(
+	ctx context.Context,
+	pr *entities.PullRequest,
+) (*entities.PullRequest, error) {
+	created, err := s.next.CreateAndAssign(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	e := s.event(ctx, TypeCreated, created)
+	s.publish(ctx, e)
+	for _, id := range created.ReviewerIDs() {
+		assigned := e
+		assigned.Type = TypeAssigned
+		assigned.Assigned = id
+		s.publish(ctx, assigned)
+	}
+
+	return created, nil
+}
+
+func (s *publishingAssignmentService) ReassignReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	oldReviewerID entities.UserID,
+	newReviewerID entities.UserID,
+) (entities.UserID, *entities.PullRequest, error) {
+	assigned, pr, err := s.next.ReassignReviewer(ctx, prID, oldReviewerID, newReviewerID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	e := s.event(ctx, TypeReassigned, pr)
+	e.Assigned = assigned
+	e.Replaced = oldReviewerID
+	s.publish(ctx, e)
+
+	return assigned, pr, nil
+}
+
+func (s *publishingAssignmentService) AddReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (*entities.PullRequest, error) {
+	pr, err := s.next.AddReviewer(ctx, prID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := s.event(ctx, TypeAssigned, pr)
+	e.Assigned = reviewerID
+	s.publish(ctx, e)
+
+	return pr, nil
+}
+
+func (s *publishingAssignmentService) RemoveReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (*entities.PullRequest, error) {
+	pr, err := s.next.RemoveReviewer(ctx, prID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := s.event(ctx, TypeUnassigned, pr)
+	e.Replaced = reviewerID
+	s.publish(ctx, e)
+
+	return pr, nil
+}
+
+func (s *publishingAssignmentService) FillReviewers(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) ([]entities.UserID, *entities.PullRequest, error) {
+	added, pr, err := s.next.FillReviewers(ctx, prID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(added) > 0 {
+		e := s.event(ctx, TypeAssigned, pr)
+		for _, id := range added {
+			assigned := e
+			assigned.Assigned = id
+			s.publish(ctx, assigned)
+		}
+	}
+
+	return added, pr, nil
+}
+
+func (s *publishingAssignmentService) Merge(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) (*entities.PullRequest, error) {
+	// merge is idempotent, only the call that actually merges publishes
+	before, err := s.prs.FindByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := s.next.Merge(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil && !before.IsMerged() {
+		s.publish(ctx, s.event(ctx, TypeMerged, pr))
+	}
+
+	return pr, nil
+}
+
+func (s *publishingAssignmentService) Preview(
+	ctx context.Context,
+	authorID entities.UserID,
+) (*entities.AssignmentPreview, error) {
+	return s.next.Preview(ctx, authorID)
+}
+
+func (s *publishingAssignmentService) event(
+	ctx context.Context,
+	t Type,
+	pr *entities.PullRequest,
+) Event {
+	e := Event{
+		Type:            t,
+		OrgID:           tenant.OrgID(ctx),
+		PullRequestID:   pr.ID(),
+		PullRequestName: pr.Name(),
+		AuthorID:        pr.AuthorID(),
+		Reviewers:       pr.ReviewerIDs(),
+		Actor:           ds.Actor(ctx),
+		At:              time.Now().UTC(),
+	}
+
+	team, err := s.teams.FindByUserID(ctx, pr.AuthorID())
+	if err != nil {
+		logging.FromContext(ctx).Warn("failed to resolve team for event",
+			"pr_id", pr.ID(),
+			"error", err,
+		)
+	}
+	if team != nil {
+		e.TeamName = team.Name()
+	}
+
+	return e
+}
+
+func (s *publishingAssignmentService) publish(ctx context.Context, e Event) {
+	if err := s.publisher.Publish(ctx, e); err != nil {
+		logging.FromContext(ctx).Warn("failed to publish event",
+			"type", e.Type,
+			"pr_id", e.PullRequestID,
+			"error", err,
+		)
+	}
+}
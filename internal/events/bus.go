@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// Bus delivers events to the subscribers of this instance. On its own it is
+// a Publisher for single instance deployments.
+type Bus struct {
+	buffer int
+
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+// NewBus creates a bus whose subscribers each buffer up to buffer events
+func NewBus(buffer int) *Bus {
+	return &Bus{
+		buffer: buffer,
+		subs:   make(map[*Subscription]struct{}),
+	}
+}
+
+type Subscription struct {
+	bus    *Bus
+	filter Filter
+	events chan Event
+	once   sync.Once
+}
+
+// Events is closed when the subscription or the bus is closed
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.close()
+}
+
+// close expects the bus lock to be held
+func (s *Subscription) close() {
+	s.once.Do(func() {
+		delete(s.bus.subs, s)
+		close(s.events)
+	})
+}
+
+func (b *Bus) Subscribe(filter Filter) *Subscription {
+	sub := &Subscription{
+		bus:    b,
+		filter: filter,
+		events: make(chan Event, b.buffer),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		// through once, so the caller's Close doesn't close it again
+		sub.once.Do(func() { close(sub.events) })
+		return sub
+	}
+	b.subs[sub] = struct{}{}
+
+	return sub
+}
+
+func (b *Bus) Publish(_ context.Context, e Event) error {
+	b.Deliver(e)
+	return nil
+}
+
+// Deliver hands the event to matching subscribers, skipping those whose
+// buffer is full
+func (b *Bus) Deliver(e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		if !sub.filter.Match(e) {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+		}
+	}
+}
+
+// Close ends every subscription, streams return once they drain
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for sub := range b.subs {
+		sub.close()
+	}
+}
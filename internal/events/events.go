@@ -0,0 +1,74 @@
+// Package events fans pull request events out to live subscribers, such as
+// the SSE stream. Events are best effort: a subscriber that falls behind
+// loses events rather than slowing down the API.
+package events
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type Type string
+
+const (
+	TypeCreated    Type = "pr.created"
+	TypeAssigned   Type = "pr.assigned"
+	TypeReassigned Type = "pr.reassigned"
+	TypeUnassigned Type = "pr.unassigned"
+	TypeMerged     Type = "pr.merged"
+)
+
+type Event struct {
+	Type            Type                   `json:"type"`
+	OrgID           entities.OrgID         `json:"-"`
+	PullRequestID   entities.PullRequestID `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	AuthorID        entities.UserID        `json:"author_id"`
+	// the author's team, empty when the author has left it
+	TeamName  string            `json:"team_name,omitempty"`
+	Reviewers []entities.UserID `json:"reviewers"`
+	// set on pr.assigned and pr.reassigned
+	Assigned entities.UserID `json:"assigned,omitempty"`
+	// the reviewer replaced on pr.reassigned or taken off on pr.unassigned
+	Replaced entities.UserID `json:"replaced,omitempty"`
+	// who made the change, empty when unknown
+	Actor string    `json:"actor,omitempty"`
+	At    time.Time `json:"at"`
+}
+
+// Involves reports whether the user authored or reviews the pull request,
+// or was just taken off it
+func (e Event) Involves(userID entities.UserID) bool {
+	return e.AuthorID == userID ||
+		e.Replaced == userID ||
+		slices.Contains(e.Reviewers, userID)
+}
+
+// Filter selects the events a subscriber gets, empty fields match anything
+// but the organization always has to match
+type Filter struct {
+	OrgID    entities.OrgID
+	TeamName string
+	UserID   entities.UserID
+}
+
+func (f Filter) Match(e Event) bool {
+	if e.OrgID != f.OrgID {
+		return false
+	}
+	if f.TeamName != "" && e.TeamName != f.TeamName {
+		return false
+	}
+	if f.UserID != "" && !e.Involves(f.UserID) {
+		return false
+	}
+	return true
+}
+
+// Publisher hands an event to every instance's subscribers
+type Publisher interface {
+	Publish(ctx context.Context, e Event) error
+}
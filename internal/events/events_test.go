@@ -0,0 +1,106 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+func TestFilterMatch(t *testing.T) {
+	event := Event{
+		Type:      TypeReassigned,
+		OrgID:     1,
+		TeamName:  "backend",
+		AuthorID:  "u1",
+		Reviewers: []entities.UserID{"u2", "u3"},
+		Assigned:  "u3",
+		Replaced:  "u4",
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"same org", Filter{OrgID: 1}, true},
+		{"other org", Filter{OrgID: 2}, false},
+		{"zero org doesn't mean any", Filter{}, false},
+		{"team", Filter{OrgID: 1, TeamName: "backend"}, true},
+		{"other team", Filter{OrgID: 1, TeamName: "frontend"}, false},
+		{"same team in other org", Filter{OrgID: 2, TeamName: "backend"}, false},
+		{"author", Filter{OrgID: 1, UserID: "u1"}, true},
+		{"reviewer", Filter{OrgID: 1, UserID: "u2"}, true},
+		{"replaced reviewer", Filter{OrgID: 1, UserID: "u4"}, true},
+		{"uninvolved user", Filter{OrgID: 1, UserID: "u5"}, false},
+		{"involved user in other org", Filter{OrgID: 2, UserID: "u1"}, false},
+		{"team and user", Filter{OrgID: 1, TeamName: "backend", UserID: "u2"}, true},
+		{"team and uninvolved user", Filter{OrgID: 1, TeamName: "backend", UserID: "u5"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(event); got != tt.want {
+				t.Errorf("%+v.Match = %t, want %t", tt.filter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusDeliversOnlyToOwnOrg(t *testing.T) {
+	bus := NewBus(4)
+	org1 := bus.Subscribe(Filter{OrgID: 1})
+	org2 := bus.Subscribe(Filter{OrgID: 2})
+
+	for _, e := range []Event{
+		{Type: TypeCreated, OrgID: 1, PullRequestID: "pr-1"},
+		{Type: TypeCreated, OrgID: 2, PullRequestID: "pr-2"},
+		{Type: TypeMerged, OrgID: 1, PullRequestID: "pr-1"},
+	} {
+		if err := bus.Publish(context.Background(), e); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+	bus.Close()
+
+	got := map[entities.OrgID][]entities.PullRequestID{}
+	for org, sub := range map[entities.OrgID]*Subscription{1: org1, 2: org2} {
+		for e := range sub.Events() {
+			if e.OrgID != org {
+				t.Errorf("org %d subscriber got an event of org %d", org, e.OrgID)
+			}
+			got[org] = append(got[org], e.PullRequestID)
+		}
+	}
+	if len(got[1]) != 2 || len(got[2]) != 1 {
+		t.Errorf("delivered %v, want two events to org 1 and one to org 2", got)
+	}
+}
+
+func TestBusDropsEventsForFullSubscribers(t *testing.T) {
+	bus := NewBus(1)
+	sub := bus.Subscribe(Filter{OrgID: 1})
+
+	bus.Deliver(Event{OrgID: 1, PullRequestID: "pr-1"})
+	bus.Deliver(Event{OrgID: 1, PullRequestID: "pr-2"})
+	sub.Close()
+
+	var got []entities.PullRequestID
+	for e := range sub.Events() {
+		got = append(got, e.PullRequestID)
+	}
+	if len(got) != 1 || got[0] != "pr-1" {
+		t.Errorf("got %v, want only pr-1", got)
+	}
+}
+
+func TestSubscribeAfterClose(t *testing.T) {
+	bus := NewBus(1)
+	bus.Close()
+
+	sub := bus.Subscribe(Filter{OrgID: 1})
+	if _, ok := <-sub.Events(); ok {
+		t.Error("subscription on a closed bus got an event")
+	}
+	// closing again is harmless
+	sub.Close()
+}
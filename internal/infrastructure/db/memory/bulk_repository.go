@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/tenant"
+)
+
+type BulkRepository struct {
+	store *Store
+}
+
+func NewBulkRepository(store *Store) *BulkRepository {
+	return &BulkRepository{
+		store: store,
+	}
+}
+
+// Apply writes to a copy of the organization and swaps it in only when every
+// write succeeded, so a failed import leaves nothing behind
+func (r *BulkRepository) Apply(
+	ctx context.Context,
+	plan repositories.BulkPlan,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// team ids handed out by a failed import are not reused, like a sequence
+	d := r.store.data(ctx).clone()
+
+	for _, name := range plan.Teams {
+		if _, err := r.store.createTeam(d, name); err != nil {
+			return fmt.Errorf("create team %s: %w", name, err)
+		}
+	}
+
+	for _, u := range plan.Users {
+		team, ok := teamByName(d, u.TeamName)
+		if !ok {
+			return fmt.Errorf("find team %s: %w", u.TeamName, errNoRows)
+		}
+		u.User.SetTeamID(&team.id)
+
+		if u.New {
+			if err := createUser(d, u.User); err != nil {
+				return fmt.Errorf("save user %s: %w", u.User.ID(), err)
+			}
+		} else {
+			updateUser(d, u.User)
+		}
+	}
+
+	for _, pr := range plan.PullRequests {
+		if err := insertPullRequest(d, pr); err != nil {
+			return fmt.Errorf("create pull request %s: %w", pr.ID(), err)
+		}
+	}
+
+	r.store.orgs[tenant.OrgID(ctx)] = d
+	return nil
+}
@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+type DigestRepository struct {
+	store *Store
+}
+
+func NewDigestRepository(store *Store) *DigestRepository {
+	return &DigestRepository{
+		store: store,
+	}
+}
+
+func (r *DigestRepository) ClaimRun(ctx context.Context, day time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	key := day.Format(time.DateOnly)
+	if d.digestRuns[key] {
+		return false, nil
+	}
+	d.digestRuns[key] = true
+	return true, nil
+}
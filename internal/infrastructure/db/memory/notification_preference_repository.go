@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type NotificationPreferenceRepository struct {
+	store *Store
+}
+
+func NewNotificationPreferenceRepository(store *Store) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{
+		store: store,
+	}
+}
+
+func (r *NotificationPreferenceRepository) FindByUserID(
+	ctx context.Context,
+	userID entities.UserID,
+) ([]entities.NotificationPreference, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return slices.Clone(r.store.data(ctx).prefs[userID]), nil
+}
+
+func (r *NotificationPreferenceRepository) Replace(
+	ctx context.Context,
+	userID entities.UserID,
+	prefs []entities.NotificationPreference,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	if len(prefs) == 0 {
+		delete(d.prefs, userID)
+		return nil
+	}
+
+	stored := slices.Clone(prefs)
+	slices.SortFunc(stored, func(a, b entities.NotificationPreference) int {
+		return strings.Compare(string(a.Channel), string(b.Channel))
+	})
+	d.prefs[userID] = stored
+	return nil
+}
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type OrganizationRepository struct {
+	store *Store
+}
+
+func NewOrganizationRepository(store *Store) *OrganizationRepository {
+	return &OrganizationRepository{
+		store: store,
+	}
+}
+
+func orgToDomain(row orgRow) (*entities.Organization, error) {
+	return entities.NewOrganization(row.id, row.name, row.createdAt)
+}
+
+func (r *OrganizationRepository) Create(
+	_ context.Context,
+	org *entities.Organization,
+) (*entities.Organization, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return orgToDomain(r.store.addOrg(org.Name()))
+}
+
+func (r *OrganizationRepository) FindByID(
+	_ context.Context,
+	id entities.OrgID,
+) (*entities.Organization, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, row := range r.store.orgRows {
+		if row.id == id {
+			return orgToDomain(row)
+		}
+	}
+	return nil, nil
+}
+
+func (r *OrganizationRepository) FindAll(
+	_ context.Context,
+) ([]*entities.Organization, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	orgs := make([]*entities.Organization, len(r.store.orgRows))
+	for i, row := range r.store.orgRows {
+		org, err := orgToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		orgs[i] = org
+	}
+	return orgs, nil
+}
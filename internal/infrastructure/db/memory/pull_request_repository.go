@@ -0,0 +1,286 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type PullRequestRepository struct {
+	store *Store
+}
+
+func NewPullRequestRepository(store *Store) *PullRequestRepository {
+	return &PullRequestRepository{
+		store: store,
+	}
+}
+
+func copyReviewer(r entities.Reviewer) entities.Reviewer {
+	r.VerdictAt = copyPtr(r.VerdictAt)
+	r.Seed = copyPtr(r.Seed)
+	return r
+}
+
+func prToDomain(row prRow) (*entities.PullRequest, error) {
+	reviewers := make([]entities.Reviewer, len(row.reviewers))
+	for i, r := range row.reviewers {
+		reviewers[i] = copyReviewer(r.reviewer)
+	}
+
+	return entities.NewPullRequest(
+		row.id,
+		row.name,
+		row.authorID,
+		row.status,
+		reviewers,
+		row.createdAt,
+		copyPtr(row.mergedAt),
+	)
+}
+
+// prsWhere returns the matching pull requests newest first
+func prsWhere(d *orgData, match func(prRow) bool) ([]*entities.PullRequest, error) {
+	rows := make([]prRow, 0, len(d.prs))
+	for _, row := range d.prs {
+		if match(row) {
+			rows = append(rows, row)
+		}
+	}
+	slices.SortFunc(rows, func(a, b prRow) int {
+		if c := b.createdAt.Compare(a.createdAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id.String(), b.id.String())
+	})
+
+	prs := make([]*entities.PullRequest, len(rows))
+	for i, row := range rows {
+		pr, err := prToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		prs[i] = pr
+	}
+	return prs, nil
+}
+
+func (r *PullRequestRepository) Create(
+	ctx context.Context,
+	pr *entities.PullRequest,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return insertPullRequest(r.store.data(ctx), pr)
+}
+
+func insertPullRequest(d *orgData, pr *entities.PullRequest) error {
+	if _, ok := d.prs[pr.ID()]; ok {
+		return fmt.Errorf("pull request %s already exists", pr.ID())
+	}
+
+	row := prRow{
+		id:        pr.ID(),
+		name:      pr.Name(),
+		authorID:  pr.AuthorID(),
+		status:    pr.Status(),
+		createdAt: pr.CreatedAt(),
+	}
+	for _, reviewer := range pr.Reviewers() {
+		row.reviewers = append(row.reviewers, newReviewerRow(reviewer))
+	}
+	d.prs[pr.ID()] = row
+	return nil
+}
+
+// newReviewerRow stores an assignment without its verdict, as AddReviewer does
+func newReviewerRow(reviewer entities.Reviewer) reviewerRow {
+	reviewer = copyReviewer(reviewer)
+	reviewer.Verdict = ""
+	reviewer.VerdictAt = nil
+	return reviewerRow{reviewer: reviewer}
+}
+
+func (r *PullRequestRepository) FindByID(
+	ctx context.Context,
+	id entities.PullRequestID,
+) (*entities.PullRequest, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	row, ok := r.store.data(ctx).prs[id]
+	if !ok {
+		return nil, nil
+	}
+	return prToDomain(row)
+}
+
+func (r *PullRequestRepository) FindAll(
+	ctx context.Context,
+) ([]*entities.PullRequest, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return prsWhere(r.store.data(ctx), func(prRow) bool { return true })
+}
+
+// Update syncs the status and the reviewers like the postgres repository:
+// dropped reviewers are moved to the removals, new ones added and existing
+// ones only get their verdict updated
+func (r *PullRequestRepository) Update(
+	ctx context.Context,
+	pr *entities.PullRequest,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	row, ok := d.prs[pr.ID()]
+	if !ok {
+		return errNoRows
+	}
+	row.status = pr.Status()
+	row.mergedAt = copyPtr(pr.MergedAtPtr())
+
+	wanted := make(map[entities.UserID]entities.Reviewer)
+	for _, reviewer := range pr.Reviewers() {
+		wanted[reviewer.UserID] = reviewer
+	}
+
+	removals := make(map[entities.UserID]entities.ReviewerRemoval)
+	for _, removal := range pr.Removals() {
+		removals[removal.UserID] = removal
+	}
+
+	reviewers := make([]reviewerRow, 0, len(pr.Reviewers()))
+	current := make(map[entities.UserID]bool)
+	for _, rev := range row.reviewers {
+		reviewer, ok := wanted[rev.reviewer.UserID]
+		if !ok {
+			removal, recorded := removals[rev.reviewer.UserID]
+			if !recorded {
+				removal = entities.ReviewerRemoval{UserID: rev.reviewer.UserID}
+			}
+			if removal.RemovedAt.IsZero() {
+				removal.RemovedAt = r.store.now()
+			}
+			row.removals = append(row.removals, removalRow{
+				removal:    removal,
+				assignedAt: rev.reviewer.AssignedAt,
+				assignedBy: rev.reviewer.AssignedBy,
+			})
+			continue
+		}
+		current[rev.reviewer.UserID] = true
+		if reviewer.Verdict != "" {
+			rev.reviewer.Verdict = reviewer.Verdict
+			rev.reviewer.VerdictAt = copyPtr(reviewer.VerdictAt)
+		}
+		reviewers = append(reviewers, rev)
+	}
+	for _, reviewer := range pr.Reviewers() {
+		if !current[reviewer.UserID] {
+			reviewers = append(reviewers, newReviewerRow(reviewer))
+		}
+	}
+	slices.SortStableFunc(reviewers, func(a, b reviewerRow) int {
+		return a.reviewer.AssignedAt.Compare(b.reviewer.AssignedAt)
+	})
+
+	row.reviewers = reviewers
+	d.prs[pr.ID()] = row
+	return nil
+}
+
+func (r *PullRequestRepository) DeleteByID(
+	ctx context.Context,
+	id entities.PullRequestID,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.data(ctx).prs, id)
+	return nil
+}
+
+func (r *PullRequestRepository) FindPullRequestByUserID(
+	ctx context.Context,
+	id entities.UserID,
+) ([]*entities.PullRequest, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return prsWhere(r.store.data(ctx), func(row prRow) bool {
+		return slices.ContainsFunc(row.reviewers, func(rev reviewerRow) bool {
+			return rev.reviewer.UserID == id
+		})
+	})
+}
+
+func (r *PullRequestRepository) FindOpenPullRequests(
+	ctx context.Context,
+) ([]*entities.PullRequest, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return prsWhere(r.store.data(ctx), func(row prRow) bool {
+		return row.status == entities.StatusOpen
+	})
+}
+
+func (r *PullRequestRepository) FindOpenPullRequestsByTeamID(
+	ctx context.Context,
+	id entities.TeamID,
+) ([]*entities.PullRequest, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	return prsWhere(d, func(row prRow) bool {
+		author, ok := d.users[row.authorID]
+		return row.status == entities.StatusOpen && ok && inTeam(author, id)
+	})
+}
+
+func (r *PullRequestRepository) CountOpenReviewsByUser(
+	ctx context.Context,
+) (map[entities.UserID]int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	counts := make(map[entities.UserID]int)
+	for _, row := range r.store.data(ctx).prs {
+		if row.status != entities.StatusOpen {
+			continue
+		}
+		for _, rev := range row.reviewers {
+			counts[rev.reviewer.UserID]++
+		}
+	}
+	return counts, nil
+}
+
+func (r *PullRequestRepository) CountOpenReviews(
+	ctx context.Context,
+	id entities.UserID,
+) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	count := 0
+	for _, row := range r.store.data(ctx).prs {
+		if row.status != entities.StatusOpen {
+			continue
+		}
+		for _, rev := range row.reviewers {
+			if rev.reviewer.UserID == id {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type ReviewReminderRepository struct {
+	store *Store
+}
+
+func NewReviewReminderRepository(store *Store) *ReviewReminderRepository {
+	return &ReviewReminderRepository{
+		store: store,
+	}
+}
+
+// FindOverdue uses the SLA of the author's team like the postgres query
+func (r *ReviewReminderRepository) FindOverdue(
+	ctx context.Context,
+	defaults entities.ReviewSLA,
+) ([]entities.OverdueReview, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	now := r.store.now()
+
+	var overdue []entities.OverdueReview
+	for _, pr := range d.prs {
+		if pr.status != entities.StatusOpen {
+			continue
+		}
+
+		sla, teamName := defaults, ""
+		if author, ok := d.users[pr.authorID]; ok && author.teamID != nil {
+			if team, ok := d.teams[*author.teamID]; ok {
+				teamName = team.name
+				if team.sla != nil {
+					sla = *team.sla
+				}
+			}
+		}
+
+		for _, rev := range pr.reviewers {
+			if rev.reviewer.Verdict != "" || now.Sub(rev.reviewer.AssignedAt) < sla.RemindAfter {
+				continue
+			}
+			overdue = append(overdue, entities.OverdueReview{
+				PullRequestID:   pr.id,
+				PullRequestName: pr.name,
+				AuthorID:        pr.authorID,
+				ReviewerID:      rev.reviewer.UserID,
+				TeamName:        teamName,
+				AssignedAt:      rev.reviewer.AssignedAt,
+				RemindedAt:      copyPtr(rev.remindedAt),
+				SLA:             sla,
+			})
+		}
+	}
+
+	slices.SortStableFunc(overdue, func(a, b entities.OverdueReview) int {
+		return a.AssignedAt.Compare(b.AssignedAt)
+	})
+	return overdue, nil
+}
+
+func (r *ReviewReminderRepository) MarkReminded(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+	at time.Time,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	pr, ok := r.store.data(ctx).prs[prID]
+	if !ok {
+		return nil
+	}
+	for i, rev := range pr.reviewers {
+		if rev.reviewer.UserID == reviewerID {
+			pr.reviewers[i].remindedAt = &at
+		}
+	}
+	return nil
+}
+
+func (r *ReviewReminderRepository) SetTeamSLA(
+	ctx context.Context,
+	teamID entities.TeamID,
+	sla *entities.ReviewSLA,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	team, ok := d.teams[teamID]
+	if !ok {
+		return nil
+	}
+	team.sla = copyPtr(sla)
+	d.teams[teamID] = team
+	return nil
+}
@@ -0,0 +1,178 @@
+// Package memory keeps the repositories in process memory, for tests and
+// trying the service out without Postgres. It follows the postgres package:
+// missing rows are nil, nil, lists come back in the same order and every
+// query is scoped to the organization of the context. Stats are not
+// implemented, they're aggregates over the database.
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/tenant"
+)
+
+// errNoRows matches an update of a row that doesn't exist, where postgres
+// returns pgx.ErrNoRows
+var errNoRows = errors.New("no rows in result set")
+
+// Store holds the data of every repository created from it, they share one
+// lock so a repository call is atomic like a postgres transaction
+type Store struct {
+	mu  sync.Mutex
+	now func() time.Time
+
+	orgs    map[entities.OrgID]*orgData
+	orgRows []orgRow
+	tokens  []tokenRow
+
+	nextOrgID   entities.OrgID
+	nextTeamID  entities.TeamID
+	nextTokenID entities.TokenID
+}
+
+// NewStore returns an empty store with the default organization, as the
+// migrations leave a fresh database
+func NewStore() *Store {
+	s := &Store{
+		now:         time.Now,
+		orgs:        make(map[entities.OrgID]*orgData),
+		nextOrgID:   entities.DefaultOrgID,
+		nextTeamID:  1,
+		nextTokenID: 1,
+	}
+	s.addOrg("default")
+	return s
+}
+
+type orgRow struct {
+	id        entities.OrgID
+	name      string
+	createdAt time.Time
+}
+
+type orgData struct {
+	users      map[entities.UserID]userRow
+	teams      map[entities.TeamID]teamRow
+	prs        map[entities.PullRequestID]prRow
+	prefs      map[entities.UserID][]entities.NotificationPreference
+	digestRuns map[string]bool
+}
+
+type userRow struct {
+	id             entities.UserID
+	username       string
+	active         bool
+	teamID         *entities.TeamID
+	maxOpenReviews *int
+	outOfOffice    *time.Time
+}
+
+type teamRow struct {
+	id   entities.TeamID
+	name string
+	// nil uses the scheduler defaults
+	sla *entities.ReviewSLA
+}
+
+type prRow struct {
+	id        entities.PullRequestID
+	name      string
+	authorID  entities.UserID
+	status    entities.PRStatus
+	createdAt time.Time
+	mergedAt  *time.Time
+	// in assignment order
+	reviewers []reviewerRow
+	// reviewers taken off, in removal order
+	removals []removalRow
+}
+
+type reviewerRow struct {
+	reviewer   entities.Reviewer
+	remindedAt *time.Time
+}
+
+// removalRow keeps the assignment with its removal like reviewer_removals
+type removalRow struct {
+	removal    entities.ReviewerRemoval
+	assignedAt time.Time
+	assignedBy string
+}
+
+type tokenRow struct {
+	id        entities.TokenID
+	name      string
+	hash      string
+	role      entities.Role
+	userID    *entities.UserID
+	orgID     *entities.OrgID
+	createdAt time.Time
+	revokedAt *time.Time
+}
+
+func newOrgData() *orgData {
+	return &orgData{
+		users:      make(map[entities.UserID]userRow),
+		teams:      make(map[entities.TeamID]teamRow),
+		prs:        make(map[entities.PullRequestID]prRow),
+		prefs:      make(map[entities.UserID][]entities.NotificationPreference),
+		digestRuns: make(map[string]bool),
+	}
+}
+
+// clone is a deep copy, rows with slices get their own
+func (d *orgData) clone() *orgData {
+	c := newOrgData()
+	for id, row := range d.users {
+		c.users[id] = row
+	}
+	for id, row := range d.teams {
+		c.teams[id] = row
+	}
+	for id, row := range d.prs {
+		row.reviewers = append([]reviewerRow(nil), row.reviewers...)
+		row.removals = append([]removalRow(nil), row.removals...)
+		c.prs[id] = row
+	}
+	for id, prefs := range d.prefs {
+		c.prefs[id] = append([]entities.NotificationPreference(nil), prefs...)
+	}
+	for day := range d.digestRuns {
+		c.digestRuns[day] = true
+	}
+	return c
+}
+
+// addOrg expects the lock to be held
+func (s *Store) addOrg(name string) orgRow {
+	row := orgRow{id: s.nextOrgID, name: name, createdAt: s.now()}
+	s.nextOrgID++
+	s.orgRows = append(s.orgRows, row)
+	s.orgs[row.id] = newOrgData()
+	return row
+}
+
+// data returns the organization of the context, the lock must be held.
+// Unknown organizations read as empty, like a query filtering by their id
+func (s *Store) data(ctx context.Context) *orgData {
+	id := tenant.OrgID(ctx)
+	d, ok := s.orgs[id]
+	if !ok {
+		d = newOrgData()
+		s.orgs[id] = d
+	}
+	return d
+}
+
+// copyPtr keeps rows from sharing memory with the entities they came from
+func copyPtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
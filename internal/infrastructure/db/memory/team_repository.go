@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type TeamRepository struct {
+	store *Store
+}
+
+func NewTeamRepository(store *Store) *TeamRepository {
+	return &TeamRepository{
+		store: store,
+	}
+}
+
+// teamToDomain expects the lock to be held, members are the users of the
+// team like in postgres
+func teamToDomain(d *orgData, row teamRow) (*entities.Team, error) {
+	team, err := entities.NewTeam(row.name, row.id)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := usersWhere(d, func(u userRow) bool { return inTeam(u, row.id) })
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if err := team.AddMember(member.ID()); err != nil {
+			return nil, err
+		}
+	}
+	return team, nil
+}
+
+func teamByName(d *orgData, name string) (teamRow, bool) {
+	for _, row := range d.teams {
+		if row.name == name {
+			return row, true
+		}
+	}
+	return teamRow{}, false
+}
+
+// Create stores the team under a new id and ignores its members, they join
+// by their team id
+func (r *TeamRepository) Create(
+	ctx context.Context,
+	team *entities.Team,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	_, err := r.store.createTeam(r.store.data(ctx), team.Name())
+	return err
+}
+
+// createTeam expects the lock to be held
+func (s *Store) createTeam(d *orgData, name string) (entities.TeamID, error) {
+	if _, ok := teamByName(d, name); ok {
+		return 0, fmt.Errorf("team %s already exists", name)
+	}
+	id := s.nextTeamID
+	s.nextTeamID++
+	d.teams[id] = teamRow{id: id, name: name}
+	return id, nil
+}
+
+func (r *TeamRepository) DeleteByID(
+	ctx context.Context,
+	id entities.TeamID,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.data(ctx).teams, id)
+	return nil
+}
+
+func (r *TeamRepository) FindByID(
+	ctx context.Context,
+	id entities.TeamID,
+) (*entities.Team, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	row, ok := d.teams[id]
+	if !ok {
+		return nil, nil
+	}
+	return teamToDomain(d, row)
+}
+
+func (r *TeamRepository) FindByName(
+	ctx context.Context,
+	name string,
+) (*entities.Team, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	row, ok := teamByName(d, name)
+	if !ok {
+		return nil, nil
+	}
+	return teamToDomain(d, row)
+}
+
+func (r *TeamRepository) FindByUserID(
+	ctx context.Context,
+	id entities.UserID,
+) (*entities.Team, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	user, ok := d.users[id]
+	if !ok || user.teamID == nil {
+		return nil, nil
+	}
+	row, ok := d.teams[*user.teamID]
+	if !ok {
+		return nil, nil
+	}
+	return teamToDomain(d, row)
+}
+
+func (r *TeamRepository) FindAll(
+	ctx context.Context,
+) ([]*entities.Team, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	ids := make([]entities.TeamID, 0, len(d.teams))
+	for id := range d.teams {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	teams := make([]*entities.Team, len(ids))
+	for i, id := range ids {
+		team, err := teamToDomain(d, d.teams[id])
+		if err != nil {
+			return nil, err
+		}
+		teams[i] = team
+	}
+	return teams, nil
+}
+
+func (r *TeamRepository) Update(
+	ctx context.Context,
+	team *entities.Team,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	row, ok := d.teams[team.ID()]
+	if !ok {
+		return nil
+	}
+	row.name = team.Name()
+	d.teams[team.ID()] = row
+	return nil
+}
+
+func (r *TeamRepository) FindActiveReviewersByTeamID(
+	ctx context.Context,
+	id entities.TeamID,
+) ([]*entities.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return usersWhere(r.store.data(ctx), func(row userRow) bool {
+		return row.active && inTeam(row, id)
+	})
+}
+
+func (r *TeamRepository) TeamExists(
+	ctx context.Context,
+	name string,
+) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	_, ok := teamByName(r.store.data(ctx), name)
+	return ok, nil
+}
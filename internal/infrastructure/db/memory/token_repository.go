@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type TokenRepository struct {
+	store *Store
+}
+
+func NewTokenRepository(store *Store) *TokenRepository {
+	return &TokenRepository{
+		store: store,
+	}
+}
+
+func tokenToDomain(row tokenRow) (*entities.APIToken, error) {
+	return entities.NewAPIToken(
+		row.id,
+		row.name,
+		row.role,
+		copyPtr(row.userID),
+		copyPtr(row.orgID),
+		row.createdAt,
+		copyPtr(row.revokedAt),
+	)
+}
+
+func (r *TokenRepository) Create(
+	_ context.Context,
+	token *entities.APIToken,
+	hash string,
+) (*entities.APIToken, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	row := tokenRow{
+		id:        r.store.nextTokenID,
+		name:      token.Name(),
+		hash:      hash,
+		role:      token.Role(),
+		userID:    copyPtr(token.UserID()),
+		orgID:     copyPtr(token.OrgID()),
+		createdAt: r.store.now(),
+	}
+	r.store.nextTokenID++
+	r.store.tokens = append(r.store.tokens, row)
+	return tokenToDomain(row)
+}
+
+func (r *TokenRepository) FindActiveByHash(
+	_ context.Context,
+	hash string,
+) (*entities.APIToken, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, row := range r.store.tokens {
+		if row.hash == hash && row.revokedAt == nil {
+			return tokenToDomain(row)
+		}
+	}
+	return nil, nil
+}
+
+func (r *TokenRepository) FindAll(
+	_ context.Context,
+) ([]*entities.APIToken, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	tokens := make([]*entities.APIToken, len(r.store.tokens))
+	for i, row := range r.store.tokens {
+		token, err := tokenToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		tokens[i] = token
+	}
+	return tokens, nil
+}
+
+func (r *TokenRepository) Revoke(
+	_ context.Context,
+	id entities.TokenID,
+) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i, row := range r.store.tokens {
+		if row.id == id && row.revokedAt == nil {
+			now := r.store.now()
+			r.store.tokens[i].revokedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
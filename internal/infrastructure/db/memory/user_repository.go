@@ -0,0 +1,203 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type UserRepository struct {
+	store *Store
+}
+
+func NewUserRepository(store *Store) *UserRepository {
+	return &UserRepository{
+		store: store,
+	}
+}
+
+func userToRow(user *entities.User) userRow {
+	return userRow{
+		id:             user.ID(),
+		username:       user.Username(),
+		active:         user.IsActive(),
+		teamID:         copyPtr(user.TeamID()),
+		maxOpenReviews: copyPtr(user.MaxOpenReviews()),
+		outOfOffice:    copyPtr(user.OutOfOfficeUntil()),
+	}
+}
+
+func userToDomain(row userRow) (*entities.User, error) {
+	user, err := entities.NewUser(row.id, row.username, row.active, copyPtr(row.teamID))
+	if err != nil {
+		return nil, err
+	}
+	if err := user.SetMaxOpenReviews(copyPtr(row.maxOpenReviews)); err != nil {
+		return nil, err
+	}
+	user.SetOutOfOfficeUntil(copyPtr(row.outOfOffice))
+	return user, nil
+}
+
+// usersWhere returns the matching users ordered by id
+func usersWhere(d *orgData, match func(userRow) bool) ([]*entities.User, error) {
+	rows := make([]userRow, 0, len(d.users))
+	for _, row := range d.users {
+		if match(row) {
+			rows = append(rows, row)
+		}
+	}
+	slices.SortFunc(rows, func(a, b userRow) int {
+		return strings.Compare(a.id.String(), b.id.String())
+	})
+
+	users := make([]*entities.User, len(rows))
+	for i, row := range rows {
+		user, err := userToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		users[i] = user
+	}
+	return users, nil
+}
+
+func inTeam(row userRow, id entities.TeamID) bool {
+	return row.teamID != nil && *row.teamID == id
+}
+
+func (r *UserRepository) Create(
+	ctx context.Context,
+	user *entities.User,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return createUser(r.store.data(ctx), user)
+}
+
+func createUser(d *orgData, user *entities.User) error {
+	if _, ok := d.users[user.ID()]; ok {
+		return fmt.Errorf("user %s already exists", user.ID())
+	}
+	d.users[user.ID()] = userToRow(user)
+	return nil
+}
+
+func (r *UserRepository) DeleteByID(
+	ctx context.Context,
+	id entities.UserID,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.data(ctx).users, id)
+	return nil
+}
+
+func (r *UserRepository) FindByID(
+	ctx context.Context,
+	id entities.UserID,
+) (*entities.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	row, ok := r.store.data(ctx).users[id]
+	if !ok {
+		return nil, nil
+	}
+	return userToDomain(row)
+}
+
+func (r *UserRepository) FindAll(
+	ctx context.Context,
+) ([]*entities.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return usersWhere(r.store.data(ctx), func(userRow) bool { return true })
+}
+
+// Update leaves the capacity and the absence alone, they only change through
+// SetMaxOpenReviews and SetOutOfOffice
+func (r *UserRepository) Update(
+	ctx context.Context,
+	user *entities.User,
+) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	updateUser(r.store.data(ctx), user)
+	return nil
+}
+
+// updateUser is a no-op for unknown users, like an UPDATE matching no rows
+func updateUser(d *orgData, user *entities.User) {
+	row, ok := d.users[user.ID()]
+	if !ok {
+		return
+	}
+	row.username = user.Username()
+	row.active = user.IsActive()
+	row.teamID = copyPtr(user.TeamID())
+	d.users[user.ID()] = row
+}
+
+func (r *UserRepository) GetActiveUsers(
+	ctx context.Context,
+) ([]*entities.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return usersWhere(r.store.data(ctx), func(row userRow) bool { return row.active })
+}
+
+func (r *UserRepository) GetByTeamID(
+	ctx context.Context,
+	id entities.TeamID,
+) ([]*entities.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return usersWhere(r.store.data(ctx), func(row userRow) bool { return inTeam(row, id) })
+}
+
+func (r *UserRepository) SetMaxOpenReviews(
+	ctx context.Context,
+	id entities.UserID,
+	max *int,
+) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	row, ok := d.users[id]
+	if !ok {
+		return false, nil
+	}
+	row.maxOpenReviews = copyPtr(max)
+	d.users[id] = row
+	return true, nil
+}
+
+func (r *UserRepository) SetOutOfOffice(
+	ctx context.Context,
+	id entities.UserID,
+	until *time.Time,
+) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	d := r.store.data(ctx)
+	row, ok := d.users[id]
+	if !ok {
+		return false, nil
+	}
+	row.outOfOffice = copyPtr(until)
+	d.users[id] = row
+	return true, nil
+}
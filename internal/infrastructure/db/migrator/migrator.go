@@ -0,0 +1,123 @@
+package migrator
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/Traunin/review-assigner/migrations"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// replicas starting at the same time queue up on the driver's advisory
+// lock, so the timeout has to cover a full migration run
+const lockTimeout = 5 * time.Minute
+
+type Migration struct {
+	Version uint
+	Applied bool
+}
+
+type Status struct {
+	Version    uint
+	Dirty      bool
+	Migrations []Migration
+}
+
+type Migrator struct {
+	m      *migrate.Migrate
+	source fs.FS
+}
+
+// New opens a migrator over the embedded migrations. The postgres driver
+// takes pg_advisory_lock for every run, so concurrent replicas can't
+// apply the same migration twice.
+func New(dsn string) (*Migrator, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("open embedded migrations: %w", err)
+	}
+
+	p := &postgres.Postgres{}
+	driver, err := p.Open(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
+	if err != nil {
+		return nil, fmt.Errorf("init migrate: %w", err)
+	}
+	m.LockTimeout = lockTimeout
+
+	return &Migrator{m: m, source: migrations.FS}, nil
+}
+
+// Up applies all pending migrations, it's a no-op when the schema is current
+func (mg *Migrator) Up() error {
+	if err := mg.m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Down rolls back the given number of migrations
+func (mg *Migrator) Down(steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive, got %d", steps)
+	}
+	if err := mg.m.Steps(-steps); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+func (mg *Migrator) Status() (Status, error) {
+	version, dirty, err := mg.m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return Status{}, err
+	}
+
+	versions, err := mg.availableVersions()
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{Version: version, Dirty: dirty}
+	for _, v := range versions {
+		status.Migrations = append(status.Migrations, Migration{
+			Version: v,
+			Applied: v <= version,
+		})
+	}
+
+	return status, nil
+}
+
+func (mg *Migrator) Close() error {
+	srcErr, dbErr := mg.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+func (mg *Migrator) availableVersions() ([]uint, error) {
+	src, err := iofs.New(mg.source, ".")
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	var versions []uint
+	v, err := src.First()
+	for err == nil {
+		versions = append(versions, v)
+		v, err = src.Next(v)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	return versions, nil
+}
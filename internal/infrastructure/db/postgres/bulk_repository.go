@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+)
+
+type BulkRepository struct {
+	db *DB
+}
+
+func NewBulkRepository(db *DB) *BulkRepository {
+	return &BulkRepository{
+		db: db,
+	}
+}
+
+func (r *BulkRepository) Apply(
+	ctx context.Context,
+	plan repositories.BulkPlan,
+) error {
+	org := orgID(ctx)
+	return r.db.execTx(ctx, func(q *sqlc.Queries) error {
+		teamIDs := make(map[string]entities.TeamID)
+		for _, name := range plan.Teams {
+			row, err := q.CreateTeam(ctx, sqlc.CreateTeamParams{
+				OrgID:    org,
+				TeamName: name,
+			})
+			if err != nil {
+				return fmt.Errorf("create team %s: %w", name, err)
+			}
+			teamIDs[name] = entities.TeamID(row.ID)
+		}
+
+		for _, u := range plan.Users {
+			teamID, ok := teamIDs[u.TeamName]
+			if !ok {
+				row, err := q.GetTeamByName(ctx, sqlc.GetTeamByNameParams{
+					OrgID:    org,
+					TeamName: u.TeamName,
+				})
+				if err != nil {
+					return fmt.Errorf("find team %s: %w", u.TeamName, err)
+				}
+				teamID = entities.TeamID(row.ID)
+				teamIDs[u.TeamName] = teamID
+			}
+			u.User.SetTeamID(&teamID)
+
+			var err error
+			if u.New {
+				_, err = q.CreateUser(ctx, sqlc.CreateUserParams{
+					OrgID:    org,
+					UserID:   u.User.ID().String(),
+					Username: u.User.Username(),
+					IsActive: u.User.IsActive(),
+					TeamID:   teamIdToPgInt4(teamID),
+				})
+			} else {
+				err = q.UpdateUser(ctx, sqlc.UpdateUserParams{
+					OrgID:    org,
+					UserID:   u.User.ID().String(),
+					Username: u.User.Username(),
+					IsActive: u.User.IsActive(),
+					TeamID:   teamIdToPgInt4(teamID),
+				})
+			}
+			if err != nil {
+				return fmt.Errorf("save user %s: %w", u.User.ID(), err)
+			}
+			if err := recordActivity(ctx, q, u.User); err != nil {
+				return err
+			}
+		}
+
+		for _, pr := range plan.PullRequests {
+			if err := insertPullRequest(ctx, q, pr); err != nil {
+				return fmt.Errorf("create pull request %s: %w", pr.ID(), err)
+			}
+		}
+
+		return nil
+	})
+}
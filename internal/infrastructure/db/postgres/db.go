@@ -2,9 +2,11 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -46,3 +48,59 @@ func (db *DB) execTx(ctx context.Context, fn func(*sqlc.Queries) error) error {
 
 	return nil
 }
+
+func (db *DB) Ping(ctx context.Context) error {
+	return db.pool.Ping(ctx)
+}
+
+// MigrationVersion reads the state golang-migrate keeps in schema_migrations
+func (db *DB) MigrationVersion(ctx context.Context) (int64, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+
+	err := db.pool.QueryRow(
+		ctx,
+		"SELECT version, dirty FROM schema_migrations LIMIT 1",
+	).Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("read migration version: %w", err)
+	}
+
+	return version, dirty, nil
+}
+
+// TryAdvisoryLock runs fn while holding a session advisory lock on key and
+// reports false without running it when another session holds the lock
+func (db *DB) TryAdvisoryLock(
+	ctx context.Context,
+	key int64,
+	fn func(ctx context.Context) error,
+) (bool, error) {
+	conn, err := db.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	var locked bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&locked); err != nil {
+		return false, fmt.Errorf("take advisory lock: %w", err)
+	}
+	if !locked {
+		return false, nil
+	}
+	defer func() {
+		// the lock dies with the session anyway, a failed unlock is only
+		// worth dropping the connection over
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			_ = conn.Conn().Close(context.Background())
+		}
+	}()
+
+	return true, fn(ctx)
+}
@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type DigestRepository struct {
+	db *DB
+}
+
+func NewDigestRepository(db *DB) *DigestRepository {
+	return &DigestRepository{
+		db: db,
+	}
+}
+
+func (r *DigestRepository) ClaimRun(ctx context.Context, day time.Time) (bool, error) {
+	return r.db.Queries.ClaimDigestRun(ctx, sqlc.ClaimDigestRunParams{
+		OrgID:   orgID(ctx),
+		RunDate: pgtype.Date{Time: day, Valid: true},
+	})
+}
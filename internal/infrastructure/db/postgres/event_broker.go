@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/events"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	eventsChannel = "review_assigner_events"
+	// NOTIFY rejects payloads of 8000 bytes or more
+	maxEventPayload = 7999
+	// how long to wait before listening again after the connection broke
+	listenRetry = 5 * time.Second
+)
+
+// EventBroker publishes events with NOTIFY and feeds those of every replica,
+// its own included, into the local bus. Events raised while a replica is
+// reconnecting are lost for its subscribers.
+type EventBroker struct {
+	db     *DB
+	bus    *events.Bus
+	logger *slog.Logger
+}
+
+func NewEventBroker(db *DB, bus *events.Bus, logger *slog.Logger) *EventBroker {
+	return &EventBroker{
+		db:     db,
+		bus:    bus,
+		logger: logger,
+	}
+}
+
+// the event hides its organization from clients, replicas still need it
+type eventPayload struct {
+	OrgID entities.OrgID `json:"org_id"`
+	events.Event
+}
+
+func (b *EventBroker) Publish(ctx context.Context, e events.Event) error {
+	payload, err := json.Marshal(eventPayload{OrgID: e.OrgID, Event: e})
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxEventPayload {
+		return fmt.Errorf("event payload is %d bytes, NOTIFY allows %d", len(payload), maxEventPayload)
+	}
+
+	return b.db.Queries.PublishEvent(ctx, sqlc.PublishEventParams{
+		Channel: eventsChannel,
+		Payload: string(payload),
+	})
+}
+
+// Listen delivers notifications to the bus until ctx is done, reconnecting
+// when the connection breaks
+func (b *EventBroker) Listen(ctx context.Context) {
+	for {
+		err := b.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		b.logger.Warn("event listener disconnected", "retry_in", listenRetry, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetry):
+		}
+	}
+}
+
+func (b *EventBroker) listen(ctx context.Context) error {
+	pooled, err := b.db.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	// a listening session must not go back to the pool
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{eventsChannel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var p eventPayload
+		if err := json.Unmarshal([]byte(n.Payload), &p); err != nil {
+			b.logger.Warn("malformed event notification", "error", err)
+			continue
+		}
+		p.Event.OrgID = p.OrgID
+		b.bus.Deliver(p.Event)
+	}
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type NotificationPreferenceRepository struct {
+	db *DB
+}
+
+func NewNotificationPreferenceRepository(db *DB) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{
+		db: db,
+	}
+}
+
+func (r *NotificationPreferenceRepository) FindByUserID(
+	ctx context.Context,
+	userID entities.UserID,
+) ([]entities.NotificationPreference, error) {
+	rows, err := r.db.Queries.GetNotificationPreferences(ctx, sqlc.GetNotificationPreferencesParams{
+		OrgID:  orgID(ctx),
+		UserID: userID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prefs := make([]entities.NotificationPreference, len(rows))
+	for i, row := range rows {
+		prefs[i] = entities.NotificationPreference{
+			Channel: entities.NotificationChannel(row.Channel),
+			Address: row.Address.String,
+			Enabled: row.Enabled,
+		}
+	}
+
+	return prefs, nil
+}
+
+func (r *NotificationPreferenceRepository) Replace(
+	ctx context.Context,
+	userID entities.UserID,
+	prefs []entities.NotificationPreference,
+) error {
+	org := orgID(ctx)
+	return r.db.execTx(ctx, func(q *sqlc.Queries) error {
+		err := q.DeleteNotificationPreferences(ctx, sqlc.DeleteNotificationPreferencesParams{
+			OrgID:  org,
+			UserID: userID.String(),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, pref := range prefs {
+			err := q.AddNotificationPreference(ctx, sqlc.AddNotificationPreferenceParams{
+				OrgID:   org,
+				UserID:  userID.String(),
+				Channel: string(pref.Channel),
+				Address: pgtype.Text{String: pref.Address, Valid: pref.Address != ""},
+				Enabled: pref.Enabled,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+	"github.com/Traunin/review-assigner/internal/tenant"
+	"github.com/jackc/pgx/v5"
+)
+
+type OrganizationRepository struct {
+	db *DB
+}
+
+func NewOrganizationRepository(db *DB) *OrganizationRepository {
+	return &OrganizationRepository{
+		db: db,
+	}
+}
+
+// orgID is the tenant every scoped query filters by
+func orgID(ctx context.Context) int32 {
+	return int32(tenant.OrgID(ctx))
+}
+
+func orgToDomain(row sqlc.Organization) (*entities.Organization, error) {
+	return entities.NewOrganization(
+		entities.OrgID(row.ID),
+		row.Name,
+		pgTimestamptzToTime(row.CreatedAt),
+	)
+}
+
+func (r *OrganizationRepository) Create(
+	ctx context.Context,
+	org *entities.Organization,
+) (*entities.Organization, error) {
+	row, err := r.db.Queries.CreateOrganization(ctx, org.Name())
+	if err != nil {
+		return nil, err
+	}
+	return orgToDomain(row)
+}
+
+func (r *OrganizationRepository) FindByID(
+	ctx context.Context,
+	id entities.OrgID,
+) (*entities.Organization, error) {
+	row, err := r.db.Queries.GetOrganizationByID(ctx, int32(id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return orgToDomain(row)
+}
+
+func (r *OrganizationRepository) FindAll(
+	ctx context.Context,
+) ([]*entities.Organization, error) {
+	rows, err := r.db.Queries.GetOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := make([]*entities.Organization, len(rows))
+	for i, row := range rows {
+		org, err := orgToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		orgs[i] = org
+	}
+	return orgs, nil
+}
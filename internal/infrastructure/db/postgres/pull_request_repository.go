@@ -62,6 +62,18 @@ func timePtrToPgTimestamptz(t *time.Time) pgtype.Timestamptz {
 	}
 }
 
+// seeds use all 64 bits, they're stored as the signed value with the same bits
+func seedToPgInt8(seed *uint64) pgtype.Int8 {
+	if seed == nil {
+		return pgtype.Int8{Valid: false}
+	}
+	return pgtype.Int8{Int64: int64(*seed), Valid: true}
+}
+
+func actorToPgText(actor string) pgtype.Text {
+	return pgtype.Text{String: actor, Valid: actor != ""}
+}
+
 func pgTimestamptzToTime(ts pgtype.Timestamptz) time.Time {
 	if !ts.Valid {
 		return time.Time{}
@@ -73,7 +85,10 @@ func (r *PullRequestRepository) buildPullRequestWithReviewers(
 	ctx context.Context,
 	prRow sqlc.PullRequest,
 ) (*entities.PullRequest, error) {
-	reviewerRows, err := r.db.Queries.GetReviewersByPR(ctx, prRow.PullRequestID)
+	reviewerRows, err := r.db.Queries.GetReviewersByPR(ctx, sqlc.GetReviewersByPRParams{
+		OrgID:         prRow.OrgID,
+		PullRequestID: prRow.PullRequestID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +98,16 @@ func (r *PullRequestRepository) buildPullRequestWithReviewers(
 		reviewers[i] = entities.Reviewer{
 			UserID:     entities.UserID(reviewer.UserID),
 			AssignedAt: pgTimestamptzToTime(reviewer.AssignedAt),
+			AssignedBy: reviewer.AssignedBy.String,
+		}
+		if reviewer.SelectionSeed.Valid {
+			seed := uint64(reviewer.SelectionSeed.Int64)
+			reviewers[i].Seed = &seed
+		}
+		if reviewer.Verdict.Valid {
+			verdictAt := pgTimestamptzToTime(reviewer.VerdictAt)
+			reviewers[i].Verdict = entities.Verdict(reviewer.Verdict.String)
+			reviewers[i].VerdictAt = &verdictAt
 		}
 	}
 
@@ -113,36 +138,48 @@ func (r *PullRequestRepository) Create(
 	pr *entities.PullRequest,
 ) error {
 	return r.db.execTx(ctx, func(q *sqlc.Queries) error {
-		_, err := q.CreatePullRequest(ctx, sqlc.CreatePullRequestParams{
-			PullRequestID:   pr.ID().String(),
-			PullRequestName: pr.Name(),
-			AuthorID:        pr.AuthorID().String(),
-			Status:          prStatusToDB(pr.Status()),
-			CreatedAt:       timeToPgTimestamptz(pr.CreatedAt()),
-		})
-		if err != nil {
-			return err
-		}
+		return insertPullRequest(ctx, q, pr)
+	})
+}
 
-		for _, reviewer := range pr.Reviewers() {
-			if err := q.AddReviewer(ctx, sqlc.AddReviewerParams{
-				PullRequestID: pr.ID().String(),
-				UserID:        reviewer.UserID.String(),
-				AssignedAt:    timeToPgTimestamptz(reviewer.AssignedAt),
-			}); err != nil {
-				return err
-			}
+func insertPullRequest(ctx context.Context, q *sqlc.Queries, pr *entities.PullRequest) error {
+	org := orgID(ctx)
+	_, err := q.CreatePullRequest(ctx, sqlc.CreatePullRequestParams{
+		OrgID:           org,
+		PullRequestID:   pr.ID().String(),
+		PullRequestName: pr.Name(),
+		AuthorID:        pr.AuthorID().String(),
+		Status:          prStatusToDB(pr.Status()),
+		CreatedAt:       timeToPgTimestamptz(pr.CreatedAt()),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, reviewer := range pr.Reviewers() {
+		if err := q.AddReviewer(ctx, sqlc.AddReviewerParams{
+			OrgID:         org,
+			PullRequestID: pr.ID().String(),
+			UserID:        reviewer.UserID.String(),
+			AssignedAt:    timeToPgTimestamptz(reviewer.AssignedAt),
+			SelectionSeed: seedToPgInt8(reviewer.Seed),
+			AssignedBy:    actorToPgText(reviewer.AssignedBy),
+		}); err != nil {
+			return err
 		}
+	}
 
-		return nil
-	})
+	return nil
 }
 
 func (r *PullRequestRepository) FindByID(
 	ctx context.Context,
 	id entities.PullRequestID,
 ) (*entities.PullRequest, error) {
-	prRow, err := r.db.Queries.GetPullRequestByID(ctx, id.String())
+	prRow, err := r.db.Queries.GetPullRequestByID(ctx, sqlc.GetPullRequestByIDParams{
+		OrgID:         orgID(ctx),
+		PullRequestID: id.String(),
+	})
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -155,7 +192,7 @@ func (r *PullRequestRepository) FindByID(
 func (r *PullRequestRepository) FindAll(
 	ctx context.Context,
 ) ([]*entities.PullRequest, error) {
-	prRows, err := r.db.Queries.GetPullRequests(ctx)
+	prRows, err := r.db.Queries.GetPullRequests(ctx, orgID(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -176,8 +213,10 @@ func (r *PullRequestRepository) Update(
 	ctx context.Context,
 	pr *entities.PullRequest,
 ) error {
+	org := orgID(ctx)
 	return r.db.execTx(ctx, func(q *sqlc.Queries) error {
 		_, err := q.UpdatePRStatus(ctx, sqlc.UpdatePRStatusParams{
+			OrgID:         org,
 			PullRequestID: pr.ID().String(),
 			Status:        prStatusToDB(pr.Status()),
 			MergedAt:      timePtrToPgTimestamptz(pr.MergedAtPtr()),
@@ -186,14 +225,17 @@ func (r *PullRequestRepository) Update(
 			return err
 		}
 
-		currentReviewers, err := q.GetReviewersByPR(ctx, pr.ID().String())
+		currentReviewers, err := q.GetReviewersByPR(ctx, sqlc.GetReviewersByPRParams{
+			OrgID:         org,
+			PullRequestID: pr.ID().String(),
+		})
 		if err != nil {
 			return err
 		}
 
-		currentReviewerMap := make(map[string]bool)
+		currentReviewerMap := make(map[string]sqlc.GetReviewersByPRRow)
 		for _, reviewer := range currentReviewers {
-			currentReviewerMap[reviewer.UserID] = true
+			currentReviewerMap[reviewer.UserID] = reviewer
 		}
 
 		newReviewerMap := make(map[string]entities.Reviewer)
@@ -201,11 +243,23 @@ func (r *PullRequestRepository) Update(
 			newReviewerMap[reviewer.UserID.String()] = reviewer
 		}
 
+		// the latest removal of a reviewer says who removed them, a reviewer
+		// dropped without one is recorded with an unknown actor
+		removals := make(map[string]entities.ReviewerRemoval)
+		for _, removal := range pr.Removals() {
+			removals[removal.UserID.String()] = removal
+		}
+
 		for _, reviewer := range currentReviewers {
 			if _, exists := newReviewerMap[reviewer.UserID]; !exists {
+				removal := removals[reviewer.UserID]
 				if err := q.RemoveReviewer(ctx, sqlc.RemoveReviewerParams{
+					OrgID:         org,
 					PullRequestID: pr.ID().String(),
 					UserID:        reviewer.UserID,
+					ReplacedBy:    pgtype.Text{String: removal.ReplacedBy.String(), Valid: removal.ReplacedBy != ""},
+					RemovedBy:     actorToPgText(removal.RemovedBy),
+					RemovedAt:     timeToPgTimestamptz(removal.RemovedAt),
 				}); err != nil {
 					return err
 				}
@@ -213,11 +267,28 @@ func (r *PullRequestRepository) Update(
 		}
 
 		for _, reviewer := range pr.Reviewers() {
-			if !currentReviewerMap[reviewer.UserID.String()] {
+			current, exists := currentReviewerMap[reviewer.UserID.String()]
+			if exists && reviewer.Verdict != "" &&
+				(current.Verdict.String != string(reviewer.Verdict) ||
+					!current.VerdictAt.Time.Equal(*reviewer.VerdictAt)) {
+				if err := q.SetReviewerVerdict(ctx, sqlc.SetReviewerVerdictParams{
+					OrgID:         org,
+					PullRequestID: pr.ID().String(),
+					UserID:        reviewer.UserID.String(),
+					Verdict:       pgtype.Text{String: string(reviewer.Verdict), Valid: true},
+					VerdictAt:     timePtrToPgTimestamptz(reviewer.VerdictAt),
+				}); err != nil {
+					return err
+				}
+			}
+			if !exists {
 				if err := q.AddReviewer(ctx, sqlc.AddReviewerParams{
+					OrgID:         org,
 					PullRequestID: pr.ID().String(),
 					UserID:        reviewer.UserID.String(),
 					AssignedAt:    timeToPgTimestamptz(reviewer.AssignedAt),
+					SelectionSeed: seedToPgInt8(reviewer.Seed),
+					AssignedBy:    actorToPgText(reviewer.AssignedBy),
 				}); err != nil {
 					return err
 				}
@@ -232,21 +303,31 @@ func (r *PullRequestRepository) DeleteByID(
 	ctx context.Context,
 	id entities.PullRequestID,
 ) error {
-	return r.db.Queries.DeletePullRequest(ctx, id.String())
+	return r.db.Queries.DeletePullRequest(ctx, sqlc.DeletePullRequestParams{
+		OrgID:         orgID(ctx),
+		PullRequestID: id.String(),
+	})
 }
 
 func (r *PullRequestRepository) FindPullRequestByUserID(
 	ctx context.Context,
 	id entities.UserID,
 ) ([]*entities.PullRequest, error) {
-	prRows, err := r.db.Queries.GetPRsByReviewer(ctx, id.String())
+	org := orgID(ctx)
+	prRows, err := r.db.Queries.GetPRsByReviewer(ctx, sqlc.GetPRsByReviewerParams{
+		OrgID:  org,
+		UserID: id.String(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	prs := make([]*entities.PullRequest, len(prRows))
 	for i, prRow := range prRows {
-		fullPR, err := r.db.Queries.GetPullRequestByID(ctx, prRow.PullRequestID)
+		fullPR, err := r.db.Queries.GetPullRequestByID(ctx, sqlc.GetPullRequestByIDParams{
+			OrgID:         org,
+			PullRequestID: prRow.PullRequestID,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -264,7 +345,31 @@ func (r *PullRequestRepository) FindPullRequestByUserID(
 func (r *PullRequestRepository) FindOpenPullRequests(
 	ctx context.Context,
 ) ([]*entities.PullRequest, error) {
-	prRows, err := r.db.Queries.GetOpenPRs(ctx)
+	prRows, err := r.db.Queries.GetOpenPRs(ctx, orgID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]*entities.PullRequest, len(prRows))
+	for i, prRow := range prRows {
+		pr, err := r.buildPullRequestWithReviewers(ctx, prRow)
+		if err != nil {
+			return nil, err
+		}
+		prs[i] = pr
+	}
+
+	return prs, nil
+}
+
+func (r *PullRequestRepository) FindOpenPullRequestsByTeamID(
+	ctx context.Context,
+	id entities.TeamID,
+) ([]*entities.PullRequest, error) {
+	prRows, err := r.db.Queries.GetOpenPRsByTeamID(ctx, sqlc.GetOpenPRsByTeamIDParams{
+		OrgID:  orgID(ctx),
+		TeamID: teamIdToPgInt4(id),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -280,3 +385,30 @@ func (r *PullRequestRepository) FindOpenPullRequests(
 
 	return prs, nil
 }
+
+func (r *PullRequestRepository) CountOpenReviewsByUser(
+	ctx context.Context,
+) (map[entities.UserID]int, error) {
+	rows, err := r.db.Queries.CountOpenReviewsByUser(ctx, orgID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entities.UserID]int, len(rows))
+	for _, row := range rows {
+		counts[entities.UserID(row.UserID)] = int(row.OpenReviews)
+	}
+
+	return counts, nil
+}
+
+func (r *PullRequestRepository) CountOpenReviews(
+	ctx context.Context,
+	id entities.UserID,
+) (int, error) {
+	count, err := r.db.Queries.CountOpenReviewsForUser(ctx, sqlc.CountOpenReviewsForUserParams{
+		OrgID:  orgID(ctx),
+		UserID: id.String(),
+	})
+	return int(count), err
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+	"github.com/Traunin/review-assigner/internal/ratelimit"
+	"github.com/jackc/pgx/v5"
+)
+
+// buckets whose next token is this far in the past are full and get deleted
+const rateLimitIdle = time.Hour
+
+// RateLimitStore keeps token buckets in Postgres so every replica enforces
+// the same limits. A bucket is a single timestamp (GCRA), taking a token is
+// one atomic upsert, and the clock is the database's so replica clock skew
+// doesn't matter.
+type RateLimitStore struct {
+	db *DB
+}
+
+func NewRateLimitStore(db *DB) *RateLimitStore {
+	return &RateLimitStore{
+		db: db,
+	}
+}
+
+func (s *RateLimitStore) Take(
+	ctx context.Context,
+	key string,
+	limit ratelimit.Limit,
+) (ratelimit.Decision, error) {
+	interval := 1 / limit.Rate
+	// how far ahead of now the bucket may run before it's empty
+	tolerance := interval * float64(limit.Burst-1)
+
+	_, err := s.db.Queries.TakeRateLimitToken(ctx, sqlc.TakeRateLimitTokenParams{
+		BucketKey:        key,
+		IntervalSeconds:  interval,
+		ToleranceSeconds: tolerance,
+	})
+	if err == nil {
+		return ratelimit.Decision{Allowed: true}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return ratelimit.Decision{}, err
+	}
+
+	wait, err := s.db.Queries.GetRateLimitWait(ctx, key)
+	if err != nil {
+		return ratelimit.Decision{}, err
+	}
+
+	retryAfter := time.Duration((wait - tolerance) * float64(time.Second))
+	return ratelimit.Decision{RetryAfter: max(retryAfter, 0)}, nil
+}
+
+// Cleanup deletes idle buckets every interval until ctx is done
+func (s *RateLimitStore) Cleanup(ctx context.Context, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.db.Queries.DeleteIdleRateLimitBuckets(ctx, rateLimitIdle.Seconds())
+			if err != nil {
+				slog.Default().Error("rate limit cleanup failed", "error", err)
+				continue
+			}
+			if deleted > 0 {
+				slog.Default().Debug("rate limit buckets cleaned up", "deleted", deleted)
+			}
+		}
+	}
+}
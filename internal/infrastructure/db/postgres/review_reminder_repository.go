@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ReviewReminderRepository struct {
+	db *DB
+}
+
+func NewReviewReminderRepository(db *DB) *ReviewReminderRepository {
+	return &ReviewReminderRepository{
+		db: db,
+	}
+}
+
+func (r *ReviewReminderRepository) FindOverdue(
+	ctx context.Context,
+	defaults entities.ReviewSLA,
+) ([]entities.OverdueReview, error) {
+	rows, err := r.db.Queries.GetOverdueReviews(ctx, sqlc.GetOverdueReviewsParams{
+		OrgID:                       orgID(ctx),
+		DefaultRemindAfterSeconds:   int32(defaults.RemindAfter.Seconds()),
+		DefaultReassignAfterSeconds: int32(defaults.ReassignAfter.Seconds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	overdue := make([]entities.OverdueReview, len(rows))
+	for i, row := range rows {
+		var remindedAt *time.Time
+		if row.RemindedAt.Valid {
+			remindedAt = &row.RemindedAt.Time
+		}
+
+		overdue[i] = entities.OverdueReview{
+			PullRequestID:   entities.PullRequestID(row.PullRequestID),
+			PullRequestName: row.PullRequestName,
+			AuthorID:        entities.UserID(row.AuthorID),
+			ReviewerID:      entities.UserID(row.UserID),
+			TeamName:        row.TeamName,
+			AssignedAt:      row.AssignedAt.Time,
+			RemindedAt:      remindedAt,
+			SLA: entities.ReviewSLA{
+				RemindAfter:   time.Duration(row.RemindAfterSeconds) * time.Second,
+				ReassignAfter: time.Duration(row.ReassignAfterSeconds) * time.Second,
+			},
+		}
+	}
+
+	return overdue, nil
+}
+
+func (r *ReviewReminderRepository) MarkReminded(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+	at time.Time,
+) error {
+	return r.db.Queries.MarkReviewerReminded(ctx, sqlc.MarkReviewerRemindedParams{
+		OrgID:         orgID(ctx),
+		PullRequestID: prID.String(),
+		UserID:        reviewerID.String(),
+		RemindedAt:    timeToPgTimestamptz(at),
+	})
+}
+
+func (r *ReviewReminderRepository) SetTeamSLA(
+	ctx context.Context,
+	teamID entities.TeamID,
+	sla *entities.ReviewSLA,
+) error {
+	params := sqlc.SetTeamReviewSLAParams{
+		OrgID: orgID(ctx),
+		ID:    int32(teamID),
+	}
+	if sla != nil {
+		params.RemindAfterSeconds = pgtype.Int4{
+			Int32: int32(sla.RemindAfter.Seconds()),
+			Valid: true,
+		}
+		params.ReassignAfterSeconds = pgtype.Int4{
+			Int32: int32(sla.ReassignAfter.Seconds()),
+			Valid: true,
+		}
+	}
+
+	return r.db.Queries.SetTeamReviewSLA(ctx, params)
+}
@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type StatsRepository struct {
+	db *DB
+}
+
+func NewStatsRepository(db *DB) *StatsRepository {
+	return &StatsRepository{
+		db: db,
+	}
+}
+
+func (r *StatsRepository) SLA(
+	ctx context.Context,
+	window entities.TimeWindow,
+) (*entities.SLAReport, error) {
+	// the three queries take the same parameters and return the same rows
+	params := sqlc.SLATimeToMergeParams{
+		OrgID:       orgID(ctx),
+		WindowStart: timePtrToPgTimestamptz(window.From),
+		WindowEnd:   timePtrToPgTimestamptz(window.To),
+	}
+
+	toMerge, err := r.db.Queries.SLATimeToMerge(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	toFirstReview, err := r.db.Queries.SLATimeToFirstReview(
+		ctx,
+		sqlc.SLATimeToFirstReviewParams(params),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	toVerdict, err := r.db.Queries.SLAAssignmentToVerdict(
+		ctx,
+		sqlc.SLAAssignmentToVerdictParams(params),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entities.SLAReport{
+		TimeToMerge: slaBreakdown(toMerge),
+	}
+
+	// the generated row types are identical, convert them to one
+	firstReviewRows := make([]sqlc.SLATimeToMergeRow, len(toFirstReview))
+	for i, row := range toFirstReview {
+		firstReviewRows[i] = sqlc.SLATimeToMergeRow(row)
+	}
+	report.TimeToFirstReview = slaBreakdown(firstReviewRows)
+
+	verdictRows := make([]sqlc.SLATimeToMergeRow, len(toVerdict))
+	for i, row := range toVerdict {
+		verdictRows[i] = sqlc.SLATimeToMergeRow(row)
+	}
+	report.AssignmentToVerdict = slaBreakdown(verdictRows)
+
+	return report, nil
+}
+
+func (r *StatsRepository) ReviewerStats(
+	ctx context.Context,
+	userID *entities.UserID,
+) ([]entities.ReviewerStats, error) {
+	params := sqlc.ReviewerAssignmentCountsParams{
+		OrgID: orgID(ctx),
+	}
+	if userID != nil {
+		params.UserID = pgtype.Text{String: string(*userID), Valid: true}
+	}
+
+	rows, err := r.db.Queries.ReviewerAssignmentCounts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]entities.ReviewerStats, len(rows))
+	for i, row := range rows {
+		stats[i] = entities.ReviewerStats{
+			UserID:   entities.UserID(row.UserID),
+			Username: row.Username,
+			TeamName: row.TeamName,
+			IsActive: row.IsActive,
+			Total:    int(row.Total),
+			Open:     int(row.Open),
+			Merged:   int(row.Merged),
+		}
+	}
+
+	return stats, nil
+}
+
+func (r *StatsRepository) PullRequestStats(
+	ctx context.Context,
+) (*entities.PullRequestStats, error) {
+	rows, err := r.db.Queries.PullRequestCountsByTeam(ctx, orgID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &entities.PullRequestStats{
+		ByTeam: make(map[string]entities.PullRequestCounts),
+	}
+	for _, row := range rows {
+		addPullRequestCounts(&stats.PullRequestCounts, row)
+		if row.TeamName == "" {
+			continue
+		}
+		team := stats.ByTeam[row.TeamName]
+		addPullRequestCounts(&team, row)
+		stats.ByTeam[row.TeamName] = team
+	}
+
+	return stats, nil
+}
+
+func (r *StatsRepository) Fairness(
+	ctx context.Context,
+	teamName string,
+	window entities.TimeWindow,
+) (*entities.FairnessReport, error) {
+	team, err := r.db.Queries.GetTeamByName(ctx, sqlc.GetTeamByNameParams{
+		OrgID:    orgID(ctx),
+		TeamName: teamName,
+	})
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Queries.TeamWorkload(ctx, sqlc.TeamWorkloadParams{
+		OrgID:       orgID(ctx),
+		TeamID:      pgtype.Int4{Int32: team.ID, Valid: true},
+		WindowStart: timePtrToPgTimestamptz(window.From),
+		WindowEnd:   timePtrToPgTimestamptz(window.To),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]entities.MemberWorkload, len(rows))
+	for i, row := range rows {
+		members[i] = entities.MemberWorkload{
+			UserID:      entities.UserID(row.UserID),
+			Username:    row.Username,
+			IsActive:    row.IsActive,
+			ActiveTime:  secondsToDuration(row.ActiveSeconds),
+			Assignments: int(row.Assignments),
+		}
+	}
+
+	return entities.NewFairnessReport(team.TeamName, members), nil
+}
+
+func addPullRequestCounts(
+	counts *entities.PullRequestCounts,
+	row sqlc.PullRequestCountsByTeamRow,
+) {
+	n := int(row.PullRequests)
+	counts.Total += n
+	counts.Reviewers += int(row.Reviewers)
+	switch entities.PRStatus(row.Status) {
+	case entities.StatusOpen:
+		counts.Open += n
+	case entities.StatusMerged:
+		counts.Merged += n
+	}
+}
+
+func slaBreakdown(rows []sqlc.SLATimeToMergeRow) entities.SLABreakdown {
+	breakdown := entities.SLABreakdown{
+		ByTeam:     make(map[string]entities.DurationPercentiles),
+		ByReviewer: make(map[entities.UserID]entities.DurationPercentiles),
+	}
+
+	for _, row := range rows {
+		percentiles := entities.DurationPercentiles{
+			Samples: int(row.Samples),
+			P50:     secondsToDuration(row.P50),
+			P90:     secondsToDuration(row.P90),
+			P99:     secondsToDuration(row.P99),
+		}
+
+		switch row.Scope {
+		case "overall":
+			breakdown.Overall = percentiles
+		case "team":
+			breakdown.ByTeam[row.Key] = percentiles
+		case "reviewer":
+			breakdown.ByReviewer[entities.UserID(row.Key)] = percentiles
+		}
+	}
+
+	return breakdown
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -39,7 +39,10 @@ func (r *TeamRepository) buildTeamWithMembers(
 	// a user can only be a member of one team
 	memberRows, err := r.db.Queries.GetUsersByTeamID(
 		ctx,
-		teamIdToPgInt4(team.ID()),
+		sqlc.GetUsersByTeamIDParams{
+			OrgID:  orgID(ctx),
+			TeamID: teamIdToPgInt4(team.ID()),
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -58,7 +61,10 @@ func (r *TeamRepository) Create(
 	ctx context.Context,
 	team *entities.Team,
 ) error {
-	_, err := r.db.Queries.CreateTeam(ctx, team.Name())
+	_, err := r.db.Queries.CreateTeam(ctx, sqlc.CreateTeamParams{
+		OrgID:    orgID(ctx),
+		TeamName: team.Name(),
+	})
 	return err
 }
 
@@ -66,14 +72,20 @@ func (r *TeamRepository) DeleteByID(
 	ctx context.Context,
 	id entities.TeamID,
 ) error {
-	return r.db.Queries.DeleteTeam(ctx, int32(id))
+	return r.db.Queries.DeleteTeam(ctx, sqlc.DeleteTeamParams{
+		OrgID: orgID(ctx),
+		ID:    int32(id),
+	})
 }
 
 func (r *TeamRepository) FindByID(
 	ctx context.Context,
 	id entities.TeamID,
 ) (*entities.Team, error) {
-	teamRow, err := r.db.Queries.GetTeamByID(ctx, int32(id))
+	teamRow, err := r.db.Queries.GetTeamByID(ctx, sqlc.GetTeamByIDParams{
+		OrgID: orgID(ctx),
+		ID:    int32(id),
+	})
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -87,7 +99,10 @@ func (r *TeamRepository) FindByName(
 	ctx context.Context,
 	name string,
 ) (*entities.Team, error) {
-	teamRow, err := r.db.Queries.GetTeamByName(ctx, name)
+	teamRow, err := r.db.Queries.GetTeamByName(ctx, sqlc.GetTeamByNameParams{
+		OrgID:    orgID(ctx),
+		TeamName: name,
+	})
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -101,7 +116,10 @@ func (r *TeamRepository) FindByUserID(
 	ctx context.Context,
 	id entities.UserID,
 ) (*entities.Team, error) {
-	teamRow, err := r.db.Queries.GetTeamByUserID(ctx, id.String())
+	teamRow, err := r.db.Queries.GetTeamByUserID(ctx, sqlc.GetTeamByUserIDParams{
+		OrgID:  orgID(ctx),
+		UserID: id.String(),
+	})
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -114,7 +132,7 @@ func (r *TeamRepository) FindByUserID(
 func (r *TeamRepository) FindAll(
 	ctx context.Context,
 ) ([]*entities.Team, error) {
-	teams, err := r.db.Queries.GetTeams(ctx)
+	teams, err := r.db.Queries.GetTeams(ctx, orgID(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +154,7 @@ func (r *TeamRepository) Update(
 	team *entities.Team,
 ) error {
 	return r.db.Queries.UpdateTeam(ctx, sqlc.UpdateTeamParams{
+		OrgID:    orgID(ctx),
 		ID:       int32(team.ID()),
 		TeamName: team.Name(),
 	})
@@ -147,7 +166,10 @@ func (r *TeamRepository) FindActiveReviewersByTeamID(
 ) ([]*entities.User, error) {
 	userRows, err := r.db.Queries.GetActiveUsersByTeamID(
 		ctx,
-		teamIdToPgInt4(id),
+		sqlc.GetActiveUsersByTeamIDParams{
+			OrgID:  orgID(ctx),
+			TeamID: teamIdToPgInt4(id),
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -169,6 +191,10 @@ func (r *TeamRepository) FindActiveReviewersByTeamID(
 		if err != nil {
 			return nil, err
 		}
+		if err := user.SetMaxOpenReviews(pgInt4ToIntPtr(row.MaxOpenReviews)); err != nil {
+			return nil, err
+		}
+		user.SetOutOfOfficeUntil(pgTimestamptzToTimePtr(row.OutOfOfficeUntil))
 		users = append(users, user)
 	}
 	return users, nil
@@ -178,5 +204,8 @@ func (r *TeamRepository) TeamExists(
 	ctx context.Context,
 	name string,
 ) (bool, error) {
-	return r.db.Queries.TeamExists(ctx, name)
+	return r.db.Queries.TeamExists(ctx, sqlc.TeamExistsParams{
+		OrgID:    orgID(ctx),
+		TeamName: name,
+	})
 }
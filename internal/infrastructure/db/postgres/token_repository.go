@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TokenRepository struct {
+	db *DB
+}
+
+func NewTokenRepository(db *DB) *TokenRepository {
+	return &TokenRepository{
+		db: db,
+	}
+}
+
+// the generated row types are identical, so all of them convert to this one
+func tokenToDomain(row sqlc.GetAPITokensRow) (*entities.APIToken, error) {
+	var userID *entities.UserID
+	if row.UserID.Valid {
+		uid := entities.UserID(row.UserID.String)
+		userID = &uid
+	}
+
+	var org *entities.OrgID
+	if row.OrgID.Valid {
+		id := entities.OrgID(row.OrgID.Int32)
+		org = &id
+	}
+
+	var revokedAt *time.Time
+	if row.RevokedAt.Valid {
+		revokedAt = &row.RevokedAt.Time
+	}
+
+	return entities.NewAPIToken(
+		entities.TokenID(row.ID),
+		row.Name,
+		entities.Role(row.Role),
+		userID,
+		org,
+		pgTimestamptzToTime(row.CreatedAt),
+		revokedAt,
+	)
+}
+
+func (r *TokenRepository) Create(
+	ctx context.Context,
+	token *entities.APIToken,
+	hash string,
+) (*entities.APIToken, error) {
+	var pgUserID pgtype.Text
+	if token.UserID() != nil {
+		pgUserID = pgtype.Text{String: token.UserID().String(), Valid: true}
+	}
+
+	var pgOrgID pgtype.Int4
+	if token.OrgID() != nil {
+		pgOrgID = pgtype.Int4{Int32: int32(*token.OrgID()), Valid: true}
+	}
+
+	row, err := r.db.Queries.CreateAPIToken(ctx, sqlc.CreateAPITokenParams{
+		Name:      token.Name(),
+		TokenHash: hash,
+		Role:      token.Role().String(),
+		UserID:    pgUserID,
+		OrgID:     pgOrgID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenToDomain(sqlc.GetAPITokensRow(row))
+}
+
+func (r *TokenRepository) FindActiveByHash(
+	ctx context.Context,
+	hash string,
+) (*entities.APIToken, error) {
+	row, err := r.db.Queries.GetActiveAPITokenByHash(ctx, hash)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return tokenToDomain(sqlc.GetAPITokensRow(row))
+}
+
+func (r *TokenRepository) FindAll(
+	ctx context.Context,
+) ([]*entities.APIToken, error) {
+	rows, err := r.db.Queries.GetAPITokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*entities.APIToken, len(rows))
+	for i, row := range rows {
+		token, err := tokenToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		tokens[i] = token
+	}
+
+	return tokens, nil
+}
+
+func (r *TokenRepository) Revoke(
+	ctx context.Context,
+	id entities.TokenID,
+) (bool, error) {
+	affected, err := r.db.Queries.RevokeAPIToken(ctx, int32(id))
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
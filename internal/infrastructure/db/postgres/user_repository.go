@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/Traunin/review-assigner/internal/domain/entities"
 	"github.com/Traunin/review-assigner/internal/infrastructure/db/sqlc"
@@ -28,28 +29,55 @@ func (r *UserRepository) Create(
 		pgTeamID = pgtype.Int4{Int32: int32(*user.TeamID()), Valid: true}
 	}
 
-	_, err := r.db.Queries.CreateUser(ctx, sqlc.CreateUserParams{
-		UserID:   user.ID().String(),
-		Username: user.Username(),
-		IsActive: user.IsActive(),
-		TeamID:   pgTeamID,
+	return r.db.execTx(ctx, func(q *sqlc.Queries) error {
+		_, err := q.CreateUser(ctx, sqlc.CreateUserParams{
+			OrgID:    orgID(ctx),
+			UserID:   user.ID().String(),
+			Username: user.Username(),
+			IsActive: user.IsActive(),
+			TeamID:   pgTeamID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return recordActivity(ctx, q, user)
 	})
+}
 
-	return err
+// recordActivity keeps user_activity_periods in step with is_active, both
+// queries are no-ops when the state did not change
+func recordActivity(ctx context.Context, q *sqlc.Queries, user *entities.User) error {
+	if user.IsActive() {
+		return q.OpenActivityPeriod(ctx, sqlc.OpenActivityPeriodParams{
+			OrgID:  orgID(ctx),
+			UserID: user.ID().String(),
+		})
+	}
+	return q.CloseActivityPeriod(ctx, sqlc.CloseActivityPeriodParams{
+		OrgID:  orgID(ctx),
+		UserID: user.ID().String(),
+	})
 }
 
 func (r *UserRepository) DeleteByID(
 	ctx context.Context,
 	id entities.UserID,
 ) error {
-	return r.db.Queries.DeleteUser(ctx, id.String())
+	return r.db.Queries.DeleteUser(ctx, sqlc.DeleteUserParams{
+		OrgID:  orgID(ctx),
+		UserID: id.String(),
+	})
 }
 
 func (r *UserRepository) FindByID(
 	ctx context.Context,
 	id entities.UserID,
 ) (*entities.User, error) {
-	user, err := r.db.Queries.GetUserByID(ctx, id.String())
+	user, err := r.db.Queries.GetUserByID(ctx, sqlc.GetUserByIDParams{
+		OrgID:  orgID(ctx),
+		UserID: id.String(),
+	})
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -62,18 +90,26 @@ func (r *UserRepository) FindByID(
 		teamID = &tid
 	}
 
-	return entities.NewUser(
+	userEntity, err := entities.NewUser(
 		entities.UserID(user.UserID),
 		user.Username,
 		user.IsActive,
 		teamID,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := userEntity.SetMaxOpenReviews(pgInt4ToIntPtr(user.MaxOpenReviews)); err != nil {
+		return nil, err
+	}
+	userEntity.SetOutOfOfficeUntil(pgTimestamptzToTimePtr(user.OutOfOfficeUntil))
+	return userEntity, nil
 }
 
 func (r *UserRepository) FindAll(
 	ctx context.Context,
 ) ([]*entities.User, error) {
-	users, err := r.db.Queries.GetUsers(ctx)
+	users, err := r.db.Queries.GetUsers(ctx, orgID(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +131,10 @@ func (r *UserRepository) FindAll(
 		if err != nil {
 			return nil, err
 		}
+		if err := userEntity.SetMaxOpenReviews(pgInt4ToIntPtr(user.MaxOpenReviews)); err != nil {
+			return nil, err
+		}
+		userEntity.SetOutOfOfficeUntil(pgTimestamptzToTimePtr(user.OutOfOfficeUntil))
 		userEntities[i] = userEntity
 	}
 
@@ -110,18 +150,26 @@ func (r *UserRepository) Update(
 		pgTeamID = pgtype.Int4{Int32: int32(*user.TeamID()), Valid: true}
 	}
 
-	return r.db.Queries.UpdateUser(ctx, sqlc.UpdateUserParams{
-		UserID:   user.ID().String(),
-		Username: user.Username(),
-		IsActive: user.IsActive(),
-		TeamID:   pgTeamID,
+	return r.db.execTx(ctx, func(q *sqlc.Queries) error {
+		err := q.UpdateUser(ctx, sqlc.UpdateUserParams{
+			OrgID:    orgID(ctx),
+			UserID:   user.ID().String(),
+			Username: user.Username(),
+			IsActive: user.IsActive(),
+			TeamID:   pgTeamID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return recordActivity(ctx, q, user)
 	})
 }
 
 func (r *UserRepository) GetActiveUsers(
 	ctx context.Context,
 ) ([]*entities.User, error) {
-	users, err := r.db.Queries.GetActiveUsers(ctx)
+	users, err := r.db.Queries.GetActiveUsers(ctx, orgID(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +191,10 @@ func (r *UserRepository) GetActiveUsers(
 		if err != nil {
 			return nil, err
 		}
+		if err := userEntity.SetMaxOpenReviews(pgInt4ToIntPtr(user.MaxOpenReviews)); err != nil {
+			return nil, err
+		}
+		userEntity.SetOutOfOfficeUntil(pgTimestamptzToTimePtr(user.OutOfOfficeUntil))
 		userEntities[i] = userEntity
 	}
 
@@ -153,7 +205,10 @@ func (r *UserRepository) GetByTeamID(
 	ctx context.Context,
 	id entities.TeamID,
 ) ([]*entities.User, error) {
-	users, err := r.db.Queries.GetUsersByTeamID(ctx, teamIdToPgInt4(id))
+	users, err := r.db.Queries.GetUsersByTeamID(ctx, sqlc.GetUsersByTeamIDParams{
+		OrgID:  orgID(ctx),
+		TeamID: teamIdToPgInt4(id),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -175,8 +230,59 @@ func (r *UserRepository) GetByTeamID(
 		if err != nil {
 			return nil, err
 		}
+		if err := userEntity.SetMaxOpenReviews(pgInt4ToIntPtr(user.MaxOpenReviews)); err != nil {
+			return nil, err
+		}
+		userEntity.SetOutOfOfficeUntil(pgTimestamptzToTimePtr(user.OutOfOfficeUntil))
 		userEntities[i] = userEntity
 	}
 
 	return userEntities, nil
 }
+
+func (r *UserRepository) SetMaxOpenReviews(
+	ctx context.Context,
+	id entities.UserID,
+	max *int,
+) (bool, error) {
+	var pgMax pgtype.Int4
+	if max != nil {
+		pgMax = pgtype.Int4{Int32: int32(*max), Valid: true}
+	}
+
+	rows, err := r.db.Queries.SetUserMaxOpenReviews(ctx, sqlc.SetUserMaxOpenReviewsParams{
+		OrgID:          orgID(ctx),
+		UserID:         id.String(),
+		MaxOpenReviews: pgMax,
+	})
+	return rows > 0, err
+}
+
+func pgInt4ToIntPtr(v pgtype.Int4) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int32)
+	return &i
+}
+
+func pgTimestamptzToTimePtr(v pgtype.Timestamptz) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	t := v.Time
+	return &t
+}
+
+func (r *UserRepository) SetOutOfOffice(
+	ctx context.Context,
+	id entities.UserID,
+	until *time.Time,
+) (bool, error) {
+	rows, err := r.db.Queries.SetUserOutOfOffice(ctx, sqlc.SetUserOutOfOfficeParams{
+		OrgID:            orgID(ctx),
+		UserID:           id.String(),
+		OutOfOfficeUntil: timePtrToPgTimestamptz(until),
+	})
+	return rows > 0, err
+}
@@ -11,6 +11,48 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type NotificationChannel string
+
+const (
+	NotificationChannelChat  NotificationChannel = "chat"
+	NotificationChannelEmail NotificationChannel = "email"
+)
+
+func (e *NotificationChannel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationChannel(s)
+	case string:
+		*e = NotificationChannel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationChannel: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationChannel struct {
+	NotificationChannel NotificationChannel `json:"notification_channel"`
+	Valid               bool                `json:"valid"` // Valid is true if NotificationChannel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationChannel) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationChannel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationChannel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationChannel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationChannel), nil
+}
+
 type PrStatus string
 
 const (
@@ -53,6 +95,122 @@ func (ns NullPrStatus) Value() (driver.Value, error) {
 	return string(ns.PrStatus), nil
 }
 
+type ReviewVerdict string
+
+const (
+	ReviewVerdictAPPROVED         ReviewVerdict = "APPROVED"
+	ReviewVerdictCHANGESREQUESTED ReviewVerdict = "CHANGES_REQUESTED"
+)
+
+func (e *ReviewVerdict) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReviewVerdict(s)
+	case string:
+		*e = ReviewVerdict(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReviewVerdict: %T", src)
+	}
+	return nil
+}
+
+type NullReviewVerdict struct {
+	ReviewVerdict ReviewVerdict `json:"review_verdict"`
+	Valid         bool          `json:"valid"` // Valid is true if ReviewVerdict is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReviewVerdict) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReviewVerdict, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReviewVerdict.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReviewVerdict) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReviewVerdict), nil
+}
+
+type TokenRole string
+
+const (
+	TokenRoleAdmin  TokenRole = "admin"
+	TokenRoleBot    TokenRole = "bot"
+	TokenRoleMember TokenRole = "member"
+)
+
+func (e *TokenRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TokenRole(s)
+	case string:
+		*e = TokenRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TokenRole: %T", src)
+	}
+	return nil
+}
+
+type NullTokenRole struct {
+	TokenRole TokenRole `json:"token_role"`
+	Valid     bool      `json:"valid"` // Valid is true if TokenRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTokenRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.TokenRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TokenRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTokenRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TokenRole), nil
+}
+
+type ApiToken struct {
+	ID        int32              `json:"id"`
+	Name      string             `json:"name"`
+	TokenHash string             `json:"token_hash"`
+	Role      string             `json:"role"`
+	UserID    pgtype.Text        `json:"user_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+	OrgID     pgtype.Int4        `json:"org_id"`
+}
+
+type DigestRun struct {
+	OrgID     int32              `json:"org_id"`
+	RunDate   pgtype.Date        `json:"run_date"`
+	StartedAt pgtype.Timestamptz `json:"started_at"`
+}
+
+type NotificationPreference struct {
+	OrgID   int32       `json:"org_id"`
+	UserID  string      `json:"user_id"`
+	Channel string      `json:"channel"`
+	Address pgtype.Text `json:"address"`
+	Enabled bool        `json:"enabled"`
+}
+
+type Organization struct {
+	ID        int32              `json:"id"`
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type PullRequest struct {
 	PullRequestID   string             `json:"pull_request_id"`
 	PullRequestName string             `json:"pull_request_name"`
@@ -60,22 +218,59 @@ type PullRequest struct {
 	Status          string             `json:"status"`
 	CreatedAt       pgtype.Timestamptz `json:"created_at"`
 	MergedAt        pgtype.Timestamptz `json:"merged_at"`
+	OrgID           int32              `json:"org_id"`
+}
+
+type RateLimitBucket struct {
+	BucketKey string             `json:"bucket_key"`
+	Tat       pgtype.Timestamptz `json:"tat"`
 }
 
 type Reviewer struct {
 	PullRequestID string             `json:"pull_request_id"`
 	UserID        string             `json:"user_id"`
 	AssignedAt    pgtype.Timestamptz `json:"assigned_at"`
+	OrgID         int32              `json:"org_id"`
+	Verdict       pgtype.Text        `json:"verdict"`
+	VerdictAt     pgtype.Timestamptz `json:"verdict_at"`
+	RemindedAt    pgtype.Timestamptz `json:"reminded_at"`
+	SelectionSeed pgtype.Int8        `json:"selection_seed"`
+	AssignedBy    pgtype.Text        `json:"assigned_by"`
+}
+
+type ReviewerRemoval struct {
+	ID            int32              `json:"id"`
+	OrgID         int32              `json:"org_id"`
+	PullRequestID string             `json:"pull_request_id"`
+	UserID        string             `json:"user_id"`
+	AssignedAt    pgtype.Timestamptz `json:"assigned_at"`
+	AssignedBy    pgtype.Text        `json:"assigned_by"`
+	ReplacedBy    pgtype.Text        `json:"replaced_by"`
+	RemovedBy     pgtype.Text        `json:"removed_by"`
+	RemovedAt     pgtype.Timestamptz `json:"removed_at"`
 }
 
 type Team struct {
-	ID       int32  `json:"id"`
-	TeamName string `json:"team_name"`
+	ID                   int32       `json:"id"`
+	TeamName             string      `json:"team_name"`
+	OrgID                int32       `json:"org_id"`
+	RemindAfterSeconds   pgtype.Int4 `json:"remind_after_seconds"`
+	ReassignAfterSeconds pgtype.Int4 `json:"reassign_after_seconds"`
 }
 
 type User struct {
-	UserID   string      `json:"user_id"`
-	Username string      `json:"username"`
-	IsActive bool        `json:"is_active"`
-	TeamID   pgtype.Int4 `json:"team_id"`
+	UserID           string             `json:"user_id"`
+	Username         string             `json:"username"`
+	IsActive         bool               `json:"is_active"`
+	TeamID           pgtype.Int4        `json:"team_id"`
+	OrgID            int32              `json:"org_id"`
+	MaxOpenReviews   pgtype.Int4        `json:"max_open_reviews"`
+	OutOfOfficeUntil pgtype.Timestamptz `json:"out_of_office_until"`
+}
+
+type UserActivityPeriod struct {
+	OrgID      int32              `json:"org_id"`
+	UserID     string             `json:"user_id"`
+	ActiveFrom pgtype.Timestamptz `json:"active_from"`
+	ActiveTo   pgtype.Timestamptz `json:"active_to"`
 }
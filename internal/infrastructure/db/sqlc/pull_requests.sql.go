@@ -13,17 +13,19 @@ import (
 
 const createPullRequest = `-- name: CreatePullRequest :one
 INSERT INTO pull_requests (
+    org_id,
     pull_request_id, 
     pull_request_name, 
     author_id, 
     status,
     created_at
 )
-VALUES ($1, $2, $3, $4, $5)
-RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at, org_id
 `
 
 type CreatePullRequestParams struct {
+	OrgID           int32              `json:"org_id"`
 	PullRequestID   string             `json:"pull_request_id"`
 	PullRequestName string             `json:"pull_request_name"`
 	AuthorID        string             `json:"author_id"`
@@ -33,6 +35,7 @@ type CreatePullRequestParams struct {
 
 func (q *Queries) CreatePullRequest(ctx context.Context, arg CreatePullRequestParams) (PullRequest, error) {
 	row := q.db.QueryRow(ctx, createPullRequest,
+		arg.OrgID,
 		arg.PullRequestID,
 		arg.PullRequestName,
 		arg.AuthorID,
@@ -47,17 +50,23 @@ func (q *Queries) CreatePullRequest(ctx context.Context, arg CreatePullRequestPa
 		&i.Status,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.OrgID,
 	)
 	return i, err
 }
 
 const deletePullRequest = `-- name: DeletePullRequest :exec
 DELETE FROM pull_requests
-WHERE pull_request_id = $1
+WHERE org_id = $1 AND pull_request_id = $2
 `
 
-func (q *Queries) DeletePullRequest(ctx context.Context, pullRequestID string) error {
-	_, err := q.db.Exec(ctx, deletePullRequest, pullRequestID)
+type DeletePullRequestParams struct {
+	OrgID         int32  `json:"org_id"`
+	PullRequestID string `json:"pull_request_id"`
+}
+
+func (q *Queries) DeletePullRequest(ctx context.Context, arg DeletePullRequestParams) error {
+	_, err := q.db.Exec(ctx, deletePullRequest, arg.OrgID, arg.PullRequestID)
 	return err
 }
 
@@ -68,14 +77,64 @@ SELECT
     author_id, 
     status,
     created_at,
-    merged_at
+    merged_at,
+    org_id
 FROM pull_requests
-WHERE status = 'OPEN'
+WHERE org_id = $1 AND status = 'OPEN'
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetOpenPRs(ctx context.Context, orgID int32) ([]PullRequest, error) {
+	rows, err := q.db.Query(ctx, getOpenPRs, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PullRequest{}
+	for rows.Next() {
+		var i PullRequest
+		if err := rows.Scan(
+			&i.PullRequestID,
+			&i.PullRequestName,
+			&i.AuthorID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.MergedAt,
+			&i.OrgID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpenPRsByTeamID = `-- name: GetOpenPRsByTeamID :many
+SELECT 
+    pull_request_id, 
+    pull_request_name, 
+    author_id, 
+    status,
+    created_at,
+    merged_at,
+    org_id
+FROM pull_requests pr
+WHERE pr.org_id = $1 AND pr.status = 'OPEN' AND pr.author_id IN (
+    SELECT u.user_id FROM users u WHERE u.org_id = $1 AND u.team_id = $2
+)
 ORDER BY created_at DESC
 `
 
-func (q *Queries) GetOpenPRs(ctx context.Context) ([]PullRequest, error) {
-	rows, err := q.db.Query(ctx, getOpenPRs)
+type GetOpenPRsByTeamIDParams struct {
+	OrgID  int32       `json:"org_id"`
+	TeamID pgtype.Int4 `json:"team_id"`
+}
+
+func (q *Queries) GetOpenPRsByTeamID(ctx context.Context, arg GetOpenPRsByTeamIDParams) ([]PullRequest, error) {
+	rows, err := q.db.Query(ctx, getOpenPRsByTeamID, arg.OrgID, arg.TeamID)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +149,7 @@ func (q *Queries) GetOpenPRs(ctx context.Context) ([]PullRequest, error) {
 			&i.Status,
 			&i.CreatedAt,
 			&i.MergedAt,
+			&i.OrgID,
 		); err != nil {
 			return nil, err
 		}
@@ -108,14 +168,20 @@ SELECT
     author_id, 
     status,
     created_at,
-    merged_at
+    merged_at,
+    org_id
 FROM pull_requests
-WHERE author_id = $1
+WHERE org_id = $1 AND author_id = $2
 ORDER BY created_at DESC
 `
 
-func (q *Queries) GetPRsByAuthor(ctx context.Context, authorID string) ([]PullRequest, error) {
-	rows, err := q.db.Query(ctx, getPRsByAuthor, authorID)
+type GetPRsByAuthorParams struct {
+	OrgID    int32  `json:"org_id"`
+	AuthorID string `json:"author_id"`
+}
+
+func (q *Queries) GetPRsByAuthor(ctx context.Context, arg GetPRsByAuthorParams) ([]PullRequest, error) {
+	rows, err := q.db.Query(ctx, getPRsByAuthor, arg.OrgID, arg.AuthorID)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +196,7 @@ func (q *Queries) GetPRsByAuthor(ctx context.Context, authorID string) ([]PullRe
 			&i.Status,
 			&i.CreatedAt,
 			&i.MergedAt,
+			&i.OrgID,
 		); err != nil {
 			return nil, err
 		}
@@ -148,13 +215,19 @@ SELECT
     author_id, 
     status,
     created_at,
-    merged_at
+    merged_at,
+    org_id
 FROM pull_requests
-WHERE pull_request_id = $1
+WHERE org_id = $1 AND pull_request_id = $2
 `
 
-func (q *Queries) GetPullRequestByID(ctx context.Context, pullRequestID string) (PullRequest, error) {
-	row := q.db.QueryRow(ctx, getPullRequestByID, pullRequestID)
+type GetPullRequestByIDParams struct {
+	OrgID         int32  `json:"org_id"`
+	PullRequestID string `json:"pull_request_id"`
+}
+
+func (q *Queries) GetPullRequestByID(ctx context.Context, arg GetPullRequestByIDParams) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, getPullRequestByID, arg.OrgID, arg.PullRequestID)
 	var i PullRequest
 	err := row.Scan(
 		&i.PullRequestID,
@@ -163,6 +236,7 @@ func (q *Queries) GetPullRequestByID(ctx context.Context, pullRequestID string)
 		&i.Status,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.OrgID,
 	)
 	return i, err
 }
@@ -174,13 +248,15 @@ SELECT
     author_id, 
     status,
     created_at,
-    merged_at
+    merged_at,
+    org_id
 FROM pull_requests
+WHERE org_id = $1
 ORDER BY created_at DESC
 `
 
-func (q *Queries) GetPullRequests(ctx context.Context) ([]PullRequest, error) {
-	rows, err := q.db.Query(ctx, getPullRequests)
+func (q *Queries) GetPullRequests(ctx context.Context, orgID int32) ([]PullRequest, error) {
+	rows, err := q.db.Query(ctx, getPullRequests, orgID)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +271,7 @@ func (q *Queries) GetPullRequests(ctx context.Context) ([]PullRequest, error) {
 			&i.Status,
 			&i.CreatedAt,
 			&i.MergedAt,
+			&i.OrgID,
 		); err != nil {
 			return nil, err
 		}
@@ -208,12 +285,17 @@ func (q *Queries) GetPullRequests(ctx context.Context) ([]PullRequest, error) {
 
 const pRExists = `-- name: PRExists :one
 SELECT EXISTS(
-    SELECT 1 FROM pull_requests WHERE pull_request_id = $1
+    SELECT 1 FROM pull_requests WHERE org_id = $1 AND pull_request_id = $2
 )
 `
 
-func (q *Queries) PRExists(ctx context.Context, pullRequestID string) (bool, error) {
-	row := q.db.QueryRow(ctx, pRExists, pullRequestID)
+type PRExistsParams struct {
+	OrgID         int32  `json:"org_id"`
+	PullRequestID string `json:"pull_request_id"`
+}
+
+func (q *Queries) PRExists(ctx context.Context, arg PRExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, pRExists, arg.OrgID, arg.PullRequestID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
@@ -222,20 +304,26 @@ func (q *Queries) PRExists(ctx context.Context, pullRequestID string) (bool, err
 const updatePRStatus = `-- name: UpdatePRStatus :one
 UPDATE pull_requests
 SET 
-    status = $2,
-    merged_at = $3
-WHERE pull_request_id = $1
-RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+    status = $3,
+    merged_at = $4
+WHERE org_id = $1 AND pull_request_id = $2
+RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at, org_id
 `
 
 type UpdatePRStatusParams struct {
+	OrgID         int32              `json:"org_id"`
 	PullRequestID string             `json:"pull_request_id"`
 	Status        string             `json:"status"`
 	MergedAt      pgtype.Timestamptz `json:"merged_at"`
 }
 
 func (q *Queries) UpdatePRStatus(ctx context.Context, arg UpdatePRStatusParams) (PullRequest, error) {
-	row := q.db.QueryRow(ctx, updatePRStatus, arg.PullRequestID, arg.Status, arg.MergedAt)
+	row := q.db.QueryRow(ctx, updatePRStatus,
+		arg.OrgID,
+		arg.PullRequestID,
+		arg.Status,
+		arg.MergedAt,
+	)
 	var i PullRequest
 	err := row.Scan(
 		&i.PullRequestID,
@@ -244,6 +332,7 @@ func (q *Queries) UpdatePRStatus(ctx context.Context, arg UpdatePRStatusParams)
 		&i.Status,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.OrgID,
 	)
 	return i, err
 }
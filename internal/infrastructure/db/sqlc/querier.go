@@ -11,40 +11,86 @@ import (
 )
 
 type Querier interface {
+	AddNotificationPreference(ctx context.Context, arg AddNotificationPreferenceParams) error
 	AddReviewer(ctx context.Context, arg AddReviewerParams) error
+	// returns false when the day's digest was already claimed
+	ClaimDigestRun(ctx context.Context, arg ClaimDigestRunParams) (bool, error)
+	CloseActivityPeriod(ctx context.Context, arg CloseActivityPeriodParams) error
+	CountOpenReviewsByUser(ctx context.Context, orgID int32) ([]CountOpenReviewsByUserRow, error)
+	CountOpenReviewsForUser(ctx context.Context, arg CountOpenReviewsForUserParams) (int64, error)
+	CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) (CreateAPITokenRow, error)
+	CreateOrganization(ctx context.Context, name string) (Organization, error)
 	CreatePullRequest(ctx context.Context, arg CreatePullRequestParams) (PullRequest, error)
-	CreateTeam(ctx context.Context, teamName string) (Team, error)
-	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeletePullRequest(ctx context.Context, pullRequestID string) error
-	DeleteTeam(ctx context.Context, id int32) error
-	DeleteUser(ctx context.Context, userID string) error
-	GetActiveUsers(ctx context.Context) ([]User, error)
-	GetActiveUsersByTeamID(ctx context.Context, teamID pgtype.Int4) ([]User, error)
-	GetOpenPRs(ctx context.Context) ([]PullRequest, error)
-	GetPRsByAuthor(ctx context.Context, authorID string) ([]PullRequest, error)
-	GetPRsByReviewer(ctx context.Context, userID string) ([]GetPRsByReviewerRow, error)
-	GetPullRequestByID(ctx context.Context, pullRequestID string) (PullRequest, error)
-	GetPullRequests(ctx context.Context) ([]PullRequest, error)
-	GetReviewerCount(ctx context.Context, pullRequestID string) (int64, error)
-	GetReviewersByPR(ctx context.Context, pullRequestID string) ([]GetReviewersByPRRow, error)
-	GetTeamByID(ctx context.Context, id int32) (Team, error)
-	GetTeamByName(ctx context.Context, teamName string) (Team, error)
-	GetTeamByUserID(ctx context.Context, userID string) (Team, error)
-	GetTeamMemberCount(ctx context.Context, teamID pgtype.Int4) (int64, error)
-	GetTeams(ctx context.Context) ([]Team, error)
-	GetUserByID(ctx context.Context, userID string) (User, error)
-	GetUsers(ctx context.Context) ([]User, error)
-	GetUsersByTeamID(ctx context.Context, teamID pgtype.Int4) ([]User, error)
+	CreateTeam(ctx context.Context, arg CreateTeamParams) (CreateTeamRow, error)
+	CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error)
+	DeleteIdleRateLimitBuckets(ctx context.Context, idleSeconds float64) (int64, error)
+	DeleteNotificationPreferences(ctx context.Context, arg DeleteNotificationPreferencesParams) error
+	DeletePullRequest(ctx context.Context, arg DeletePullRequestParams) error
+	DeleteTeam(ctx context.Context, arg DeleteTeamParams) error
+	DeleteUser(ctx context.Context, arg DeleteUserParams) error
+	GetAPITokens(ctx context.Context) ([]GetAPITokensRow, error)
+	GetActiveAPITokenByHash(ctx context.Context, tokenHash string) (GetActiveAPITokenByHashRow, error)
+	GetActiveUsers(ctx context.Context, orgID int32) ([]User, error)
+	GetActiveUsersByTeamID(ctx context.Context, arg GetActiveUsersByTeamIDParams) ([]User, error)
+	GetNotificationPreferences(ctx context.Context, arg GetNotificationPreferencesParams) ([]GetNotificationPreferencesRow, error)
+	GetOpenPRs(ctx context.Context, orgID int32) ([]PullRequest, error)
+	GetOpenPRsByTeamID(ctx context.Context, arg GetOpenPRsByTeamIDParams) ([]PullRequest, error)
+	GetOrganizationByID(ctx context.Context, id int32) (Organization, error)
+	GetOrganizations(ctx context.Context) ([]Organization, error)
+	// reviewers of open pull requests who gave no verdict within their team's
+	// reminder threshold, the team is the author's
+	GetOverdueReviews(ctx context.Context, arg GetOverdueReviewsParams) ([]GetOverdueReviewsRow, error)
+	GetPRsByAuthor(ctx context.Context, arg GetPRsByAuthorParams) ([]PullRequest, error)
+	GetPRsByReviewer(ctx context.Context, arg GetPRsByReviewerParams) ([]GetPRsByReviewerRow, error)
+	GetPullRequestByID(ctx context.Context, arg GetPullRequestByIDParams) (PullRequest, error)
+	GetPullRequests(ctx context.Context, orgID int32) ([]PullRequest, error)
+	GetRateLimitWait(ctx context.Context, bucketKey string) (float64, error)
+	GetReviewerCount(ctx context.Context, arg GetReviewerCountParams) (int64, error)
+	GetReviewersByPR(ctx context.Context, arg GetReviewersByPRParams) ([]GetReviewersByPRRow, error)
+	GetTeamByID(ctx context.Context, arg GetTeamByIDParams) (GetTeamByIDRow, error)
+	GetTeamByName(ctx context.Context, arg GetTeamByNameParams) (GetTeamByNameRow, error)
+	GetTeamByUserID(ctx context.Context, arg GetTeamByUserIDParams) (GetTeamByUserIDRow, error)
+	GetTeamMemberCount(ctx context.Context, arg GetTeamMemberCountParams) (int64, error)
+	GetTeams(ctx context.Context, orgID int32) ([]GetTeamsRow, error)
+	GetUserByID(ctx context.Context, arg GetUserByIDParams) (User, error)
+	GetUsers(ctx context.Context, orgID int32) ([]User, error)
+	GetUsersByTeamID(ctx context.Context, arg GetUsersByTeamIDParams) ([]User, error)
 	IsUserReviewer(ctx context.Context, arg IsUserReviewerParams) (bool, error)
-	PRExists(ctx context.Context, pullRequestID string) (bool, error)
+	MarkReviewerReminded(ctx context.Context, arg MarkReviewerRemindedParams) error
+	OpenActivityPeriod(ctx context.Context, arg OpenActivityPeriodParams) error
+	PRExists(ctx context.Context, arg PRExistsParams) (bool, error)
+	PublishEvent(ctx context.Context, arg PublishEventParams) error
+	// one row per author team and status, team_name is empty for authors
+	// without a team
+	PullRequestCountsByTeam(ctx context.Context, orgID int32) ([]PullRequestCountsByTeamRow, error)
+	// moves the assignment to reviewer_removals with who removed it
 	RemoveReviewer(ctx context.Context, arg RemoveReviewerParams) error
 	ReplaceReviewer(ctx context.Context, arg ReplaceReviewerParams) error
-	TeamExists(ctx context.Context, teamName string) (bool, error)
+	// every user of the org, including those never assigned
+	ReviewerAssignmentCounts(ctx context.Context, arg ReviewerAssignmentCountsParams) ([]ReviewerAssignmentCountsRow, error)
+	RevokeAPIToken(ctx context.Context, id int32) (int64, error)
+	SLAAssignmentToVerdict(ctx context.Context, arg SLAAssignmentToVerdictParams) ([]SLAAssignmentToVerdictRow, error)
+	// the reviewer breakdown credits whoever responded first
+	SLATimeToFirstReview(ctx context.Context, arg SLATimeToFirstReviewParams) ([]SLATimeToFirstReviewRow, error)
+	// SLA queries return one row per scope: 'overall', then one per 'team'
+	// (the author's team) and one per 'reviewer'. Durations are in seconds,
+	// the window filters pull requests by creation time.
+	SLATimeToMerge(ctx context.Context, arg SLATimeToMergeParams) ([]SLATimeToMergeRow, error)
+	SetReviewerVerdict(ctx context.Context, arg SetReviewerVerdictParams) error
+	SetTeamReviewSLA(ctx context.Context, arg SetTeamReviewSLAParams) error
+	SetUserMaxOpenReviews(ctx context.Context, arg SetUserMaxOpenReviewsParams) (int64, error)
+	SetUserOutOfOffice(ctx context.Context, arg SetUserOutOfOfficeParams) (int64, error)
+	// returns no rows when the bucket is empty
+	TakeRateLimitToken(ctx context.Context, arg TakeRateLimitTokenParams) (pgtype.Timestamptz, error)
+	TeamExists(ctx context.Context, arg TeamExistsParams) (bool, error)
+	// current team members with the time they were active and the reviews they
+	// were assigned inside the window, an open window end means now
+	TeamWorkload(ctx context.Context, arg TeamWorkloadParams) ([]TeamWorkloadRow, error)
 	UpdatePRStatus(ctx context.Context, arg UpdatePRStatusParams) (PullRequest, error)
 	UpdateTeam(ctx context.Context, arg UpdateTeamParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
 	UpdateUserStatus(ctx context.Context, arg UpdateUserStatusParams) (UpdateUserStatusRow, error)
-	UserExists(ctx context.Context, userID string) (bool, error)
+	UserExists(ctx context.Context, arg UserExistsParams) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
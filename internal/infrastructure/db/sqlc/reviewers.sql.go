@@ -12,21 +12,85 @@ import (
 )
 
 const addReviewer = `-- name: AddReviewer :exec
-INSERT INTO reviewers (pull_request_id, user_id, assigned_at)
-VALUES ($1, $2, $3)
+INSERT INTO reviewers (org_id, pull_request_id, user_id, assigned_at, selection_seed, assigned_by)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type AddReviewerParams struct {
+	OrgID         int32              `json:"org_id"`
 	PullRequestID string             `json:"pull_request_id"`
 	UserID        string             `json:"user_id"`
 	AssignedAt    pgtype.Timestamptz `json:"assigned_at"`
+	SelectionSeed pgtype.Int8        `json:"selection_seed"`
+	AssignedBy    pgtype.Text        `json:"assigned_by"`
 }
 
 func (q *Queries) AddReviewer(ctx context.Context, arg AddReviewerParams) error {
-	_, err := q.db.Exec(ctx, addReviewer, arg.PullRequestID, arg.UserID, arg.AssignedAt)
+	_, err := q.db.Exec(ctx, addReviewer,
+		arg.OrgID,
+		arg.PullRequestID,
+		arg.UserID,
+		arg.AssignedAt,
+		arg.SelectionSeed,
+		arg.AssignedBy,
+	)
 	return err
 }
 
+const countOpenReviewsByUser = `-- name: CountOpenReviewsByUser :many
+SELECT rev.user_id, COUNT(*) AS open_reviews
+FROM reviewers rev
+JOIN pull_requests pr
+    ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+WHERE rev.org_id = $1 AND pr.status = 'OPEN'
+GROUP BY rev.user_id
+`
+
+type CountOpenReviewsByUserRow struct {
+	UserID      string `json:"user_id"`
+	OpenReviews int64  `json:"open_reviews"`
+}
+
+func (q *Queries) CountOpenReviewsByUser(ctx context.Context, orgID int32) ([]CountOpenReviewsByUserRow, error) {
+	rows, err := q.db.Query(ctx, countOpenReviewsByUser, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountOpenReviewsByUserRow{}
+	for rows.Next() {
+		var i CountOpenReviewsByUserRow
+		if err := rows.Scan(&i.UserID, &i.OpenReviews); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countOpenReviewsForUser = `-- name: CountOpenReviewsForUser :one
+SELECT COUNT(*) AS open_reviews
+FROM reviewers rev
+JOIN pull_requests pr
+    ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+WHERE rev.org_id = $1 AND rev.user_id = $2 AND pr.status = 'OPEN'
+`
+
+type CountOpenReviewsForUserParams struct {
+	OrgID  int32  `json:"org_id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) CountOpenReviewsForUser(ctx context.Context, arg CountOpenReviewsForUserParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countOpenReviewsForUser, arg.OrgID, arg.UserID)
+	var open_reviews int64
+	err := row.Scan(&open_reviews)
+	return open_reviews, err
+}
+
 const getPRsByReviewer = `-- name: GetPRsByReviewer :many
 SELECT 
     pr.pull_request_id,
@@ -34,11 +98,17 @@ SELECT
     pr.author_id,
     pr.status
 FROM pull_requests pr
-JOIN reviewers rev ON pr.pull_request_id = rev.pull_request_id
-WHERE rev.user_id = $1
+JOIN reviewers rev
+    ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+WHERE rev.org_id = $1 AND rev.user_id = $2
 ORDER BY pr.created_at DESC
 `
 
+type GetPRsByReviewerParams struct {
+	OrgID  int32  `json:"org_id"`
+	UserID string `json:"user_id"`
+}
+
 type GetPRsByReviewerRow struct {
 	PullRequestID   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
@@ -46,8 +116,8 @@ type GetPRsByReviewerRow struct {
 	Status          string `json:"status"`
 }
 
-func (q *Queries) GetPRsByReviewer(ctx context.Context, userID string) ([]GetPRsByReviewerRow, error) {
-	rows, err := q.db.Query(ctx, getPRsByReviewer, userID)
+func (q *Queries) GetPRsByReviewer(ctx context.Context, arg GetPRsByReviewerParams) ([]GetPRsByReviewerRow, error) {
+	rows, err := q.db.Query(ctx, getPRsByReviewer, arg.OrgID, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -74,30 +144,44 @@ func (q *Queries) GetPRsByReviewer(ctx context.Context, userID string) ([]GetPRs
 const getReviewerCount = `-- name: GetReviewerCount :one
 SELECT COUNT(*)
 FROM reviewers
-WHERE pull_request_id = $1
+WHERE org_id = $1 AND pull_request_id = $2
 `
 
-func (q *Queries) GetReviewerCount(ctx context.Context, pullRequestID string) (int64, error) {
-	row := q.db.QueryRow(ctx, getReviewerCount, pullRequestID)
+type GetReviewerCountParams struct {
+	OrgID         int32  `json:"org_id"`
+	PullRequestID string `json:"pull_request_id"`
+}
+
+func (q *Queries) GetReviewerCount(ctx context.Context, arg GetReviewerCountParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getReviewerCount, arg.OrgID, arg.PullRequestID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const getReviewersByPR = `-- name: GetReviewersByPR :many
-SELECT user_id, assigned_at
+SELECT user_id, assigned_at, verdict, verdict_at, selection_seed, assigned_by
 FROM reviewers
-WHERE pull_request_id = $1
+WHERE org_id = $1 AND pull_request_id = $2
 ORDER BY assigned_at
 `
 
+type GetReviewersByPRParams struct {
+	OrgID         int32  `json:"org_id"`
+	PullRequestID string `json:"pull_request_id"`
+}
+
 type GetReviewersByPRRow struct {
-	UserID     string             `json:"user_id"`
-	AssignedAt pgtype.Timestamptz `json:"assigned_at"`
+	UserID        string             `json:"user_id"`
+	AssignedAt    pgtype.Timestamptz `json:"assigned_at"`
+	Verdict       pgtype.Text        `json:"verdict"`
+	VerdictAt     pgtype.Timestamptz `json:"verdict_at"`
+	SelectionSeed pgtype.Int8        `json:"selection_seed"`
+	AssignedBy    pgtype.Text        `json:"assigned_by"`
 }
 
-func (q *Queries) GetReviewersByPR(ctx context.Context, pullRequestID string) ([]GetReviewersByPRRow, error) {
-	rows, err := q.db.Query(ctx, getReviewersByPR, pullRequestID)
+func (q *Queries) GetReviewersByPR(ctx context.Context, arg GetReviewersByPRParams) ([]GetReviewersByPRRow, error) {
+	rows, err := q.db.Query(ctx, getReviewersByPR, arg.OrgID, arg.PullRequestID)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +189,14 @@ func (q *Queries) GetReviewersByPR(ctx context.Context, pullRequestID string) ([
 	items := []GetReviewersByPRRow{}
 	for rows.Next() {
 		var i GetReviewersByPRRow
-		if err := rows.Scan(&i.UserID, &i.AssignedAt); err != nil {
+		if err := rows.Scan(
+			&i.UserID,
+			&i.AssignedAt,
+			&i.Verdict,
+			&i.VerdictAt,
+			&i.SelectionSeed,
+			&i.AssignedBy,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -120,48 +211,85 @@ const isUserReviewer = `-- name: IsUserReviewer :one
 SELECT EXISTS(
     SELECT 1 
     FROM reviewers 
-    WHERE pull_request_id = $1 AND user_id = $2
+    WHERE org_id = $1 AND pull_request_id = $2 AND user_id = $3
 )
 `
 
 type IsUserReviewerParams struct {
+	OrgID         int32  `json:"org_id"`
 	PullRequestID string `json:"pull_request_id"`
 	UserID        string `json:"user_id"`
 }
 
 func (q *Queries) IsUserReviewer(ctx context.Context, arg IsUserReviewerParams) (bool, error) {
-	row := q.db.QueryRow(ctx, isUserReviewer, arg.PullRequestID, arg.UserID)
+	row := q.db.QueryRow(ctx, isUserReviewer, arg.OrgID, arg.PullRequestID, arg.UserID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
 }
 
 const removeReviewer = `-- name: RemoveReviewer :exec
-DELETE FROM reviewers
-WHERE pull_request_id = $1 AND user_id = $2
+WITH removed AS (
+    DELETE FROM reviewers r
+    WHERE r.org_id = $4 AND r.pull_request_id = $5 AND r.user_id = $6
+    RETURNING r.org_id, r.pull_request_id, r.user_id, r.assigned_at, r.assigned_by
+)
+INSERT INTO reviewer_removals (
+    org_id,
+    pull_request_id,
+    user_id,
+    assigned_at,
+    assigned_by,
+    replaced_by,
+    removed_by,
+    removed_at
+)
+SELECT
+    removed.org_id,
+    removed.pull_request_id,
+    removed.user_id,
+    removed.assigned_at,
+    removed.assigned_by,
+    $1::varchar,
+    $2::varchar,
+    COALESCE($3::timestamptz, NOW())
+FROM removed
 `
 
 type RemoveReviewerParams struct {
-	PullRequestID string `json:"pull_request_id"`
-	UserID        string `json:"user_id"`
+	ReplacedBy    pgtype.Text        `json:"replaced_by"`
+	RemovedBy     pgtype.Text        `json:"removed_by"`
+	RemovedAt     pgtype.Timestamptz `json:"removed_at"`
+	OrgID         int32              `json:"org_id"`
+	PullRequestID string             `json:"pull_request_id"`
+	UserID        string             `json:"user_id"`
 }
 
+// moves the assignment to reviewer_removals with who removed it
 func (q *Queries) RemoveReviewer(ctx context.Context, arg RemoveReviewerParams) error {
-	_, err := q.db.Exec(ctx, removeReviewer, arg.PullRequestID, arg.UserID)
+	_, err := q.db.Exec(ctx, removeReviewer,
+		arg.ReplacedBy,
+		arg.RemovedBy,
+		arg.RemovedAt,
+		arg.OrgID,
+		arg.PullRequestID,
+		arg.UserID,
+	)
 	return err
 }
 
 const replaceReviewer = `-- name: ReplaceReviewer :exec
 WITH deleted AS (
     DELETE FROM reviewers r
-    WHERE r.pull_request_id = $1 AND r.user_id = $2
+    WHERE r.org_id = $1 AND r.pull_request_id = $2 AND r.user_id = $3
 )
-INSERT INTO reviewers (pull_request_id, user_id, assigned_at)
-VALUES ($1, $3, $4)
+INSERT INTO reviewers (org_id, pull_request_id, user_id, assigned_at)
+VALUES ($1, $2, $4, $5)
 ON CONFLICT DO NOTHING
 `
 
 type ReplaceReviewerParams struct {
+	OrgID         int32              `json:"org_id"`
 	PullRequestID string             `json:"pull_request_id"`
 	UserID        string             `json:"user_id"`
 	UserID_2      string             `json:"user_id_2"`
@@ -170,6 +298,7 @@ type ReplaceReviewerParams struct {
 
 func (q *Queries) ReplaceReviewer(ctx context.Context, arg ReplaceReviewerParams) error {
 	_, err := q.db.Exec(ctx, replaceReviewer,
+		arg.OrgID,
 		arg.PullRequestID,
 		arg.UserID,
 		arg.UserID_2,
@@ -177,3 +306,28 @@ func (q *Queries) ReplaceReviewer(ctx context.Context, arg ReplaceReviewerParams
 	)
 	return err
 }
+
+const setReviewerVerdict = `-- name: SetReviewerVerdict :exec
+UPDATE reviewers
+SET verdict = $4, verdict_at = $5
+WHERE org_id = $1 AND pull_request_id = $2 AND user_id = $3
+`
+
+type SetReviewerVerdictParams struct {
+	OrgID         int32              `json:"org_id"`
+	PullRequestID string             `json:"pull_request_id"`
+	UserID        string             `json:"user_id"`
+	Verdict       pgtype.Text        `json:"verdict"`
+	VerdictAt     pgtype.Timestamptz `json:"verdict_at"`
+}
+
+func (q *Queries) SetReviewerVerdict(ctx context.Context, arg SetReviewerVerdictParams) error {
+	_, err := q.db.Exec(ctx, setReviewerVerdict,
+		arg.OrgID,
+		arg.PullRequestID,
+		arg.UserID,
+		arg.Verdict,
+		arg.VerdictAt,
+	)
+	return err
+}
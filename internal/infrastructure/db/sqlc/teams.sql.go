@@ -12,82 +12,132 @@ import (
 )
 
 const createTeam = `-- name: CreateTeam :one
-INSERT INTO teams (team_name)
-VALUES ($1)
-RETURNING id, team_name
+INSERT INTO teams (org_id, team_name)
+VALUES ($1, $2)
+RETURNING id, team_name, org_id
 `
 
-func (q *Queries) CreateTeam(ctx context.Context, teamName string) (Team, error) {
-	row := q.db.QueryRow(ctx, createTeam, teamName)
-	var i Team
-	err := row.Scan(&i.ID, &i.TeamName)
+type CreateTeamParams struct {
+	OrgID    int32  `json:"org_id"`
+	TeamName string `json:"team_name"`
+}
+
+type CreateTeamRow struct {
+	ID       int32  `json:"id"`
+	TeamName string `json:"team_name"`
+	OrgID    int32  `json:"org_id"`
+}
+
+func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (CreateTeamRow, error) {
+	row := q.db.QueryRow(ctx, createTeam, arg.OrgID, arg.TeamName)
+	var i CreateTeamRow
+	err := row.Scan(&i.ID, &i.TeamName, &i.OrgID)
 	return i, err
 }
 
 const deleteTeam = `-- name: DeleteTeam :exec
 DELETE FROM teams
-WHERE id = $1
+WHERE org_id = $1 AND id = $2
 `
 
-func (q *Queries) DeleteTeam(ctx context.Context, id int32) error {
-	_, err := q.db.Exec(ctx, deleteTeam, id)
+type DeleteTeamParams struct {
+	OrgID int32 `json:"org_id"`
+	ID    int32 `json:"id"`
+}
+
+func (q *Queries) DeleteTeam(ctx context.Context, arg DeleteTeamParams) error {
+	_, err := q.db.Exec(ctx, deleteTeam, arg.OrgID, arg.ID)
 	return err
 }
 
 const getTeamByID = `-- name: GetTeamByID :one
-SELECT id, team_name
+SELECT id, team_name, org_id
 FROM teams
-WHERE id = $1
+WHERE org_id = $1 AND id = $2
 `
 
-func (q *Queries) GetTeamByID(ctx context.Context, id int32) (Team, error) {
-	row := q.db.QueryRow(ctx, getTeamByID, id)
-	var i Team
-	err := row.Scan(&i.ID, &i.TeamName)
+type GetTeamByIDParams struct {
+	OrgID int32 `json:"org_id"`
+	ID    int32 `json:"id"`
+}
+
+type GetTeamByIDRow struct {
+	ID       int32  `json:"id"`
+	TeamName string `json:"team_name"`
+	OrgID    int32  `json:"org_id"`
+}
+
+func (q *Queries) GetTeamByID(ctx context.Context, arg GetTeamByIDParams) (GetTeamByIDRow, error) {
+	row := q.db.QueryRow(ctx, getTeamByID, arg.OrgID, arg.ID)
+	var i GetTeamByIDRow
+	err := row.Scan(&i.ID, &i.TeamName, &i.OrgID)
 	return i, err
 }
 
 const getTeamByName = `-- name: GetTeamByName :one
-SELECT id, team_name
+SELECT id, team_name, org_id
 FROM teams
-WHERE team_name = $1
+WHERE org_id = $1 AND team_name = $2
 `
 
-func (q *Queries) GetTeamByName(ctx context.Context, teamName string) (Team, error) {
-	row := q.db.QueryRow(ctx, getTeamByName, teamName)
-	var i Team
-	err := row.Scan(&i.ID, &i.TeamName)
+type GetTeamByNameParams struct {
+	OrgID    int32  `json:"org_id"`
+	TeamName string `json:"team_name"`
+}
+
+type GetTeamByNameRow struct {
+	ID       int32  `json:"id"`
+	TeamName string `json:"team_name"`
+	OrgID    int32  `json:"org_id"`
+}
+
+func (q *Queries) GetTeamByName(ctx context.Context, arg GetTeamByNameParams) (GetTeamByNameRow, error) {
+	row := q.db.QueryRow(ctx, getTeamByName, arg.OrgID, arg.TeamName)
+	var i GetTeamByNameRow
+	err := row.Scan(&i.ID, &i.TeamName, &i.OrgID)
 	return i, err
 }
 
 const getTeamMemberCount = `-- name: GetTeamMemberCount :one
 SELECT COUNT(*) 
 FROM users 
-WHERE team_id = $1
+WHERE org_id = $1 AND team_id = $2
 `
 
-func (q *Queries) GetTeamMemberCount(ctx context.Context, teamID pgtype.Int4) (int64, error) {
-	row := q.db.QueryRow(ctx, getTeamMemberCount, teamID)
+type GetTeamMemberCountParams struct {
+	OrgID  int32       `json:"org_id"`
+	TeamID pgtype.Int4 `json:"team_id"`
+}
+
+func (q *Queries) GetTeamMemberCount(ctx context.Context, arg GetTeamMemberCountParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getTeamMemberCount, arg.OrgID, arg.TeamID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const getTeams = `-- name: GetTeams :many
-SELECT id, team_name
+SELECT id, team_name, org_id
 FROM teams
+WHERE org_id = $1
 `
 
-func (q *Queries) GetTeams(ctx context.Context) ([]Team, error) {
-	rows, err := q.db.Query(ctx, getTeams)
+type GetTeamsRow struct {
+	ID       int32  `json:"id"`
+	TeamName string `json:"team_name"`
+	OrgID    int32  `json:"org_id"`
+}
+
+func (q *Queries) GetTeams(ctx context.Context, orgID int32) ([]GetTeamsRow, error) {
+	rows, err := q.db.Query(ctx, getTeams, orgID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Team{}
+	items := []GetTeamsRow{}
 	for rows.Next() {
-		var i Team
-		if err := rows.Scan(&i.ID, &i.TeamName); err != nil {
+		var i GetTeamsRow
+		if err := rows.Scan(&i.ID, &i.TeamName, &i.OrgID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -100,12 +150,17 @@ func (q *Queries) GetTeams(ctx context.Context) ([]Team, error) {
 
 const teamExists = `-- name: TeamExists :one
 SELECT EXISTS(
-    SELECT 1 FROM teams WHERE team_name = $1
+    SELECT 1 FROM teams WHERE org_id = $1 AND team_name = $2
 )
 `
 
-func (q *Queries) TeamExists(ctx context.Context, teamName string) (bool, error) {
-	row := q.db.QueryRow(ctx, teamExists, teamName)
+type TeamExistsParams struct {
+	OrgID    int32  `json:"org_id"`
+	TeamName string `json:"team_name"`
+}
+
+func (q *Queries) TeamExists(ctx context.Context, arg TeamExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, teamExists, arg.OrgID, arg.TeamName)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
@@ -113,16 +168,17 @@ func (q *Queries) TeamExists(ctx context.Context, teamName string) (bool, error)
 
 const updateTeam = `-- name: UpdateTeam :exec
 UPDATE teams
-SET team_name = $2
-WHERE id = $1
+SET team_name = $3
+WHERE org_id = $1 AND id = $2
 `
 
 type UpdateTeamParams struct {
+	OrgID    int32  `json:"org_id"`
 	ID       int32  `json:"id"`
 	TeamName string `json:"team_name"`
 }
 
 func (q *Queries) UpdateTeam(ctx context.Context, arg UpdateTeamParams) error {
-	_, err := q.db.Exec(ctx, updateTeam, arg.ID, arg.TeamName)
+	_, err := q.db.Exec(ctx, updateTeam, arg.OrgID, arg.ID, arg.TeamName)
 	return err
 }
@@ -12,53 +12,69 @@ import (
 )
 
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (user_id, username, is_active, team_id)
-VALUES ($1, $2, $3, $4)
-RETURNING user_id, username, is_active, team_id
+INSERT INTO users (org_id, user_id, username, is_active, team_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING user_id, username, is_active, team_id, org_id
 `
 
 type CreateUserParams struct {
+	OrgID    int32       `json:"org_id"`
 	UserID   string      `json:"user_id"`
 	Username string      `json:"username"`
 	IsActive bool        `json:"is_active"`
 	TeamID   pgtype.Int4 `json:"team_id"`
 }
 
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+type CreateUserRow struct {
+	UserID   string      `json:"user_id"`
+	Username string      `json:"username"`
+	IsActive bool        `json:"is_active"`
+	TeamID   pgtype.Int4 `json:"team_id"`
+	OrgID    int32       `json:"org_id"`
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error) {
 	row := q.db.QueryRow(ctx, createUser,
+		arg.OrgID,
 		arg.UserID,
 		arg.Username,
 		arg.IsActive,
 		arg.TeamID,
 	)
-	var i User
+	var i CreateUserRow
 	err := row.Scan(
 		&i.UserID,
 		&i.Username,
 		&i.IsActive,
 		&i.TeamID,
+		&i.OrgID,
 	)
 	return i, err
 }
 
 const deleteUser = `-- name: DeleteUser :exec
 DELETE FROM users
-WHERE user_id = $1
+WHERE org_id = $1 AND user_id = $2
 `
 
-func (q *Queries) DeleteUser(ctx context.Context, userID string) error {
-	_, err := q.db.Exec(ctx, deleteUser, userID)
+type DeleteUserParams struct {
+	OrgID  int32  `json:"org_id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) DeleteUser(ctx context.Context, arg DeleteUserParams) error {
+	_, err := q.db.Exec(ctx, deleteUser, arg.OrgID, arg.UserID)
 	return err
 }
 
 const getActiveUsers = `-- name: GetActiveUsers :many
-SELECT user_id, username, is_active, team_id
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
 FROM users
-WHERE is_active = true
+WHERE org_id = $1 AND is_active = true
 `
 
-func (q *Queries) GetActiveUsers(ctx context.Context) ([]User, error) {
-	rows, err := q.db.Query(ctx, getActiveUsers)
+func (q *Queries) GetActiveUsers(ctx context.Context, orgID int32) ([]User, error) {
+	rows, err := q.db.Query(ctx, getActiveUsers, orgID)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +87,9 @@ func (q *Queries) GetActiveUsers(ctx context.Context) ([]User, error) {
 			&i.Username,
 			&i.IsActive,
 			&i.TeamID,
+			&i.OrgID,
+			&i.MaxOpenReviews,
+			&i.OutOfOfficeUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -83,13 +102,18 @@ func (q *Queries) GetActiveUsers(ctx context.Context) ([]User, error) {
 }
 
 const getActiveUsersByTeamID = `-- name: GetActiveUsersByTeamID :many
-SELECT user_id, username, is_active, team_id
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
 FROM users
-WHERE team_id = $1 AND is_active = true
+WHERE org_id = $1 AND team_id = $2 AND is_active = true
 `
 
-func (q *Queries) GetActiveUsersByTeamID(ctx context.Context, teamID pgtype.Int4) ([]User, error) {
-	rows, err := q.db.Query(ctx, getActiveUsersByTeamID, teamID)
+type GetActiveUsersByTeamIDParams struct {
+	OrgID  int32       `json:"org_id"`
+	TeamID pgtype.Int4 `json:"team_id"`
+}
+
+func (q *Queries) GetActiveUsersByTeamID(ctx context.Context, arg GetActiveUsersByTeamIDParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, getActiveUsersByTeamID, arg.OrgID, arg.TeamID)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +126,9 @@ func (q *Queries) GetActiveUsersByTeamID(ctx context.Context, teamID pgtype.Int4
 			&i.Username,
 			&i.IsActive,
 			&i.TeamID,
+			&i.OrgID,
+			&i.MaxOpenReviews,
+			&i.OutOfOfficeUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -114,43 +141,63 @@ func (q *Queries) GetActiveUsersByTeamID(ctx context.Context, teamID pgtype.Int4
 }
 
 const getTeamByUserID = `-- name: GetTeamByUserID :one
-SELECT t.id, t.team_name FROM teams t
-JOIN users u ON u.team_id = t.id
-WHERE u.user_id = $1
+SELECT t.id, t.team_name, t.org_id FROM teams t
+JOIN users u ON u.org_id = t.org_id AND u.team_id = t.id
+WHERE u.org_id = $1 AND u.user_id = $2
 `
 
-func (q *Queries) GetTeamByUserID(ctx context.Context, userID string) (Team, error) {
-	row := q.db.QueryRow(ctx, getTeamByUserID, userID)
-	var i Team
-	err := row.Scan(&i.ID, &i.TeamName)
+type GetTeamByUserIDParams struct {
+	OrgID  int32  `json:"org_id"`
+	UserID string `json:"user_id"`
+}
+
+type GetTeamByUserIDRow struct {
+	ID       int32  `json:"id"`
+	TeamName string `json:"team_name"`
+	OrgID    int32  `json:"org_id"`
+}
+
+func (q *Queries) GetTeamByUserID(ctx context.Context, arg GetTeamByUserIDParams) (GetTeamByUserIDRow, error) {
+	row := q.db.QueryRow(ctx, getTeamByUserID, arg.OrgID, arg.UserID)
+	var i GetTeamByUserIDRow
+	err := row.Scan(&i.ID, &i.TeamName, &i.OrgID)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT user_id, username, is_active, team_id
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
 FROM users
-WHERE user_id = $1
+WHERE org_id = $1 AND user_id = $2
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, userID string) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByID, userID)
+type GetUserByIDParams struct {
+	OrgID  int32  `json:"org_id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) GetUserByID(ctx context.Context, arg GetUserByIDParams) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByID, arg.OrgID, arg.UserID)
 	var i User
 	err := row.Scan(
 		&i.UserID,
 		&i.Username,
 		&i.IsActive,
 		&i.TeamID,
+		&i.OrgID,
+		&i.MaxOpenReviews,
+		&i.OutOfOfficeUntil,
 	)
 	return i, err
 }
 
 const getUsers = `-- name: GetUsers :many
-SELECT user_id, username, is_active, team_id
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
 FROM users
+WHERE org_id = $1
 `
 
-func (q *Queries) GetUsers(ctx context.Context) ([]User, error) {
-	rows, err := q.db.Query(ctx, getUsers)
+func (q *Queries) GetUsers(ctx context.Context, orgID int32) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsers, orgID)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +210,9 @@ func (q *Queries) GetUsers(ctx context.Context) ([]User, error) {
 			&i.Username,
 			&i.IsActive,
 			&i.TeamID,
+			&i.OrgID,
+			&i.MaxOpenReviews,
+			&i.OutOfOfficeUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -175,13 +225,18 @@ func (q *Queries) GetUsers(ctx context.Context) ([]User, error) {
 }
 
 const getUsersByTeamID = `-- name: GetUsersByTeamID :many
-SELECT user_id, username, is_active, team_id
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
 FROM users
-WHERE team_id = $1
+WHERE org_id = $1 AND team_id = $2
 `
 
-func (q *Queries) GetUsersByTeamID(ctx context.Context, teamID pgtype.Int4) ([]User, error) {
-	rows, err := q.db.Query(ctx, getUsersByTeamID, teamID)
+type GetUsersByTeamIDParams struct {
+	OrgID  int32       `json:"org_id"`
+	TeamID pgtype.Int4 `json:"team_id"`
+}
+
+func (q *Queries) GetUsersByTeamID(ctx context.Context, arg GetUsersByTeamIDParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsersByTeamID, arg.OrgID, arg.TeamID)
 	if err != nil {
 		return nil, err
 	}
@@ -194,6 +249,9 @@ func (q *Queries) GetUsersByTeamID(ctx context.Context, teamID pgtype.Int4) ([]U
 			&i.Username,
 			&i.IsActive,
 			&i.TeamID,
+			&i.OrgID,
+			&i.MaxOpenReviews,
+			&i.OutOfOfficeUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -205,13 +263,54 @@ func (q *Queries) GetUsersByTeamID(ctx context.Context, teamID pgtype.Int4) ([]U
 	return items, nil
 }
 
+const setUserMaxOpenReviews = `-- name: SetUserMaxOpenReviews :execrows
+UPDATE users
+SET max_open_reviews = $3
+WHERE org_id = $1 AND user_id = $2
+`
+
+type SetUserMaxOpenReviewsParams struct {
+	OrgID          int32       `json:"org_id"`
+	UserID         string      `json:"user_id"`
+	MaxOpenReviews pgtype.Int4 `json:"max_open_reviews"`
+}
+
+func (q *Queries) SetUserMaxOpenReviews(ctx context.Context, arg SetUserMaxOpenReviewsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserMaxOpenReviews, arg.OrgID, arg.UserID, arg.MaxOpenReviews)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setUserOutOfOffice = `-- name: SetUserOutOfOffice :execrows
+UPDATE users
+SET out_of_office_until = $3
+WHERE org_id = $1 AND user_id = $2
+`
+
+type SetUserOutOfOfficeParams struct {
+	OrgID            int32              `json:"org_id"`
+	UserID           string             `json:"user_id"`
+	OutOfOfficeUntil pgtype.Timestamptz `json:"out_of_office_until"`
+}
+
+func (q *Queries) SetUserOutOfOffice(ctx context.Context, arg SetUserOutOfOfficeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserOutOfOffice, arg.OrgID, arg.UserID, arg.OutOfOfficeUntil)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users
-SET username = $2, is_active = $3, team_id = $4
-WHERE user_id = $1
+SET username = $3, is_active = $4, team_id = $5
+WHERE org_id = $1 AND user_id = $2
 `
 
 type UpdateUserParams struct {
+	OrgID    int32       `json:"org_id"`
 	UserID   string      `json:"user_id"`
 	Username string      `json:"username"`
 	IsActive bool        `json:"is_active"`
@@ -220,6 +319,7 @@ type UpdateUserParams struct {
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
 	_, err := q.db.Exec(ctx, updateUser,
+		arg.OrgID,
 		arg.UserID,
 		arg.Username,
 		arg.IsActive,
@@ -230,12 +330,13 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
 
 const updateUserStatus = `-- name: UpdateUserStatus :one
 UPDATE users
-SET is_active = $2
-WHERE user_id = $1
+SET is_active = $3
+WHERE org_id = $1 AND user_id = $2
 RETURNING user_id, username, is_active
 `
 
 type UpdateUserStatusParams struct {
+	OrgID    int32  `json:"org_id"`
 	UserID   string `json:"user_id"`
 	IsActive bool   `json:"is_active"`
 }
@@ -247,7 +348,7 @@ type UpdateUserStatusRow struct {
 }
 
 func (q *Queries) UpdateUserStatus(ctx context.Context, arg UpdateUserStatusParams) (UpdateUserStatusRow, error) {
-	row := q.db.QueryRow(ctx, updateUserStatus, arg.UserID, arg.IsActive)
+	row := q.db.QueryRow(ctx, updateUserStatus, arg.OrgID, arg.UserID, arg.IsActive)
 	var i UpdateUserStatusRow
 	err := row.Scan(&i.UserID, &i.Username, &i.IsActive)
 	return i, err
@@ -255,12 +356,17 @@ func (q *Queries) UpdateUserStatus(ctx context.Context, arg UpdateUserStatusPara
 
 const userExists = `-- name: UserExists :one
 SELECT EXISTS (
-    SELECT 1 FROM users WHERE user_id = $1
+    SELECT 1 FROM users WHERE org_id = $1 AND user_id = $2
 )
 `
 
-func (q *Queries) UserExists(ctx context.Context, userID string) (bool, error) {
-	row := q.db.QueryRow(ctx, userExists, userID)
+type UserExistsParams struct {
+	OrgID  int32  `json:"org_id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) UserExists(ctx context.Context, arg UserExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, userExists, arg.OrgID, arg.UserID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	ds "github.com/Traunin/review-assigner/internal/domain/services"
+)
+
+type instrumentedAssignmentService struct {
+	next    ds.ReviewerAssignmentService
+	metrics *Metrics
+
+	// a PR with fewer reviewers than this counts as understaffed
+	reviewersPerPR int
+}
+
+// InstrumentAssignment wraps the assignment service with domain counters
+func InstrumentAssignment(
+	next ds.ReviewerAssignmentService,
+	m *Metrics,
+	reviewersPerPR int,
+) ds.ReviewerAssignmentService {
+	return &instrumentedAssignmentService{
+		next:           next,
+		metrics:        m,
+		reviewersPerPR: reviewersPerPR,
+	}
+}
+
+func (s *instrumentedAssignmentService) CreateAndAssign(
+	ctx context.Context,
+	pr *entities.PullRequest,
+) (*entities.PullRequest, error) {
+	created, err := s.next.CreateAndAssign(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned := len(created.Reviewers())
+	s.metrics.prsCreated.Inc()
+	s.metrics.reviewersAssigned.Add(float64(assigned))
+	if assigned < s.reviewersPerPR {
+		s.metrics.understaffedPRs.Inc()
+	}
+
+	return created, nil
+}
+
+func (s *instrumentedAssignmentService) ReassignReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	oldReviewerID entities.UserID,
+	newReviewerID entities.UserID,
+) (entities.UserID, *entities.PullRequest, error) {
+	assigned, pr, err := s.next.ReassignReviewer(ctx, prID, oldReviewerID, newReviewerID)
+
+	switch {
+	case err == nil:
+		s.metrics.reassignments.WithLabelValues(OutcomeSuccess).Inc()
+		s.metrics.reviewersAssigned.Inc()
+	case errors.Is(err, ds.ErrAtCapacity):
+		s.metrics.reassignments.WithLabelValues(OutcomeAtCapacity).Inc()
+	case errors.Is(err, ds.ErrNoCandidate):
+		s.metrics.reassignments.WithLabelValues(OutcomeNoCandidate).Inc()
+	case errors.Is(err, ds.ErrUserNotReviewer):
+		s.metrics.reassignments.WithLabelValues(OutcomeNotAssigned).Inc()
+	default:
+		s.metrics.reassignments.WithLabelValues(OutcomeError).Inc()
+	}
+
+	return assigned, pr, err
+}
+
+func (s *instrumentedAssignmentService) AddReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (*entities.PullRequest, error) {
+	pr, err := s.next.AddReviewer(ctx, prID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.metrics.reviewersAssigned.Inc()
+	return pr, nil
+}
+
+func (s *instrumentedAssignmentService) RemoveReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (*entities.PullRequest, error) {
+	return s.next.RemoveReviewer(ctx, prID, reviewerID)
+}
+
+func (s *instrumentedAssignmentService) FillReviewers(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) ([]entities.UserID, *entities.PullRequest, error) {
+	added, pr, err := s.next.FillReviewers(ctx, prID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.metrics.reviewersAssigned.Add(float64(len(added)))
+	return added, pr, nil
+}
+
+func (s *instrumentedAssignmentService) Merge(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) (*entities.PullRequest, error) {
+	return s.next.Merge(ctx, prID)
+}
+
+func (s *instrumentedAssignmentService) Preview(
+	ctx context.Context,
+	authorID entities.UserID,
+) (*entities.AssignmentPreview, error) {
+	return s.next.Preview(ctx, authorID)
+}
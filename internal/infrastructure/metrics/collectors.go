@@ -0,0 +1,147 @@
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/tenant"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const scrapeTimeout = 5 * time.Second
+
+// poolCollector exposes pgxpool statistics at scrape time
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	acquiredConns        *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	idleConns            *prometheus.Desc
+	maxConns             *prometheus.Desc
+	totalConns           *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "db_pool", name),
+			help,
+			nil,
+			nil,
+		)
+	}
+
+	return &poolCollector{
+		pool:                 pool,
+		acquireCount:         desc("acquire_total", "Successful connection acquires."),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		acquiredConns:        desc("acquired_connections", "Connections currently in use."),
+		canceledAcquireCount: desc("canceled_acquire_total", "Acquires canceled by context."),
+		emptyAcquireCount:    desc("empty_acquire_total", "Acquires that had to wait for a connection."),
+		idleConns:            desc("idle_connections", "Idle connections in the pool."),
+		maxConns:             desc("max_connections", "Maximum size of the pool."),
+		totalConns:           desc("total_connections", "Total connections in the pool."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.acquiredConns
+	ch <- c.canceledAcquireCount
+	ch <- c.emptyAcquireCount
+	ch <- c.idleConns
+	ch <- c.maxConns
+	ch <- c.totalConns
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+}
+
+// openReviewsCollector reports open reviews per user of every
+// organization, queried at scrape time so the gauge never drifts from the
+// database
+type openReviewsCollector struct {
+	orgRepo     repositories.OrganizationRepository
+	prRepo      repositories.PullRequestRepository
+	openReviews *prometheus.Desc
+	scrapeError *prometheus.Desc
+}
+
+func newOpenReviewsCollector(
+	orgRepo repositories.OrganizationRepository,
+	prRepo repositories.PullRequestRepository,
+) *openReviewsCollector {
+	return &openReviewsCollector{
+		orgRepo: orgRepo,
+		prRepo:  prRepo,
+		openReviews: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "open_reviews"),
+			"Open pull requests each user is assigned to review.",
+			[]string{"org_id", "user_id"},
+			nil,
+		),
+		scrapeError: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "open_reviews", "scrape_error"),
+			"1 if the last open reviews query failed.",
+			nil,
+			nil,
+		),
+	}
+}
+
+func (c *openReviewsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.openReviews
+	ch <- c.scrapeError
+}
+
+func (c *openReviewsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	orgs, err := c.orgRepo.FindAll(ctx)
+	if err != nil {
+		ch <- prometheus.MustNewConstMetric(c.scrapeError, prometheus.GaugeValue, 1)
+		return
+	}
+
+	counts := make(map[entities.OrgID]map[entities.UserID]int, len(orgs))
+	for _, org := range orgs {
+		orgCounts, err := c.prRepo.CountOpenReviewsByUser(tenant.WithOrg(ctx, org.ID()))
+		if err != nil {
+			ch <- prometheus.MustNewConstMetric(c.scrapeError, prometheus.GaugeValue, 1)
+			return
+		}
+		counts[org.ID()] = orgCounts
+	}
+	ch <- prometheus.MustNewConstMetric(c.scrapeError, prometheus.GaugeValue, 0)
+
+	for orgID, orgCounts := range counts {
+		for userID, count := range orgCounts {
+			ch <- prometheus.MustNewConstMetric(
+				c.openReviews,
+				prometheus.GaugeValue,
+				float64(count),
+				strconv.Itoa(int(orgID)),
+				userID.String(),
+			)
+		}
+	}
+}
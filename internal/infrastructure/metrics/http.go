@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Middleware records request latency labelled with the route template
+// (e.g. /team/get), not the raw URL, to keep label cardinality bounded.
+func (m *Metrics) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					status = httpErr.Code
+				} else {
+					status = http.StatusInternalServerError
+				}
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+
+			m.requestDuration.WithLabelValues(
+				route,
+				c.Request().Method,
+				strconv.Itoa(status),
+			).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
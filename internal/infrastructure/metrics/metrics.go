@@ -0,0 +1,106 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "review_assigner"
+
+// reassignment outcomes used as the "outcome" label
+const (
+	OutcomeSuccess     = "success"
+	OutcomeNoCandidate = "no_candidate"
+	OutcomeAtCapacity  = "at_capacity"
+	OutcomeNotAssigned = "not_assigned"
+	OutcomeError       = "error"
+)
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requestDuration   *prometheus.HistogramVec
+	prsCreated        prometheus.Counter
+	reviewersAssigned prometheus.Counter
+	reassignments     *prometheus.CounterVec
+	understaffedPRs   prometheus.Counter
+}
+
+func New(
+	pool *pgxpool.Pool,
+	orgRepo repositories.OrganizationRepository,
+	prRepo repositories.PullRequestRepository,
+) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requestDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "http",
+				Name:      "request_duration_seconds",
+				Help:      "HTTP request latency by route, method and status.",
+				Buckets:   prometheus.DefBuckets,
+			},
+			[]string{"route", "method", "status"},
+		),
+		prsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pull_requests_created_total",
+			Help:      "Pull requests created.",
+		}),
+		reviewersAssigned: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewers_assigned_total",
+			Help:      "Reviewers assigned on creation and reassignment.",
+		}),
+		reassignments: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "reassignments_total",
+				Help:      "Reviewer reassignments by outcome.",
+			},
+			[]string{"outcome"},
+		),
+		understaffedPRs: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pull_requests_understaffed_total",
+			Help:      "Pull requests created with fewer reviewers than desired.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requestDuration,
+		m.prsCreated,
+		m.reviewersAssigned,
+		m.reassignments,
+		m.understaffedPRs,
+		newPoolCollector(pool),
+		newOpenReviewsCollector(orgRepo, prRepo),
+	)
+
+	// pre-populate outcomes so rates work before the first reassignment
+	for _, outcome := range []string{
+		OutcomeSuccess,
+		OutcomeNoCandidate,
+		OutcomeAtCapacity,
+		OutcomeNotAssigned,
+		OutcomeError,
+	} {
+		m.reassignments.WithLabelValues(outcome)
+	}
+
+	return m
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		Registry: m.registry,
+	})
+}
@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QueryTracer creates a client span per SQL statement. sqlc prefixes every
+// query with "-- name: X :kind", so spans are named after the sqlc query.
+type QueryTracer struct{}
+
+var _ pgx.QueryTracer = QueryTracer{}
+
+func NewQueryTracer() QueryTracer {
+	return QueryTracer{}
+}
+
+func (QueryTracer) TraceQueryStart(
+	ctx context.Context,
+	_ *pgx.Conn,
+	data pgx.TraceQueryStartData,
+) context.Context {
+	ctx, _ = tracer().Start(
+		ctx,
+		queryName(data.SQL),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.statement", data.SQL),
+		),
+	)
+	return ctx
+}
+
+func (QueryTracer) TraceQueryEnd(
+	ctx context.Context,
+	_ *pgx.Conn,
+	data pgx.TraceQueryEndData,
+) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	if data.Err != nil && data.Err != pgx.ErrNoRows {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+}
+
+func queryName(sql string) string {
+	const prefix = "-- name: "
+	if !strings.HasPrefix(sql, prefix) {
+		return "db.query"
+	}
+
+	line, _, _ := strings.Cut(sql[len(prefix):], "\n")
+	name, _, _ := strings.Cut(line, " ")
+	return "db." + name
+}
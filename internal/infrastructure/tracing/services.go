@@ -0,0 +1,321 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	ds "github.com/Traunin/review-assigner/internal/domain/services"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func startSpan(
+	ctx context.Context,
+	name string,
+	attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+type tracedAssignmentService struct {
+	next ds.ReviewerAssignmentService
+}
+
+func TraceAssignment(
+	next ds.ReviewerAssignmentService,
+) ds.ReviewerAssignmentService {
+	return &tracedAssignmentService{next: next}
+}
+
+func (s *tracedAssignmentService) CreateAndAssign(
+	ctx context.Context,
+	pr *entities.PullRequest,
+) (created *entities.PullRequest, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"ReviewerAssignmentService.CreateAndAssign",
+		attribute.String("pr.id", pr.ID().String()),
+		attribute.String("pr.author_id", pr.AuthorID().String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	created, err = s.next.CreateAndAssign(ctx, pr)
+	if err == nil {
+		span.SetAttributes(attribute.Int("pr.reviewers", len(created.Reviewers())))
+	}
+	return created, err
+}
+
+func (s *tracedAssignmentService) ReassignReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	oldReviewerID entities.UserID,
+	newReviewerID entities.UserID,
+) (assigned entities.UserID, pr *entities.PullRequest, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"ReviewerAssignmentService.ReassignReviewer",
+		attribute.String("pr.id", prID.String()),
+		attribute.String("reviewer.old_id", oldReviewerID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	assigned, pr, err = s.next.ReassignReviewer(ctx, prID, oldReviewerID, newReviewerID)
+	if err == nil {
+		span.SetAttributes(attribute.String("reviewer.new_id", assigned.String()))
+	}
+	return assigned, pr, err
+}
+
+func (s *tracedAssignmentService) AddReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (pr *entities.PullRequest, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"ReviewerAssignmentService.AddReviewer",
+		attribute.String("pr.id", prID.String()),
+		attribute.String("reviewer.id", reviewerID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.AddReviewer(ctx, prID, reviewerID)
+}
+
+func (s *tracedAssignmentService) RemoveReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (pr *entities.PullRequest, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"ReviewerAssignmentService.RemoveReviewer",
+		attribute.String("pr.id", prID.String()),
+		attribute.String("reviewer.id", reviewerID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.RemoveReviewer(ctx, prID, reviewerID)
+}
+
+func (s *tracedAssignmentService) FillReviewers(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) (added []entities.UserID, pr *entities.PullRequest, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"ReviewerAssignmentService.FillReviewers",
+		attribute.String("pr.id", prID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	added, pr, err = s.next.FillReviewers(ctx, prID)
+	if err == nil {
+		span.SetAttributes(attribute.Int("reviewers.added", len(added)))
+	}
+	return added, pr, err
+}
+
+func (s *tracedAssignmentService) Merge(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) (pr *entities.PullRequest, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"ReviewerAssignmentService.Merge",
+		attribute.String("pr.id", prID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.Merge(ctx, prID)
+}
+
+func (s *tracedAssignmentService) Preview(
+	ctx context.Context,
+	authorID entities.UserID,
+) (preview *entities.AssignmentPreview, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"ReviewerAssignmentService.Preview",
+		attribute.String("pr.author_id", authorID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.Preview(ctx, authorID)
+}
+
+type tracedPullRequestService struct {
+	next services.PullRequestService
+}
+
+func TracePullRequests(
+	next services.PullRequestService,
+) services.PullRequestService {
+	return &tracedPullRequestService{next: next}
+}
+
+func (s *tracedPullRequestService) GetByID(
+	ctx context.Context,
+	id entities.PullRequestID,
+) (pr dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.GetByID",
+		attribute.String("pr.id", id.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.GetByID(ctx, id)
+}
+
+func (s *tracedPullRequestService) List(
+	ctx context.Context,
+	status entities.PRStatus,
+) (prs []dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.List",
+		attribute.String("pr.status", status.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.List(ctx, status)
+}
+
+func (s *tracedPullRequestService) Create(
+	ctx context.Context,
+	input dto.CreatePRCmd,
+) (pr dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.Create",
+		attribute.String("pr.id", input.PullRequestID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.Create(ctx, input)
+}
+
+func (s *tracedPullRequestService) Merge(
+	ctx context.Context,
+	id entities.PullRequestID,
+) (pr dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.Merge",
+		attribute.String("pr.id", id.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.Merge(ctx, id)
+}
+
+func (s *tracedPullRequestService) ReassignReviewer(
+	ctx context.Context,
+	input dto.ReassignReviewerCmd,
+) (result *dto.ReassignedDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.ReassignReviewer",
+		attribute.String("pr.id", input.PullRequestID.String()),
+		attribute.String("reviewer.old_id", input.OldUserID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.ReassignReviewer(ctx, input)
+}
+
+func (s *tracedPullRequestService) AddReviewer(
+	ctx context.Context,
+	input dto.ChangeReviewerCmd,
+) (pr dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.AddReviewer",
+		attribute.String("pr.id", input.PullRequestID.String()),
+		attribute.String("reviewer.id", input.UserID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.AddReviewer(ctx, input)
+}
+
+func (s *tracedPullRequestService) RemoveReviewer(
+	ctx context.Context,
+	input dto.ChangeReviewerCmd,
+) (pr dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.RemoveReviewer",
+		attribute.String("pr.id", input.PullRequestID.String()),
+		attribute.String("reviewer.id", input.UserID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.RemoveReviewer(ctx, input)
+}
+
+func (s *tracedPullRequestService) FillReviewers(
+	ctx context.Context,
+) (filled []dto.FilledDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestService.FillReviewers")
+	defer func() { endSpan(span, err) }()
+
+	return s.next.FillReviewers(ctx)
+}
+
+func (s *tracedPullRequestService) FillTeamReviewers(
+	ctx context.Context,
+	teamID entities.TeamID,
+) (filled []dto.FilledDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.FillTeamReviewers",
+		attribute.Int("team.id", int(teamID)),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.FillTeamReviewers(ctx, teamID)
+}
+
+func (s *tracedPullRequestService) RecordVerdict(
+	ctx context.Context,
+	input dto.RecordVerdictCmd,
+) (pr dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.RecordVerdict",
+		attribute.String("pr.id", input.PullRequestID.String()),
+		attribute.String("reviewer.id", input.UserID.String()),
+		attribute.String("review.verdict", string(input.Verdict)),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.RecordVerdict(ctx, input)
+}
+
+func (s *tracedPullRequestService) PreviewAssignment(
+	ctx context.Context,
+	authorID entities.UserID,
+) (preview dto.AssignmentPreviewDTO, err error) {
+	ctx, span := startSpan(
+		ctx,
+		"PullRequestService.PreviewAssignment",
+		attribute.String("pr.author_id", authorID.String()),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.next.PreviewAssignment(ctx, authorID)
+}
@@ -0,0 +1,72 @@
+package logging
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestID reuses an incoming X-Request-ID or generates one, and echoes
+// it back in the response
+func RequestID() echo.MiddlewareFunc {
+	return middleware.RequestID()
+}
+
+// Middleware attaches a logger tagged with the request ID (and trace ID when
+// tracing is on) to the request context and logs every completed request.
+// Must run after RequestID.
+func Middleware(logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			req := c.Request()
+
+			reqLogger := logger.With(
+				"request_id", c.Response().Header().Get(echo.HeaderXRequestID),
+			)
+			spanCtx := trace.SpanContextFromContext(req.Context())
+			if spanCtx.HasTraceID() {
+				reqLogger = reqLogger.With("trace_id", spanCtx.TraceID().String())
+			}
+
+			c.SetRequest(req.WithContext(WithLogger(req.Context(), reqLogger)))
+
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					status = httpErr.Code
+				} else {
+					status = http.StatusInternalServerError
+				}
+			}
+
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+
+			attrs := []any{
+				"method", req.Method,
+				"uri", req.RequestURI,
+				"route", c.Path(),
+				"status", status,
+				"latency_ms", time.Since(start).Milliseconds(),
+				"remote_ip", c.RealIP(),
+			}
+			if err != nil {
+				attrs = append(attrs, "error", err.Error())
+			}
+			reqLogger.Log(req.Context(), level, "request", attrs...)
+
+			return err
+		}
+	}
+}
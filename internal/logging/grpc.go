@@ -0,0 +1,61 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor is the gRPC counterpart of Middleware. The request
+// ID comes from the x-request-id metadata or is generated.
+func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+
+		requestID := rand.Text()
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if ids := md.Get("x-request-id"); len(ids) > 0 && ids[0] != "" {
+				requestID = ids[0]
+			}
+		}
+
+		reqLogger := logger.With("request_id", requestID)
+		spanCtx := trace.SpanContextFromContext(ctx)
+		if spanCtx.HasTraceID() {
+			reqLogger = reqLogger.With("trace_id", spanCtx.TraceID().String())
+		}
+
+		resp, err := handler(WithLogger(ctx, reqLogger), req)
+
+		code := status.Code(err)
+		level := slog.LevelInfo
+		switch code {
+		case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+			level = slog.LevelError
+		}
+
+		attrs := []any{
+			"method", info.FullMethod,
+			"code", code.String(),
+			"latency_ms", time.Since(start).Milliseconds(),
+		}
+		if err != nil {
+			attrs = append(attrs, "error", err.Error())
+		}
+		reqLogger.Log(ctx, level, "rpc", attrs...)
+
+		return resp, err
+	}
+}
@@ -0,0 +1,191 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	ds "github.com/Traunin/review-assigner/internal/domain/services"
+	"github.com/Traunin/review-assigner/internal/logging"
+)
+
+type notifyingAssignmentService struct {
+	next     ds.ReviewerAssignmentService
+	prs      repositories.PullRequestRepository
+	notifier Notifier
+}
+
+// NotifyAssignment tells reviewers when they are assigned, replaced or the
+// pull request they review is merged. Failing to notify never fails the call.
+func NotifyAssignment(
+	next ds.ReviewerAssignmentService,
+	prs repositories.PullRequestRepository,
+	notifier Notifier,
+) ds.ReviewerAssignmentService {
+	return &notifyingAssignmentService{
+		next:     next,
+		prs:      prs,
+		notifier: notifier,
+	}
+}
+
+func (s *notifyingAssignmentService) CreateAndAssign(
+	ctx context.Context,
+	pr *entities.PullRequest,
+) (*entities.PullRequest, error) {
+	created, err := s.next.CreateAndAssign(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range created.ReviewerIDs() {
+		s.send(ctx, assignedMessage(created, id))
+	}
+
+	return created, nil
+}
+
+func (s *notifyingAssignmentService) ReassignReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	oldReviewerID entities.UserID,
+	newReviewerID entities.UserID,
+) (entities.UserID, *entities.PullRequest, error) {
+	assigned, pr, err := s.next.ReassignReviewer(ctx, prID, oldReviewerID, newReviewerID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	s.send(ctx, assignedMessage(pr, assigned))
+	s.send(ctx, unassignedMessage(pr, oldReviewerID, assigned))
+
+	return assigned, pr, nil
+}
+
+func (s *notifyingAssignmentService) AddReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (*entities.PullRequest, error) {
+	pr, err := s.next.AddReviewer(ctx, prID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.send(ctx, assignedMessage(pr, reviewerID))
+
+	return pr, nil
+}
+
+func (s *notifyingAssignmentService) RemoveReviewer(
+	ctx context.Context,
+	prID entities.PullRequestID,
+	reviewerID entities.UserID,
+) (*entities.PullRequest, error) {
+	pr, err := s.next.RemoveReviewer(ctx, prID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.send(ctx, removedMessage(pr, reviewerID))
+
+	return pr, nil
+}
+
+func (s *notifyingAssignmentService) FillReviewers(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) ([]entities.UserID, *entities.PullRequest, error) {
+	added, pr, err := s.next.FillReviewers(ctx, prID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, id := range added {
+		s.send(ctx, assignedMessage(pr, id))
+	}
+
+	return added, pr, nil
+}
+
+func (s *notifyingAssignmentService) Merge(
+	ctx context.Context,
+	prID entities.PullRequestID,
+) (*entities.PullRequest, error) {
+	// merge is idempotent, only the call that actually merges notifies
+	before, err := s.prs.FindByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := s.next.Merge(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil && !before.IsMerged() {
+		for _, id := range pr.ReviewerIDs() {
+			s.send(ctx, mergedMessage(pr, id))
+		}
+	}
+
+	return pr, nil
+}
+
+func (s *notifyingAssignmentService) Preview(
+	ctx context.Context,
+	authorID entities.UserID,
+) (*entities.AssignmentPreview, error) {
+	return s.next.Preview(ctx, authorID)
+}
+
+func (s *notifyingAssignmentService) send(ctx context.Context, msg Message) {
+	if err := s.notifier.Notify(ctx, msg); err != nil {
+		logging.FromContext(ctx).Warn("failed to queue notification",
+			"user_id", msg.UserID,
+			"subject", msg.Subject,
+			"error", err,
+		)
+	}
+}
+
+func assignedMessage(pr *entities.PullRequest, reviewerID entities.UserID) Message {
+	return Message{
+		UserID:   reviewerID,
+		Subject:  fmt.Sprintf("Review requested: %s", pr.Name()),
+		Text:     fmt.Sprintf("You were assigned to review %s (%s) by %s.", pr.Name(), pr.ID(), pr.AuthorID()),
+		Markdown: fmt.Sprintf("You were assigned to review **%s** (`%s`) by %s.", pr.Name(), pr.ID(), pr.AuthorID()),
+	}
+}
+
+func unassignedMessage(
+	pr *entities.PullRequest,
+	oldReviewerID entities.UserID,
+	newReviewerID entities.UserID,
+) Message {
+	return Message{
+		UserID:   oldReviewerID,
+		Subject:  fmt.Sprintf("Review reassigned: %s", pr.Name()),
+		Text:     fmt.Sprintf("Your review of %s (%s) was handed to %s.", pr.Name(), pr.ID(), newReviewerID),
+		Markdown: fmt.Sprintf("Your review of **%s** (`%s`) was handed to %s.", pr.Name(), pr.ID(), newReviewerID),
+	}
+}
+
+func removedMessage(pr *entities.PullRequest, reviewerID entities.UserID) Message {
+	return Message{
+		UserID:   reviewerID,
+		Subject:  fmt.Sprintf("Review removed: %s", pr.Name()),
+		Text:     fmt.Sprintf("You no longer need to review %s (%s).", pr.Name(), pr.ID()),
+		Markdown: fmt.Sprintf("You no longer need to review **%s** (`%s`).", pr.Name(), pr.ID()),
+	}
+}
+
+func mergedMessage(pr *entities.PullRequest, reviewerID entities.UserID) Message {
+	return Message{
+		UserID:   reviewerID,
+		Subject:  fmt.Sprintf("Merged: %s", pr.Name()),
+		Text:     fmt.Sprintf("%s (%s), which you were reviewing, was merged.", pr.Name(), pr.ID()),
+		Markdown: fmt.Sprintf("**%s** (`%s`), which you were reviewing, was merged.", pr.Name(), pr.ID()),
+	}
+}
@@ -0,0 +1,63 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type chatNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewChatNotifier posts to Slack or Mattermost incoming webhooks, both
+// accept {"text": ...} with markdown. A message's Address overrides url,
+// e.g. to reach a user's direct-message webhook.
+func NewChatNotifier(url string) Notifier {
+	return &chatNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (n *chatNotifier) Notify(ctx context.Context, msg Message) error {
+	url := msg.Address
+	if url == "" {
+		url = n.url
+	}
+	if url == "" {
+		return fmt.Errorf("chat: no webhook for user %s", msg.UserID)
+	}
+
+	text := msg.Markdown
+	if text == "" {
+		text = msg.Text
+	}
+	body, err := json.Marshal(map[string]string{
+		"text": fmt.Sprintf("*%s*\n%s", msg.Subject, text),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("chat: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("chat: unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+type fileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileNotifier appends messages to a file, handy for local runs
+func NewFileNotifier(path string) Notifier {
+	return &fileNotifier{path: path}
+}
+
+func (n *fileNotifier) Notify(ctx context.Context, msg Message) error {
+	body := msg.Markdown
+	if body == "" {
+		body = msg.Text
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(f, "To: %s\nSubject: %s\nDate: %s\n\n%s\n---\n",
+		msg.UserID,
+		msg.Subject,
+		time.Now().Format(time.RFC3339),
+		body,
+	)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
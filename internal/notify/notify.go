@@ -0,0 +1,44 @@
+// Package notify delivers messages to users. A Router picks the channels
+// from each user's preferences, every channel sits behind its own Queue so
+// slow or failing deliveries are retried off the request path.
+package notify
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type Message struct {
+	UserID  entities.UserID
+	Subject string
+	Text    string
+	// optional richer bodies, channels fall back to Text without them
+	Markdown string
+	HTML     string
+	// channel specific destination, e.g. an email address, empty uses the
+	// channel's default
+	Address string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+type logNotifier struct {
+	logger *slog.Logger
+}
+
+// NewLogNotifier only logs that a message would have been sent
+func NewLogNotifier(logger *slog.Logger) Notifier {
+	return &logNotifier{logger: logger}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, msg Message) error {
+	n.logger.InfoContext(ctx, "notification",
+		"user_id", msg.UserID,
+		"subject", msg.Subject,
+	)
+	return nil
+}
@@ -0,0 +1,147 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+var (
+	ErrQueueFull   = errors.New("notification queue is full")
+	ErrQueueClosed = errors.New("notification queue is closed")
+)
+
+type QueueConfig struct {
+	Workers int
+	Size    int
+	// attempts per message including the first one
+	MaxAttempts int
+	// delay before the first retry, doubled on every further one
+	Backoff time.Duration
+}
+
+type job struct {
+	ctx context.Context
+	msg Message
+}
+
+// Queue delivers through next on background workers and retries failures
+// with exponential backoff. Notify only enqueues.
+type Queue struct {
+	name   string
+	next   Notifier
+	cfg    QueueConfig
+	logger *slog.Logger
+
+	jobs chan job
+	// closed by Close, aborts backoff sleeps
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+	mu       sync.RWMutex
+	closed   bool
+}
+
+func NewQueue(name string, next Notifier, cfg QueueConfig, logger *slog.Logger) *Queue {
+	q := &Queue{
+		name:   name,
+		next:   next,
+		cfg:    cfg,
+		logger: logger,
+		jobs:   make(chan job, cfg.Size),
+		stop:   make(chan struct{}),
+	}
+
+	for range cfg.Workers {
+		q.wg.Add(1)
+		go q.work()
+	}
+
+	return q
+}
+
+func (q *Queue) Notify(ctx context.Context, msg Message) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	// the request may be over long before the message goes out, keep its
+	// values (org, request id) but not its deadline
+	select {
+	case q.jobs <- job{ctx: context.WithoutCancel(ctx), msg: msg}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Close stops accepting messages and waits for queued ones until ctx is
+// done, retries still pending then are dropped
+func (q *Queue) Close(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.jobs)
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		q.stopOnce.Do(func() { close(q.stop) })
+		return ctx.Err()
+	}
+}
+
+func (q *Queue) work() {
+	defer q.wg.Done()
+	for j := range q.jobs {
+		q.deliver(j)
+	}
+}
+
+func (q *Queue) deliver(j job) {
+	backoff := q.cfg.Backoff
+	for attempt := 1; ; attempt++ {
+		err := q.next.Notify(j.ctx, j.msg)
+		if err == nil {
+			return
+		}
+
+		if attempt >= q.cfg.MaxAttempts {
+			q.logger.Error("notification dropped",
+				"channel", q.name,
+				"user_id", j.msg.UserID,
+				"subject", j.msg.Subject,
+				"attempts", attempt,
+				"error", err,
+			)
+			return
+		}
+
+		q.logger.Warn("notification failed, retrying",
+			"channel", q.name,
+			"user_id", j.msg.UserID,
+			"attempt", attempt,
+			"retry_in", backoff,
+			"error", err,
+		)
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-q.stop:
+			return
+		}
+	}
+}
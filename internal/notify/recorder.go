@@ -0,0 +1,37 @@
+package notify
+
+import (
+	"context"
+	"sync"
+)
+
+type nop struct{}
+
+// Nop drops every message
+func Nop() Notifier {
+	return nop{}
+}
+
+func (nop) Notify(context.Context, Message) error {
+	return nil
+}
+
+// Recorder keeps every message it gets, for tests and local runs
+type Recorder struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func (r *Recorder) Notify(_ context.Context, msg Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, msg)
+	return nil
+}
+
+// Messages returns a copy of what was recorded so far
+func (r *Recorder) Messages() []Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Message(nil), r.messages...)
+}
@@ -0,0 +1,61 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+)
+
+// Router sends each message over the channels its recipient enabled, or
+// through the fallback when they never set any preference
+type Router struct {
+	prefs    repositories.NotificationPreferenceRepository
+	channels map[entities.NotificationChannel]Notifier
+	fallback Notifier
+}
+
+func NewRouter(
+	prefs repositories.NotificationPreferenceRepository,
+	channels map[entities.NotificationChannel]Notifier,
+	fallback Notifier,
+) *Router {
+	return &Router{
+		prefs:    prefs,
+		channels: channels,
+		fallback: fallback,
+	}
+}
+
+func (r *Router) Notify(ctx context.Context, msg Message) error {
+	prefs, err := r.prefs.FindByUserID(ctx, msg.UserID)
+	if err != nil {
+		return err
+	}
+	if len(prefs) == 0 {
+		return r.fallback.Notify(ctx, msg)
+	}
+
+	var errs []error
+	for _, pref := range prefs {
+		if !pref.Enabled {
+			continue
+		}
+
+		channel, ok := r.channels[pref.Channel]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s channel is not configured", pref.Channel))
+			continue
+		}
+
+		routed := msg
+		routed.Address = pref.Address
+		if err := channel.Notify(ctx, routed); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", pref.Channel, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
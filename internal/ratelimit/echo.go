@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/api/auth"
+	"github.com/Traunin/review-assigner/internal/logging"
+	"github.com/labstack/echo/v4"
+)
+
+// Middleware limits requests per client. Clients are told apart by their
+// API token, or by IP when the request has none. Routes are keyed as
+// "METHOD /path" with the path as registered, e.g. "GET /stats/reviewers".
+// Store errors are logged and let the request through.
+func Middleware(
+	store Store,
+	defaultLimit Limit,
+	routes map[string]Limit,
+) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			route := c.Request().Method + " " + c.Path()
+			limit, ok := routes[route]
+			if !ok {
+				limit = defaultLimit
+				route = "*"
+			}
+
+			key := clientKey(c) + "|" + route
+			decision, err := store.Take(ctx, key, limit)
+			if err != nil {
+				logging.FromContext(ctx).Error("rate limit store failed", "error", err)
+				return next(c)
+			}
+			if decision.Allowed {
+				return next(c)
+			}
+			return reject(c, decision)
+		}
+	}
+}
+
+// IPMiddleware limits every request per IP with one bucket for all routes.
+// It goes before authentication: requests it rejects never look up their
+// token, so a flood of invalid tokens can't reach the database at the rate
+// it's sent.
+func IPMiddleware(store Store, limit Limit) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			// apart from the "ip:" keys of Middleware, they take from
+			// their own limit
+			decision, err := store.Take(ctx, "pre-auth:"+c.RealIP(), limit)
+			if err != nil {
+				logging.FromContext(ctx).Error("rate limit store failed", "error", err)
+				return next(c)
+			}
+			if decision.Allowed {
+				return next(c)
+			}
+			return reject(c, decision)
+		}
+	}
+}
+
+// reject answers 429 with Retry-After rounded up to whole seconds, at least
+// one so clients don't retry at once
+func reject(c echo.Context, decision Decision) error {
+	retryAfter := int(math.Ceil(decision.RetryAfter.Seconds()))
+	retryAfter = max(retryAfter, 1)
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(retryAfter))
+	return c.JSON(http.StatusTooManyRequests, map[string]any{
+		"error": map[string]string{
+			"code":    "RATE_LIMITED",
+			"message": fmt.Sprintf("rate limit exceeded, retry in %s", time.Duration(retryAfter)*time.Second),
+		},
+	})
+}
+
+func clientKey(c echo.Context) string {
+	if token := auth.FromContext(c.Request().Context()); token != nil {
+		return "token:" + strconv.Itoa(int(token.ID()))
+	}
+	return "ip:" + c.RealIP()
+}
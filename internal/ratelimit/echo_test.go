@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TestIPMiddlewareBeforeAuth checks that requests over the per-IP limit are
+// rejected before the next middleware, where the token would be looked up
+func TestIPMiddlewareBeforeAuth(t *testing.T) {
+	store, _ := newTestStore()
+	lookups := 0
+
+	e := echo.New()
+	e.IPExtractor = echo.ExtractIPDirect()
+	e.Use(IPMiddleware(store, Limit{Rate: 1, Burst: 2}))
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			lookups++
+			return c.NoContent(http.StatusUnauthorized)
+		}
+	})
+	e.GET("/team/get", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	request := func(ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+		req.RemoteAddr = ip + ":1234"
+		req.Header.Set(echo.HeaderAuthorization, "Bearer invalid")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for range 2 {
+		if rec := request("10.0.0.1"); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("request within burst: %d", rec.Code)
+		}
+	}
+	rec := request("10.0.0.1")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over the limit: %d, want 429", rec.Code)
+	}
+	if rec.Header().Get(echo.HeaderRetryAfter) != "1" {
+		t.Errorf("Retry-After %q, want 1", rec.Header().Get(echo.HeaderRetryAfter))
+	}
+	if lookups != 2 {
+		t.Errorf("%d token lookups, want 2", lookups)
+	}
+
+	// other clients have their own bucket
+	if rec := request("10.0.0.2"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("other IP: %d", rec.Code)
+	}
+}
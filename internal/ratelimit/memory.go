@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// how often full buckets are dropped
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	limit  Limit
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (s *memoryStore) Take(
+	_ context.Context,
+	key string,
+	limit Limit,
+) (Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now, limit: limit}
+		s.buckets[key] = b
+	}
+
+	elapsed := now.Sub(b.last).Seconds()
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+	b.last = now
+	b.limit = limit
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return Decision{Allowed: true}, nil
+	}
+
+	wait := (1 - b.tokens) / limit.Rate
+	return Decision{RetryAfter: time.Duration(wait * float64(time.Second))}, nil
+}
+
+// sweep drops buckets that have refilled completely, they behave exactly
+// like missing ones
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		refilled := b.tokens + now.Sub(b.last).Seconds()*b.limit.Rate
+		if refilled >= float64(b.limit.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeClock is the memory store's clock, moved by hand
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestStore() (*memoryStore, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore().(*memoryStore)
+	store.now = clock.Now
+	return store, clock
+}
+
+func take(t *testing.T, s Store, key string, limit Limit) Decision {
+	t.Helper()
+	d, err := s.Take(context.Background(), key, limit)
+	if err != nil {
+		t.Fatalf("Take: %v", err)
+	}
+	return d
+}
+
+func TestMemoryStoreBurst(t *testing.T) {
+	store, _ := newTestStore()
+	limit := Limit{Rate: 1, Burst: 3}
+
+	for i := range limit.Burst {
+		if d := take(t, store, "a", limit); !d.Allowed {
+			t.Fatalf("request %d within burst rejected", i+1)
+		}
+	}
+
+	d := take(t, store, "a", limit)
+	if d.Allowed {
+		t.Fatal("request past burst allowed")
+	}
+	if d.RetryAfter != time.Second {
+		t.Errorf("RetryAfter = %v, want 1s", d.RetryAfter)
+	}
+}
+
+func TestMemoryStoreRefill(t *testing.T) {
+	store, clock := newTestStore()
+	limit := Limit{Rate: 2, Burst: 2}
+
+	take(t, store, "a", limit)
+	take(t, store, "a", limit)
+
+	tests := []struct {
+		name    string
+		advance time.Duration
+		allowed bool
+		retry   time.Duration
+	}{
+		{"empty", 0, false, 500 * time.Millisecond},
+		{"half a token", 250 * time.Millisecond, false, 250 * time.Millisecond},
+		{"one token", 250 * time.Millisecond, true, 0},
+		{"spent again", 0, false, 500 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		clock.Advance(tt.advance)
+		d := take(t, store, "a", limit)
+		if d.Allowed != tt.allowed || d.RetryAfter != tt.retry {
+			t.Errorf("%s: got %+v, want allowed %t retry %v", tt.name, d, tt.allowed, tt.retry)
+		}
+	}
+}
+
+func TestMemoryStoreRefillCapsAtBurst(t *testing.T) {
+	store, clock := newTestStore()
+	limit := Limit{Rate: 10, Burst: 2}
+
+	take(t, store, "a", limit)
+	clock.Advance(time.Hour)
+
+	allowed := 0
+	for range 5 {
+		if take(t, store, "a", limit).Allowed {
+			allowed++
+		}
+	}
+	if allowed != limit.Burst {
+		t.Errorf("allowed %d requests after a long idle, want burst %d", allowed, limit.Burst)
+	}
+}
+
+func TestMemoryStoreKeysAreIndependent(t *testing.T) {
+	store, _ := newTestStore()
+	limit := Limit{Rate: 1, Burst: 1}
+
+	take(t, store, "a", limit)
+	if take(t, store, "a", limit).Allowed {
+		t.Fatal("second request for a allowed")
+	}
+	if !take(t, store, "b", limit).Allowed {
+		t.Error("first request for b rejected")
+	}
+}
+
+func TestMemoryStoreSweepsFullBuckets(t *testing.T) {
+	store, clock := newTestStore()
+	limit := Limit{Rate: 1, Burst: 5}
+
+	take(t, store, "idle", limit)
+	clock.Advance(sweepInterval)
+	for range 5 {
+		take(t, store, "busy", limit)
+	}
+
+	if _, ok := store.buckets["idle"]; ok {
+		t.Error("refilled bucket survived the sweep")
+	}
+	if _, ok := store.buckets["busy"]; !ok {
+		t.Error("bucket in use was swept")
+	}
+}
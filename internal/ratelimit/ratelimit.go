@@ -0,0 +1,28 @@
+// Package ratelimit implements per-client token buckets. Every client gets
+// a bucket per route that has its own limit and one shared bucket for the
+// remaining routes.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit refills Rate tokens per second up to Burst, each request takes one
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Decision is the outcome of taking a token
+type Decision struct {
+	Allowed bool
+	// RetryAfter is how long until the next token, zero when allowed
+	RetryAfter time.Duration
+}
+
+// Store keeps the buckets. The memory store is per process, the postgres
+// one is shared by all replicas.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Decision, error)
+}
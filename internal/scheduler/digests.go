@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+)
+
+// Digests sends every organization its daily review digest once the
+// configured time of day (UTC) has passed
+type Digests struct {
+	// offset from midnight UTC
+	sendAt  time.Duration
+	orgs    repositories.OrganizationRepository
+	runs    repositories.DigestRepository
+	digests services.DigestService
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+func NewDigests(
+	sendAt time.Duration,
+	orgs repositories.OrganizationRepository,
+	runs repositories.DigestRepository,
+	digests services.DigestService,
+	logger *slog.Logger,
+) *Digests {
+	return &Digests{
+		sendAt:  sendAt,
+		orgs:    orgs,
+		runs:    runs,
+		digests: digests,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+func (d *Digests) Job() Job {
+	return Job{
+		Name:    "daily_digest",
+		LockKey: 0x7265766965770002,
+		Run: func(ctx context.Context) error {
+			now := d.now().UTC()
+			day := now.Truncate(24 * time.Hour)
+			if now.Sub(day) < d.sendAt {
+				return nil
+			}
+			return forEachOrg(ctx, d.orgs, d.logger, func(ctx context.Context, orgID entities.OrgID) error {
+				return d.sendOrg(ctx, orgID, day)
+			})
+		},
+	}
+}
+
+func (d *Digests) sendOrg(ctx context.Context, orgID entities.OrgID, day time.Time) error {
+	// claimed before sending: a crash mid-run skips the rest of the day
+	// rather than sending someone the digest twice
+	claimed, err := d.runs.ClaimRun(ctx, day)
+	if err != nil || !claimed {
+		return err
+	}
+
+	sent, err := d.digests.SendAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Info("daily digest sent", "org_id", orgID, "day", day.Format(time.DateOnly), "users", sent)
+	return nil
+}
@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/notify"
+)
+
+type EventKind string
+
+const (
+	// EventReminder nudges a reviewer who hasn't given a verdict in time
+	EventReminder EventKind = "review_reminder"
+	// EventReassigned reports a review taken from an unresponsive reviewer
+	EventReassigned EventKind = "review_reassigned"
+)
+
+type Event struct {
+	Kind            EventKind
+	OrgID           entities.OrgID
+	PullRequestID   entities.PullRequestID
+	PullRequestName string
+	AuthorID        entities.UserID
+	ReviewerID      entities.UserID
+	// set on EventReassigned
+	NewReviewerID entities.UserID
+	TeamName      string
+	Waiting       time.Duration
+}
+
+// Sink receives the events the scheduler emits
+type Sink interface {
+	Emit(ctx context.Context, event Event) error
+}
+
+type notifySink struct {
+	notifier notify.Notifier
+}
+
+// NewNotifySink sends reminders to the reviewer. Reassignments need nothing
+// here, the assignment service already tells both reviewers about them.
+func NewNotifySink(notifier notify.Notifier) Sink {
+	return &notifySink{notifier: notifier}
+}
+
+func (s *notifySink) Emit(ctx context.Context, event Event) error {
+	if event.Kind != EventReminder {
+		return nil
+	}
+
+	waiting := event.Waiting.Round(time.Hour).String()
+	return s.notifier.Notify(ctx, notify.Message{
+		UserID:  event.ReviewerID,
+		Subject: fmt.Sprintf("Reminder: %s is waiting for your review", event.PullRequestName),
+		Text: fmt.Sprintf("%s (%s) by %s has been waiting for your verdict for %s.",
+			event.PullRequestName, event.PullRequestID, event.AuthorID, waiting),
+		Markdown: fmt.Sprintf("**%s** (`%s`) by %s has been waiting for your verdict for %s.",
+			event.PullRequestName, event.PullRequestID, event.AuthorID, waiting),
+	})
+}
@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	domainservices "github.com/Traunin/review-assigner/internal/domain/services"
+)
+
+// FillReviewers tops up open pull requests that got fewer reviewers than
+// the policy asks for, once their team has candidates again
+type FillReviewers struct {
+	orgs      repositories.OrganizationRepository
+	prService services.PullRequestService
+	logger    *slog.Logger
+}
+
+func NewFillReviewers(
+	orgs repositories.OrganizationRepository,
+	prService services.PullRequestService,
+	logger *slog.Logger,
+) *FillReviewers {
+	return &FillReviewers{
+		orgs:      orgs,
+		prService: prService,
+		logger:    logger,
+	}
+}
+
+func (f *FillReviewers) Job() Job {
+	return Job{
+		Name:    "fill_reviewers",
+		LockKey: 0x7265766965770003,
+		Run: func(ctx context.Context) error {
+			ctx = domainservices.WithActor(ctx, "job:fill_reviewers")
+			return forEachOrg(ctx, f.orgs, f.logger, f.fillOrg)
+		},
+	}
+}
+
+func (f *FillReviewers) fillOrg(ctx context.Context, orgID entities.OrgID) error {
+	filled, err := f.prService.FillReviewers(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(filled) > 0 {
+		f.logger.Info("under-staffed pull requests filled",
+			"org_id", orgID,
+			"pull_requests", len(filled),
+		)
+	}
+	return nil
+}
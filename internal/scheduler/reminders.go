@@ -0,0 +1,125 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	domainservices "github.com/Traunin/review-assigner/internal/domain/services"
+)
+
+// Reminders nudges reviewers who sit on a review past their team's SLA and
+// hands the review to someone else once the second threshold passes
+type Reminders struct {
+	defaults  entities.ReviewSLA
+	orgs      repositories.OrganizationRepository
+	reminders repositories.ReviewReminderRepository
+	prService services.PullRequestService
+	sink      Sink
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+func NewReminders(
+	defaults entities.ReviewSLA,
+	orgs repositories.OrganizationRepository,
+	reminders repositories.ReviewReminderRepository,
+	prService services.PullRequestService,
+	sink Sink,
+	logger *slog.Logger,
+) *Reminders {
+	return &Reminders{
+		defaults:  defaults,
+		orgs:      orgs,
+		reminders: reminders,
+		prService: prService,
+		sink:      sink,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+func (r *Reminders) Job() Job {
+	return Job{
+		Name:    "review_reminders",
+		LockKey: 0x7265766965770001,
+		Run: func(ctx context.Context) error {
+			return forEachOrg(ctx, r.orgs, r.logger, r.remindOrg)
+		},
+	}
+}
+
+func (r *Reminders) remindOrg(ctx context.Context, orgID entities.OrgID) error {
+	overdue, err := r.reminders.FindOverdue(ctx, r.defaults)
+	if err != nil {
+		return err
+	}
+
+	now := r.now()
+	for _, review := range overdue {
+		event := Event{
+			OrgID:           orgID,
+			PullRequestID:   review.PullRequestID,
+			PullRequestName: review.PullRequestName,
+			AuthorID:        review.AuthorID,
+			ReviewerID:      review.ReviewerID,
+			TeamName:        review.TeamName,
+			Waiting:         review.Waiting(now),
+		}
+
+		switch {
+		case review.NeedsReassign(now):
+			jobCtx := domainservices.WithActor(ctx, "job:review_reminders")
+			result, err := r.prService.ReassignReviewer(jobCtx, dto.ReassignReviewerCmd{
+				PullRequestID: review.PullRequestID,
+				OldUserID:     review.ReviewerID,
+			})
+			if errors.Is(err, domainservices.ErrNoCandidate) {
+				r.logger.Warn("overdue review has no replacement",
+					"org_id", orgID,
+					"pr_id", review.PullRequestID,
+					"reviewer_id", review.ReviewerID,
+				)
+				continue
+			}
+			if errors.Is(err, domainservices.ErrPRAlreadyMerged) ||
+				errors.Is(err, domainservices.ErrUserNotReviewer) {
+				// merged or reassigned since the query ran
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			event.Kind = EventReassigned
+			event.NewReviewerID = result.Assigned
+			r.emit(ctx, event)
+
+		case review.NeedsReminder(now):
+			if err := r.reminders.MarkReminded(ctx, review.PullRequestID, review.ReviewerID, now); err != nil {
+				return err
+			}
+
+			event.Kind = EventReminder
+			r.emit(ctx, event)
+		}
+	}
+
+	return nil
+}
+
+// delivery failures are logged, the job moves on to the next review
+func (r *Reminders) emit(ctx context.Context, event Event) {
+	if err := r.sink.Emit(ctx, event); err != nil {
+		r.logger.Error("failed to emit review event",
+			"kind", event.Kind,
+			"pr_id", event.PullRequestID,
+			"error", err,
+		)
+	}
+}
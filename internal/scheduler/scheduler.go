@@ -0,0 +1,99 @@
+// Package scheduler runs periodic background jobs. Every replica runs the
+// loop, a Postgres advisory lock per job makes sure only one of them does
+// the work on each tick.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	"github.com/Traunin/review-assigner/internal/domain/repositories"
+	"github.com/Traunin/review-assigner/internal/tenant"
+)
+
+// Locker runs fn only if no other replica holds key
+type Locker interface {
+	TryAdvisoryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error)
+}
+
+type Job struct {
+	Name string
+	// advisory lock key, unique per job
+	LockKey int64
+	Run     func(ctx context.Context) error
+}
+
+type Scheduler struct {
+	interval time.Duration
+	locker   Locker
+	logger   *slog.Logger
+	jobs     []Job
+}
+
+func New(interval time.Duration, locker Locker, logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		interval: interval,
+		locker:   locker,
+		logger:   logger,
+	}
+}
+
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Run ticks until ctx is cancelled
+func (s *Scheduler) Run(ctx context.Context) {
+	names := make([]string, len(s.jobs))
+	for i, job := range s.jobs {
+		names[i] = job.Name
+	}
+	s.logger.Info("scheduler started", "interval", s.interval, "jobs", names)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, job := range s.jobs {
+				s.runJob(ctx, job)
+			}
+		}
+	}
+}
+
+func (s *Scheduler) runJob(ctx context.Context, job Job) {
+	ran, err := s.locker.TryAdvisoryLock(ctx, job.LockKey, job.Run)
+	if err != nil {
+		s.logger.Error("job failed", "job", job.Name, "error", err)
+	} else if !ran {
+		s.logger.Debug("job is running on another replica", "job", job.Name)
+	}
+}
+
+// forEachOrg runs fn scoped to every organization, one broken organization
+// doesn't starve the others
+func forEachOrg(
+	ctx context.Context,
+	orgs repositories.OrganizationRepository,
+	logger *slog.Logger,
+	fn func(ctx context.Context, orgID entities.OrgID) error,
+) error {
+	all, err := orgs.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, org := range all {
+		if err := fn(tenant.WithOrg(ctx, org.ID()), org.ID()); err != nil {
+			logger.Error("job failed for organization", "org_id", org.ID(), "error", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+// Package tenant carries the organization a request acts in. Repositories
+// read it from the context, so every query is scoped without threading the
+// id through the services.
+package tenant
+
+import (
+	"context"
+
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+)
+
+type ctxKey struct{}
+
+// WithOrg returns a context scoped to the organization
+func WithOrg(ctx context.Context, id entities.OrgID) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// OrgID returns the organization of the context, the default one when
+// nothing set it, e.g. in CLI commands
+func OrgID(ctx context.Context) entities.OrgID {
+	if id, ok := ctx.Value(ctxKey{}).(entities.OrgID); ok {
+		return id
+	}
+	return entities.DefaultOrgID
+}
@@ -1,6 +1,6 @@
-DROP TYPE IF EXISTS pr_status;
-
-DROP TABLE IF EXISTS teams;
-DROP TABLE IF EXISTS users;
-DROP TABLE IF EXISTS pull_requests;
 DROP TABLE IF EXISTS reviewers;
+DROP TABLE IF EXISTS pull_requests;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS teams;
+
+DROP TYPE IF EXISTS pr_status;
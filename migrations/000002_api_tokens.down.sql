@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS api_tokens;
+DROP TYPE IF EXISTS token_role;
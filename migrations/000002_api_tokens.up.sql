@@ -0,0 +1,13 @@
+CREATE TYPE token_role AS ENUM ('admin', 'bot', 'member');
+
+CREATE TABLE api_tokens (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    role token_role NOT NULL,
+    user_id VARCHAR(255) NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE NULL,
+    CONSTRAINT check_member_user CHECK (role <> 'member' OR user_id IS NOT NULL)
+);
+CREATE INDEX api_tokens_user_id_index ON api_tokens (user_id);
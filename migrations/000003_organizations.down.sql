@@ -0,0 +1,53 @@
+-- only the default organization survives, ids of other orgs may collide
+DELETE FROM organizations WHERE id <> 1;
+
+DROP INDEX IF EXISTS api_tokens_user_id_index;
+ALTER TABLE api_tokens DROP CONSTRAINT IF EXISTS check_user_org;
+ALTER TABLE api_tokens DROP CONSTRAINT IF EXISTS api_tokens_user_id_fkey;
+ALTER TABLE api_tokens DROP COLUMN IF EXISTS org_id;
+
+DROP INDEX IF EXISTS reviewers_user_id_index;
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS reviewers_user_id_fkey;
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS reviewers_pull_request_id_fkey;
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS reviewers_pkey;
+
+DROP INDEX IF EXISTS pull_requests_author_id_index;
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_author_id_fkey;
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_pkey;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_team_id_fkey;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_pkey;
+
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_org_id_id_key;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_org_id_team_name_key;
+
+ALTER TABLE reviewers DROP COLUMN IF EXISTS org_id;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS org_id;
+ALTER TABLE users DROP COLUMN IF EXISTS org_id;
+ALTER TABLE teams DROP COLUMN IF EXISTS org_id;
+
+ALTER TABLE teams ADD CONSTRAINT teams_team_name_key UNIQUE (team_name);
+CREATE INDEX teams_team_name_index ON teams (team_name);
+
+ALTER TABLE users ADD PRIMARY KEY (user_id);
+ALTER TABLE users ADD CONSTRAINT users_team_id_fkey
+    FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE SET NULL;
+
+ALTER TABLE pull_requests ADD PRIMARY KEY (pull_request_id);
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_author_id_fkey
+    FOREIGN KEY (author_id) REFERENCES users (user_id) ON DELETE CASCADE;
+CREATE INDEX pull_requests_author_id_index ON pull_requests (author_id);
+
+ALTER TABLE reviewers ADD PRIMARY KEY (pull_request_id, user_id);
+ALTER TABLE reviewers ADD CONSTRAINT reviewers_pull_request_id_fkey
+    FOREIGN KEY (pull_request_id) REFERENCES pull_requests (pull_request_id) ON DELETE CASCADE;
+ALTER TABLE reviewers ADD CONSTRAINT reviewers_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE;
+CREATE INDEX reviewers_user_id_index ON reviewers (user_id);
+CREATE INDEX reviewers_pull_request_id_index ON reviewers (pull_request_id);
+
+ALTER TABLE api_tokens ADD CONSTRAINT api_tokens_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE;
+CREATE INDEX api_tokens_user_id_index ON api_tokens (user_id);
+
+DROP TABLE IF EXISTS organizations;
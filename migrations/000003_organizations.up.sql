@@ -0,0 +1,59 @@
+CREATE TABLE organizations (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) UNIQUE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- existing data moves to the default organization, it gets id 1
+INSERT INTO organizations (name) VALUES ('default');
+
+-- foreign keys are rebuilt on top of the composite keys below
+ALTER TABLE reviewers DROP CONSTRAINT reviewers_pull_request_id_fkey;
+ALTER TABLE reviewers DROP CONSTRAINT reviewers_user_id_fkey;
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_author_id_fkey;
+ALTER TABLE api_tokens DROP CONSTRAINT api_tokens_user_id_fkey;
+ALTER TABLE users DROP CONSTRAINT users_team_id_fkey;
+
+ALTER TABLE teams ADD COLUMN org_id INTEGER NOT NULL DEFAULT 1 REFERENCES organizations (id) ON DELETE CASCADE;
+ALTER TABLE teams ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE teams DROP CONSTRAINT teams_team_name_key;
+ALTER TABLE teams ADD CONSTRAINT teams_org_id_team_name_key UNIQUE (org_id, team_name);
+ALTER TABLE teams ADD CONSTRAINT teams_org_id_id_key UNIQUE (org_id, id);
+DROP INDEX teams_team_name_index;
+
+ALTER TABLE users ADD COLUMN org_id INTEGER NOT NULL DEFAULT 1 REFERENCES organizations (id) ON DELETE CASCADE;
+ALTER TABLE users ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE users DROP CONSTRAINT users_pkey;
+ALTER TABLE users ADD PRIMARY KEY (org_id, user_id);
+ALTER TABLE users ADD CONSTRAINT users_team_id_fkey
+    FOREIGN KEY (org_id, team_id) REFERENCES teams (org_id, id) ON DELETE SET NULL (team_id);
+
+ALTER TABLE pull_requests ADD COLUMN org_id INTEGER NOT NULL DEFAULT 1 REFERENCES organizations (id) ON DELETE CASCADE;
+ALTER TABLE pull_requests ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_pkey;
+ALTER TABLE pull_requests ADD PRIMARY KEY (org_id, pull_request_id);
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_author_id_fkey
+    FOREIGN KEY (org_id, author_id) REFERENCES users (org_id, user_id) ON DELETE CASCADE;
+DROP INDEX pull_requests_author_id_index;
+CREATE INDEX pull_requests_author_id_index ON pull_requests (org_id, author_id);
+
+ALTER TABLE reviewers ADD COLUMN org_id INTEGER NOT NULL DEFAULT 1 REFERENCES organizations (id) ON DELETE CASCADE;
+ALTER TABLE reviewers ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE reviewers DROP CONSTRAINT reviewers_pkey;
+ALTER TABLE reviewers ADD PRIMARY KEY (org_id, pull_request_id, user_id);
+ALTER TABLE reviewers ADD CONSTRAINT reviewers_pull_request_id_fkey
+    FOREIGN KEY (org_id, pull_request_id) REFERENCES pull_requests (org_id, pull_request_id) ON DELETE CASCADE;
+ALTER TABLE reviewers ADD CONSTRAINT reviewers_user_id_fkey
+    FOREIGN KEY (org_id, user_id) REFERENCES users (org_id, user_id) ON DELETE CASCADE;
+DROP INDEX reviewers_user_id_index;
+DROP INDEX reviewers_pull_request_id_index;
+CREATE INDEX reviewers_user_id_index ON reviewers (org_id, user_id);
+
+-- tokens without an organization are instance-wide and pick one per request
+ALTER TABLE api_tokens ADD COLUMN org_id INTEGER NULL REFERENCES organizations (id) ON DELETE CASCADE;
+UPDATE api_tokens SET org_id = 1 WHERE user_id IS NOT NULL;
+ALTER TABLE api_tokens ADD CONSTRAINT api_tokens_user_id_fkey
+    FOREIGN KEY (org_id, user_id) REFERENCES users (org_id, user_id) ON DELETE CASCADE;
+ALTER TABLE api_tokens ADD CONSTRAINT check_user_org CHECK (user_id IS NULL OR org_id IS NOT NULL);
+DROP INDEX api_tokens_user_id_index;
+CREATE INDEX api_tokens_user_id_index ON api_tokens (org_id, user_id);
@@ -0,0 +1,7 @@
+-- shared state for the postgres rate limit store, losing it on a crash only
+-- resets the limits, so the table skips the WAL
+CREATE UNLOGGED TABLE rate_limit_buckets (
+    bucket_key VARCHAR(512) PRIMARY KEY,
+    -- theoretical arrival time of the next request (GCRA form of a token bucket)
+    tat TIMESTAMP WITH TIME ZONE NOT NULL
+);
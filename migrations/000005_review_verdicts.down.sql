@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS pull_requests_created_at_index;
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS check_verdict_at;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS verdict_at;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS verdict;
+DROP TYPE IF EXISTS review_verdict;
@@ -0,0 +1,8 @@
+CREATE TYPE review_verdict AS ENUM ('APPROVED', 'CHANGES_REQUESTED');
+
+ALTER TABLE reviewers ADD COLUMN verdict review_verdict NULL;
+ALTER TABLE reviewers ADD COLUMN verdict_at TIMESTAMP WITH TIME ZONE NULL;
+ALTER TABLE reviewers ADD CONSTRAINT check_verdict_at CHECK ((verdict IS NULL) = (verdict_at IS NULL));
+
+-- SLA reports filter pull requests by creation time
+CREATE INDEX pull_requests_created_at_index ON pull_requests (org_id, created_at);
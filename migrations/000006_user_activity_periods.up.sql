@@ -0,0 +1,26 @@
+-- is_active only holds the current state, fairness reports need to know
+-- how long each user was available for review
+CREATE TABLE user_activity_periods (
+    org_id INTEGER NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    active_from TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    active_to TIMESTAMP WITH TIME ZONE NULL,
+    FOREIGN KEY (org_id, user_id) REFERENCES users (org_id, user_id) ON DELETE CASCADE,
+    CONSTRAINT check_active_period CHECK (active_to IS NULL OR active_to >= active_from)
+);
+
+CREATE INDEX user_activity_periods_user_index ON user_activity_periods (org_id, user_id, active_from);
+
+-- at most one open period per user
+CREATE UNIQUE INDEX user_activity_periods_open_index ON user_activity_periods (org_id, user_id)
+    WHERE active_to IS NULL;
+
+-- history before this migration is unknown, treat currently active users as
+-- active since the first pull request of their organization
+INSERT INTO user_activity_periods (org_id, user_id, active_from)
+SELECT u.org_id, u.user_id, COALESCE(
+    (SELECT MIN(pr.created_at) FROM pull_requests pr WHERE pr.org_id = u.org_id),
+    NOW()
+)
+FROM users u
+WHERE u.is_active;
@@ -0,0 +1,4 @@
+ALTER TABLE reviewers DROP COLUMN IF EXISTS reminded_at;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS check_review_sla;
+ALTER TABLE teams DROP COLUMN IF EXISTS reassign_after_seconds;
+ALTER TABLE teams DROP COLUMN IF EXISTS remind_after_seconds;
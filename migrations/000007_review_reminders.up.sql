@@ -0,0 +1,12 @@
+-- per-team review SLA, NULL falls back to the scheduler defaults
+ALTER TABLE teams ADD COLUMN remind_after_seconds INTEGER NULL;
+ALTER TABLE teams ADD COLUMN reassign_after_seconds INTEGER NULL;
+ALTER TABLE teams ADD CONSTRAINT check_review_sla CHECK (
+    (remind_after_seconds IS NULL) = (reassign_after_seconds IS NULL)
+    AND (remind_after_seconds IS NULL OR remind_after_seconds > 0)
+    AND (reassign_after_seconds IS NULL OR reassign_after_seconds = 0
+        OR reassign_after_seconds > remind_after_seconds)
+);
+
+-- a reviewer is reminded once per assignment
+ALTER TABLE reviewers ADD COLUMN reminded_at TIMESTAMP WITH TIME ZONE NULL;
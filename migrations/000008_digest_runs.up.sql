@@ -0,0 +1,8 @@
+-- one row per organization and day the digest went out, so replicas and
+-- restarts don't send it twice
+CREATE TABLE digest_runs (
+    org_id INTEGER NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    run_date DATE NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (org_id, run_date)
+);
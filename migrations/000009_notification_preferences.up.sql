@@ -0,0 +1,14 @@
+CREATE TYPE notification_channel AS ENUM ('chat', 'email');
+
+-- users without rows get the service's default channel, rows with enabled
+-- false mute a channel
+CREATE TABLE notification_preferences (
+    org_id INTEGER NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    channel notification_channel NOT NULL,
+    -- email address or chat webhook URL, NULL uses the service default
+    address VARCHAR(1024) NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    PRIMARY KEY (org_id, user_id, channel),
+    FOREIGN KEY (org_id, user_id) REFERENCES users (org_id, user_id) ON DELETE CASCADE
+);
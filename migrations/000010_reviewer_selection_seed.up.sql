@@ -0,0 +1,3 @@
+-- seed of the random pick that chose the reviewer, NULL when the reviewer
+-- was not picked at random (e.g. imported)
+ALTER TABLE reviewers ADD COLUMN selection_seed BIGINT NULL;
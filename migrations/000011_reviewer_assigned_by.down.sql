@@ -0,0 +1 @@
+ALTER TABLE reviewers DROP COLUMN IF EXISTS assigned_by;
@@ -0,0 +1,4 @@
+-- who assigned the reviewer: the caller's user_id, token:NAME for tokens not
+-- tied to a user or job:NAME for scheduled jobs. NULL when unknown (auth
+-- disabled, assigned before this column existed)
+ALTER TABLE reviewers ADD COLUMN assigned_by VARCHAR(255) NULL;
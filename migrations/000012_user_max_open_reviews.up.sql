@@ -0,0 +1,4 @@
+-- how many open pull requests the user reviews at most before automatic
+-- selection skips them, NULL for no limit
+ALTER TABLE users ADD COLUMN max_open_reviews INTEGER NULL
+    CHECK (max_open_reviews >= 0);
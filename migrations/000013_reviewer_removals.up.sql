@@ -0,0 +1,19 @@
+-- reviewers taken off pull requests, moved here from reviewers when they're
+-- removed or replaced so the change keeps who made it
+CREATE TABLE reviewer_removals (
+    id SERIAL PRIMARY KEY,
+    org_id INTEGER NOT NULL,
+    pull_request_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    assigned_by VARCHAR(255) NULL,
+    -- the reviewer who took over, NULL for a plain removal
+    replaced_by VARCHAR(255) NULL,
+    -- same format as reviewers.assigned_by, NULL when unknown
+    removed_by VARCHAR(255) NULL,
+    removed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (org_id, pull_request_id)
+        REFERENCES pull_requests (org_id, pull_request_id) ON DELETE CASCADE
+);
+CREATE INDEX reviewer_removals_pull_request_id_index
+    ON reviewer_removals (org_id, pull_request_id);
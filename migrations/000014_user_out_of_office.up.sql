@@ -0,0 +1,2 @@
+-- automatic selection skips the user until then, NULL when not away
+ALTER TABLE users ADD COLUMN out_of_office_until TIMESTAMPTZ NULL;
@@ -0,0 +1,4604 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.2.0 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for BulkChangeAction.
+const (
+	Create BulkChangeAction = "create"
+	Move   BulkChangeAction = "move"
+	Update BulkChangeAction = "update"
+)
+
+// Defines values for BulkChangeEntity.
+const (
+	BulkChangeEntityPullRequest BulkChangeEntity = "pull_request"
+	BulkChangeEntityTeam        BulkChangeEntity = "team"
+	BulkChangeEntityUser        BulkChangeEntity = "user"
+)
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	ALREADYASSIGNED  ErrorResponseErrorCode = "ALREADY_ASSIGNED"
+	ATCAPACITY       ErrorResponseErrorCode = "AT_CAPACITY"
+	FORBIDDEN        ErrorResponseErrorCode = "FORBIDDEN"
+	INTERNALERROR    ErrorResponseErrorCode = "INTERNAL_ERROR"
+	INVALIDREQUEST   ErrorResponseErrorCode = "INVALID_REQUEST"
+	INVALIDREVIEWER  ErrorResponseErrorCode = "INVALID_REVIEWER"
+	NOCANDIDATE      ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED      ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND         ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS         ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED         ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED      ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS       ErrorResponseErrorCode = "TEAM_EXISTS"
+	TOOMANYREVIEWERS ErrorResponseErrorCode = "TOO_MANY_REVIEWERS"
+	UNAUTHORIZED     ErrorResponseErrorCode = "UNAUTHORIZED"
+)
+
+// Defines values for ExclusionReason.
+const (
+	AtCapacity  ExclusionReason = "at_capacity"
+	Author      ExclusionReason = "author"
+	Inactive    ExclusionReason = "inactive"
+	OutOfOffice ExclusionReason = "out_of_office"
+)
+
+// Defines values for NotificationPreferenceChannel.
+const (
+	Chat  NotificationPreferenceChannel = "chat"
+	Email NotificationPreferenceChannel = "email"
+)
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for PullRequestShortStatus.
+const (
+	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Defines values for ReviewVerdict.
+const (
+	ReviewVerdictAPPROVED         ReviewVerdict = "APPROVED"
+	ReviewVerdictCHANGESREQUESTED ReviewVerdict = "CHANGES_REQUESTED"
+)
+
+// Defines values for GetAdminExportParamsFormat.
+const (
+	Csv  GetAdminExportParamsFormat = "csv"
+	Json GetAdminExportParamsFormat = "json"
+	Yaml GetAdminExportParamsFormat = "yaml"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	MERGED GetPullRequestListParamsStatus = "MERGED"
+	OPEN   GetPullRequestListParamsStatus = "OPEN"
+)
+
+// Defines values for PostPullRequestVerdictJSONBodyVerdict.
+const (
+	PostPullRequestVerdictJSONBodyVerdictAPPROVED         PostPullRequestVerdictJSONBodyVerdict = "APPROVED"
+	PostPullRequestVerdictJSONBodyVerdictCHANGESREQUESTED PostPullRequestVerdictJSONBodyVerdict = "CHANGES_REQUESTED"
+)
+
+// Defines values for GetUsersDigestParamsFormat.
+const (
+	Html     GetUsersDigestParamsFormat = "html"
+	Markdown GetUsersDigestParamsFormat = "markdown"
+	Text     GetUsersDigestParamsFormat = "text"
+)
+
+// AssignmentPreview defines model for AssignmentPreview.
+type AssignmentPreview struct {
+	AuthorId string `json:"author_id"`
+
+	// Candidates Участники команды, из которых выбираются ревьюверы
+	Candidates []string    `json:"candidates"`
+	Excluded   []Exclusion `json:"excluded"`
+
+	// Reviewers Кого назначил бы `/pullRequest/create` с тем же зерном
+	Reviewers []string `json:"reviewers"`
+
+	// Seed Зерно выбора, его можно передать в запрос снова, пока не изменились кандидаты
+	Seed     string `json:"seed"`
+	TeamName string `json:"team_name"`
+}
+
+// BulkChange defines model for BulkChange.
+type BulkChange struct {
+	Action BulkChangeAction `json:"action"`
+	Detail string           `json:"detail"`
+	Entity BulkChangeEntity `json:"entity"`
+
+	// Id Имя команды, user_id или pull_request_id
+	Id string `json:"id"`
+}
+
+// BulkChangeAction defines model for BulkChange.Action.
+type BulkChangeAction string
+
+// BulkChangeEntity defines model for BulkChange.Entity.
+type BulkChangeEntity string
+
+// BulkData Команды с участниками и открытые PR. В CSV одна запись на строку, колонка `record`
+// (`team`, `member` или `pull_request`) определяет, какие из остальных колонок заполнены:
+// `record,team_name,user_id,username,is_active,pull_request_id,pull_request_name,author_id,reviewers`,
+// ревьюверы разделяются `;`.
+type BulkData struct {
+	PullRequests *[]struct {
+		AssignedReviewers *[]string `json:"assigned_reviewers,omitempty"`
+		AuthorId          string    `json:"author_id"`
+		PullRequestId     string    `json:"pull_request_id"`
+		PullRequestName   string    `json:"pull_request_name"`
+	} `json:"pull_requests,omitempty"`
+	Teams *[]struct {
+		Members []struct {
+			IsActive *bool  `json:"is_active,omitempty"`
+			UserId   string `json:"user_id"`
+			Username string `json:"username"`
+		} `json:"members"`
+		TeamName string `json:"team_name"`
+	} `json:"teams,omitempty"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error struct {
+		Code    ErrorResponseErrorCode `json:"code"`
+		Message string                 `json:"message"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// Exclusion defines model for Exclusion.
+type Exclusion struct {
+	// Reason `author` — автор PR, `inactive` — пользователь неактивен,
+	// `out_of_office` — пользователь отсутствует до `out_of_office_until`,
+	// `at_capacity` — у пользователя уже `max_open_reviews` открытых ревью.
+	Reason ExclusionReason `json:"reason"`
+	UserId string          `json:"user_id"`
+}
+
+// ExclusionReason `author` — автор PR, `inactive` — пользователь неактивен,
+// `out_of_office` — пользователь отсутствует до `out_of_office_until`,
+// `at_capacity` — у пользователя уже `max_open_reviews` открытых ревью.
+type ExclusionReason string
+
+// FilledPullRequest defines model for FilledPullRequest.
+type FilledPullRequest struct {
+	// Added Добавленные ревьюверы
+	Added []string    `json:"added"`
+	Pr    PullRequest `json:"pr"`
+}
+
+// NotificationPreference defines model for NotificationPreference.
+type NotificationPreference struct {
+	// Address URL вебхука или email, пустая строка - адрес по умолчанию
+	Address *string                       `json:"address,omitempty"`
+	Channel NotificationPreferenceChannel `json:"channel"`
+	Enabled *bool                         `json:"enabled,omitempty"`
+}
+
+// NotificationPreferenceChannel defines model for NotificationPreference.Channel.
+type NotificationPreferenceChannel string
+
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	// Channels Пустой список - канал по умолчанию
+	Channels []NotificationPreference `json:"channels"`
+	UserId   string                   `json:"user_id"`
+}
+
+// Percentiles Перцентили в секундах, null если выборка пуста
+type Percentiles struct {
+	P50Seconds *float32 `json:"p50_seconds"`
+	P90Seconds *float32 `json:"p90_seconds"`
+	P99Seconds *float32 `json:"p99_seconds"`
+	Samples    int      `json:"samples"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string          `json:"assigned_reviewers"`
+	AuthorId          string            `json:"author_id"`
+	CreatedAt         *time.Time        `json:"createdAt"`
+	MergedAt          *time.Time        `json:"mergedAt"`
+	PullRequestId     string            `json:"pull_request_id"`
+	PullRequestName   string            `json:"pull_request_name"`
+	Status            PullRequestStatus `json:"status"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestCounts defines model for PullRequestCounts.
+type PullRequestCounts struct {
+	AvgReviewersPerPr  float32 `json:"avg_reviewers_per_pr"`
+	MergedPullRequests int     `json:"merged_pull_requests"`
+	OpenPullRequests   int     `json:"open_pull_requests"`
+	TotalPullRequests  int     `json:"total_pull_requests"`
+
+	// TotalReviewers Число назначений ревьюверов
+	TotalReviewers int `json:"total_reviewers"`
+}
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AuthorId        string                 `json:"author_id"`
+	PullRequestId   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	Status          PullRequestShortStatus `json:"status"`
+}
+
+// PullRequestShortStatus defines model for PullRequestShort.Status.
+type PullRequestShortStatus string
+
+// Review defines model for Review.
+type Review struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// AssignedBy Кто назначил ревьювера: `user_id` токена, `token:ИМЯ` для токена без пользователя
+	// или `job:ИМЯ` для фоновой задачи. Нет, если неизвестно.
+	AssignedBy *string `json:"assigned_by,omitempty"`
+
+	// Seed Зерно случайного выбора ревьювера, нет у назначенных не случайно (например, импортом).
+	// При `ASSIGNMENT_ALLOW_SEED=true` заголовок `X-Assignment-Seed` с этим значением
+	// повторяет выбор, пока не изменились кандидаты: список кандидатов не сохраняется,
+	// и приход, уход, деактивация участника или достижение им лимита открытых ревью
+	// меняют выбор.
+	Seed      *string        `json:"seed,omitempty"`
+	UserId    string         `json:"user_id"`
+	Verdict   *ReviewVerdict `json:"verdict,omitempty"`
+	VerdictAt *time.Time     `json:"verdict_at,omitempty"`
+}
+
+// ReviewVerdict defines model for Review.Verdict.
+type ReviewVerdict string
+
+// ReviewerStats defines model for ReviewerStats.
+type ReviewerStats struct {
+	IsActive          bool `json:"is_active"`
+	MergedAssignments int  `json:"merged_assignments"`
+	OpenAssignments   int  `json:"open_assignments"`
+
+	// TeamName Пустая строка, если пользователь не состоит в команде
+	TeamName         string `json:"team_name"`
+	TotalAssignments int    `json:"total_assignments"`
+	UserId           string `json:"user_id"`
+	Username         string `json:"username"`
+}
+
+// SLABreakdown defines model for SLABreakdown.
+type SLABreakdown struct {
+	ByReviewer []struct {
+		P50Seconds *float32 `json:"p50_seconds"`
+		P90Seconds *float32 `json:"p90_seconds"`
+		P99Seconds *float32 `json:"p99_seconds"`
+		Samples    int      `json:"samples"`
+		UserId     string   `json:"user_id"`
+	} `json:"by_reviewer"`
+	ByTeam []struct {
+		P50Seconds *float32 `json:"p50_seconds"`
+		P90Seconds *float32 `json:"p90_seconds"`
+		P99Seconds *float32 `json:"p99_seconds"`
+		Samples    int      `json:"samples"`
+		TeamName   string   `json:"team_name"`
+	} `json:"by_team"`
+
+	// Overall Перцентили в секундах, null если выборка пуста
+	Overall Percentiles `json:"overall"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// User defines model for User.
+type User struct {
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Сколько открытых PR пользователю можно назначить автоматически, null — без ограничения
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// OpenReviews Открытые PR, где пользователь назначен ревьювером
+	OpenReviews *int `json:"open_reviews,omitempty"`
+
+	// OutOfOfficeUntil До какого момента пользователь отсутствует и не назначается автоматически, null — на месте
+	OutOfOfficeUntil *time.Time `json:"out_of_office_until"`
+	TeamName         string     `json:"team_name"`
+	UserId           string     `json:"user_id"`
+	Username         string     `json:"username"`
+}
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
+
+// GetAdminExportParams defines parameters for GetAdminExport.
+type GetAdminExportParams struct {
+	Format *GetAdminExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAdminExportParamsFormat defines parameters for GetAdminExport.
+type GetAdminExportParamsFormat string
+
+// PostAdminImportParams defines parameters for PostAdminImport.
+type PostAdminImportParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	// TeamName Только PR авторов из этой команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// UserId Только PR, где пользователь автор или ревьювер
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// PostPullRequestAddReviewerJSONBody defines parameters for PostPullRequestAddReviewer.
+type PostPullRequestAddReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBody struct {
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	Status *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestPreviewAssignmentJSONBody defines parameters for PostPullRequestPreviewAssignment.
+type PostPullRequestPreviewAssignmentJSONBody struct {
+	AuthorId string `json:"author_id"`
+
+	// Seed Зерно выбора, без него берётся случайное
+	Seed *string `json:"seed,omitempty"`
+}
+
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestRemoveReviewerJSONBody defines parameters for PostPullRequestRemoveReviewer.
+type PostPullRequestRemoveReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestVerdictJSONBody defines parameters for PostPullRequestVerdict.
+type PostPullRequestVerdictJSONBody struct {
+	PullRequestId string                                `json:"pull_request_id"`
+	UserId        string                                `json:"user_id"`
+	Verdict       PostPullRequestVerdictJSONBodyVerdict `json:"verdict"`
+}
+
+// PostPullRequestVerdictJSONBodyVerdict defines parameters for PostPullRequestVerdict.
+type PostPullRequestVerdictJSONBodyVerdict string
+
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	TeamName string     `form:"team_name" json:"team_name"`
+	From     *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To       *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsReviewersParams defines parameters for GetStatsReviewers.
+type GetStatsReviewersParams struct {
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// GetStatsSlaParams defines parameters for GetStatsSla.
+type GetStatsSlaParams struct {
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To   *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamSetReviewSLAJSONBody defines parameters for PostTeamSetReviewSLA.
+type PostTeamSetReviewSLAJSONBody struct {
+	ReassignAfterSeconds *int   `json:"reassign_after_seconds"`
+	RemindAfterSeconds   *int   `json:"remind_after_seconds"`
+	TeamName             string `json:"team_name"`
+}
+
+// GetUsersDigestParams defines parameters for GetUsersDigest.
+type GetUsersDigestParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery                 `form:"user_id" json:"user_id"`
+	Format *GetUsersDigestParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetUsersDigestParamsFormat defines parameters for GetUsersDigest.
+type GetUsersDigestParamsFormat string
+
+// GetUsersGetNotificationPreferencesParams defines parameters for GetUsersGetNotificationPreferences.
+type GetUsersGetNotificationPreferencesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+}
+
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersSetOutOfOfficeJSONBody defines parameters for PostUsersSetOutOfOffice.
+type PostUsersSetOutOfOfficeJSONBody struct {
+	Until  *time.Time `json:"until"`
+	UserId string     `json:"user_id"`
+}
+
+// PostAdminImportJSONRequestBody defines body for PostAdminImport for application/json ContentType.
+type PostAdminImportJSONRequestBody = BulkData
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody PostPullRequestAddReviewerJSONBody
+
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+
+// PostPullRequestPreviewAssignmentJSONRequestBody defines body for PostPullRequestPreviewAssignment for application/json ContentType.
+type PostPullRequestPreviewAssignmentJSONRequestBody PostPullRequestPreviewAssignmentJSONBody
+
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody PostPullRequestRemoveReviewerJSONBody
+
+// PostPullRequestVerdictJSONRequestBody defines body for PostPullRequestVerdict for application/json ContentType.
+type PostPullRequestVerdictJSONRequestBody PostPullRequestVerdictJSONBody
+
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
+
+// PostTeamSetReviewSLAJSONRequestBody defines body for PostTeamSetReviewSLA for application/json ContentType.
+type PostTeamSetReviewSLAJSONRequestBody PostTeamSetReviewSLAJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersSetNotificationPreferencesJSONRequestBody defines body for PostUsersSetNotificationPreferences for application/json ContentType.
+type PostUsersSetNotificationPreferencesJSONRequestBody = NotificationPreferences
+
+// PostUsersSetOutOfOfficeJSONRequestBody defines body for PostUsersSetOutOfOffice for application/json ContentType.
+type PostUsersSetOutOfOfficeJSONRequestBody PostUsersSetOutOfOfficeJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetAdminExport request
+	GetAdminExport(ctx context.Context, params *GetAdminExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminImportWithBody request with any body
+	PostAdminImportWithBody(ctx context.Context, params *PostAdminImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminImport(ctx context.Context, params *PostAdminImportParams, body PostAdminImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEventsStream request
+	GetEventsStream(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestAddReviewerWithBody request with any body
+	PostPullRequestAddReviewerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestAddReviewer(ctx context.Context, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestCreateWithBody request with any body
+	PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestFillReviewers request
+	PostPullRequestFillReviewers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPullRequestList request
+	GetPullRequestList(ctx context.Context, params *GetPullRequestListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestMergeWithBody request with any body
+	PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestPreviewAssignmentWithBody request with any body
+	PostPullRequestPreviewAssignmentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestPreviewAssignment(ctx context.Context, body PostPullRequestPreviewAssignmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestReassignWithBody request with any body
+	PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestRemoveReviewerWithBody request with any body
+	PostPullRequestRemoveReviewerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestRemoveReviewer(ctx context.Context, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestVerdictWithBody request with any body
+	PostPullRequestVerdictWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestVerdict(ctx context.Context, body PostPullRequestVerdictJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatsFairness request
+	GetStatsFairness(ctx context.Context, params *GetStatsFairnessParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatsPullRequests request
+	GetStatsPullRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatsReviewers request
+	GetStatsReviewers(ctx context.Context, params *GetStatsReviewersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatsSla request
+	GetStatsSla(ctx context.Context, params *GetStatsSlaParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamAddWithBody request with any body
+	PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeamGet request
+	GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetReviewSLAWithBody request with any body
+	PostTeamSetReviewSLAWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetReviewSLA(ctx context.Context, body PostTeamSetReviewSLAJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersDigest request
+	GetUsersDigest(ctx context.Context, params *GetUsersDigestParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersGetNotificationPreferences request
+	GetUsersGetNotificationPreferences(ctx context.Context, params *GetUsersGetNotificationPreferencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersGetReview request
+	GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetIsActiveWithBody request with any body
+	PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetMaxOpenReviewsWithBody request with any body
+	PostUsersSetMaxOpenReviewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetMaxOpenReviews(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetNotificationPreferencesWithBody request with any body
+	PostUsersSetNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetNotificationPreferences(ctx context.Context, body PostUsersSetNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetOutOfOfficeWithBody request with any body
+	PostUsersSetOutOfOfficeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetOutOfOffice(ctx context.Context, body PostUsersSetOutOfOfficeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAdminExport(ctx context.Context, params *GetAdminExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminExportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminImportWithBody(ctx context.Context, params *PostAdminImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminImportRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminImport(ctx context.Context, params *PostAdminImportParams, body PostAdminImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminImportRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEventsStream(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventsStreamRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestAddReviewerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestAddReviewerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestAddReviewer(ctx context.Context, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestAddReviewerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestFillReviewers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestFillReviewersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPullRequestList(ctx context.Context, params *GetPullRequestListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPullRequestListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestPreviewAssignmentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestPreviewAssignmentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestPreviewAssignment(ctx context.Context, body PostPullRequestPreviewAssignmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestPreviewAssignmentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestRemoveReviewerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestRemoveReviewerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestRemoveReviewer(ctx context.Context, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestRemoveReviewerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestVerdictWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestVerdictRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestVerdict(ctx context.Context, body PostPullRequestVerdictJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestVerdictRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatsFairness(ctx context.Context, params *GetStatsFairnessParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsFairnessRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatsPullRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsPullRequestsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatsReviewers(ctx context.Context, params *GetStatsReviewersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsReviewersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatsSla(ctx context.Context, params *GetStatsSlaParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsSlaRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamSetReviewSLAWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetReviewSLARequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamSetReviewSLA(ctx context.Context, body PostTeamSetReviewSLAJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetReviewSLARequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersDigest(ctx context.Context, params *GetUsersDigestParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersDigestRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersGetNotificationPreferences(ctx context.Context, params *GetUsersGetNotificationPreferencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersGetNotificationPreferencesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersGetReviewRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetMaxOpenReviewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetMaxOpenReviewsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetMaxOpenReviews(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetMaxOpenReviewsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetNotificationPreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetNotificationPreferences(ctx context.Context, body PostUsersSetNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetNotificationPreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetOutOfOfficeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetOutOfOfficeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetOutOfOffice(ctx context.Context, body PostUsersSetOutOfOfficeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetOutOfOfficeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAdminExportRequest generates requests for GetAdminExport
+func NewGetAdminExportRequest(server string, params *GetAdminExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminImportRequest calls the generic PostAdminImport builder with application/json body
+func NewPostAdminImportRequest(server string, params *PostAdminImportParams, body PostAdminImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminImportRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostAdminImportRequestWithBody generates requests for PostAdminImport with any type of body
+func NewPostAdminImportRequestWithBody(server string, params *PostAdminImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetEventsStreamRequest generates requests for GetEventsStream
+func NewGetEventsStreamRequest(server string, params *GetEventsStreamParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, *params.TeamName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPullRequestAddReviewerRequest calls the generic PostPullRequestAddReviewer builder with application/json body
+func NewPostPullRequestAddReviewerRequest(server string, body PostPullRequestAddReviewerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestAddReviewerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestAddReviewerRequestWithBody generates requests for PostPullRequestAddReviewer with any type of body
+func NewPostPullRequestAddReviewerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/addReviewer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestCreateRequest calls the generic PostPullRequestCreate builder with application/json body
+func NewPostPullRequestCreateRequest(server string, body PostPullRequestCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestCreateRequestWithBody generates requests for PostPullRequestCreate with any type of body
+func NewPostPullRequestCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestFillReviewersRequest generates requests for PostPullRequestFillReviewers
+func NewPostPullRequestFillReviewersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/fillReviewers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPullRequestListRequest generates requests for GetPullRequestList
+func NewGetPullRequestListRequest(server string, params *GetPullRequestListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPullRequestMergeRequest calls the generic PostPullRequestMerge builder with application/json body
+func NewPostPullRequestMergeRequest(server string, body PostPullRequestMergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestMergeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestMergeRequestWithBody generates requests for PostPullRequestMerge with any type of body
+func NewPostPullRequestMergeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestPreviewAssignmentRequest calls the generic PostPullRequestPreviewAssignment builder with application/json body
+func NewPostPullRequestPreviewAssignmentRequest(server string, body PostPullRequestPreviewAssignmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestPreviewAssignmentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestPreviewAssignmentRequestWithBody generates requests for PostPullRequestPreviewAssignment with any type of body
+func NewPostPullRequestPreviewAssignmentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/previewAssignment")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestReassignRequest calls the generic PostPullRequestReassign builder with application/json body
+func NewPostPullRequestReassignRequest(server string, body PostPullRequestReassignJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestReassignRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestReassignRequestWithBody generates requests for PostPullRequestReassign with any type of body
+func NewPostPullRequestReassignRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/reassign")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestRemoveReviewerRequest calls the generic PostPullRequestRemoveReviewer builder with application/json body
+func NewPostPullRequestRemoveReviewerRequest(server string, body PostPullRequestRemoveReviewerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestRemoveReviewerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestRemoveReviewerRequestWithBody generates requests for PostPullRequestRemoveReviewer with any type of body
+func NewPostPullRequestRemoveReviewerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/removeReviewer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestVerdictRequest calls the generic PostPullRequestVerdict builder with application/json body
+func NewPostPullRequestVerdictRequest(server string, body PostPullRequestVerdictJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestVerdictRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestVerdictRequestWithBody generates requests for PostPullRequestVerdict with any type of body
+func NewPostPullRequestVerdictRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/verdict")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStatsFairnessRequest generates requests for GetStatsFairness
+func NewGetStatsFairnessRequest(server string, params *GetStatsFairnessParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/fairness")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatsPullRequestsRequest generates requests for GetStatsPullRequests
+func NewGetStatsPullRequestsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/pullRequests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatsReviewersRequest generates requests for GetStatsReviewers
+func NewGetStatsReviewersRequest(server string, params *GetStatsReviewersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/reviewers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatsSlaRequest generates requests for GetStatsSla
+func NewGetStatsSlaRequest(server string, params *GetStatsSlaParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/sla")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
+func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
+func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTeamGetRequest generates requests for GetTeamGet
+func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamSetReviewSLARequest calls the generic PostTeamSetReviewSLA builder with application/json body
+func NewPostTeamSetReviewSLARequest(server string, body PostTeamSetReviewSLAJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetReviewSLARequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetReviewSLARequestWithBody generates requests for PostTeamSetReviewSLA with any type of body
+func NewPostTeamSetReviewSLARequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setReviewSLA")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersDigestRequest generates requests for GetUsersDigest
+func NewGetUsersDigestRequest(server string, params *GetUsersDigestParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/digest")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersGetNotificationPreferencesRequest generates requests for GetUsersGetNotificationPreferences
+func NewGetUsersGetNotificationPreferencesRequest(server string, params *GetUsersGetNotificationPreferencesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/getNotificationPreferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersGetReviewRequest generates requests for GetUsersGetReview
+func NewGetUsersGetReviewRequest(server string, params *GetUsersGetReviewParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/getReview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersSetIsActiveRequest calls the generic PostUsersSetIsActive builder with application/json body
+func NewPostUsersSetIsActiveRequest(server string, body PostUsersSetIsActiveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetIsActiveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetIsActiveRequestWithBody generates requests for PostUsersSetIsActive with any type of body
+func NewPostUsersSetIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setIsActive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetMaxOpenReviewsRequest calls the generic PostUsersSetMaxOpenReviews builder with application/json body
+func NewPostUsersSetMaxOpenReviewsRequest(server string, body PostUsersSetMaxOpenReviewsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetMaxOpenReviewsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetMaxOpenReviewsRequestWithBody generates requests for PostUsersSetMaxOpenReviews with any type of body
+func NewPostUsersSetMaxOpenReviewsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setMaxOpenReviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetNotificationPreferencesRequest calls the generic PostUsersSetNotificationPreferences builder with application/json body
+func NewPostUsersSetNotificationPreferencesRequest(server string, body PostUsersSetNotificationPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetNotificationPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetNotificationPreferencesRequestWithBody generates requests for PostUsersSetNotificationPreferences with any type of body
+func NewPostUsersSetNotificationPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setNotificationPreferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetOutOfOfficeRequest calls the generic PostUsersSetOutOfOffice builder with application/json body
+func NewPostUsersSetOutOfOfficeRequest(server string, body PostUsersSetOutOfOfficeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetOutOfOfficeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetOutOfOfficeRequestWithBody generates requests for PostUsersSetOutOfOffice with any type of body
+func NewPostUsersSetOutOfOfficeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setOutOfOffice")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAdminExportWithResponse request
+	GetAdminExportWithResponse(ctx context.Context, params *GetAdminExportParams, reqEditors ...RequestEditorFn) (*GetAdminExportResponse, error)
+
+	// PostAdminImportWithBodyWithResponse request with any body
+	PostAdminImportWithBodyWithResponse(ctx context.Context, params *PostAdminImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminImportResponse, error)
+
+	PostAdminImportWithResponse(ctx context.Context, params *PostAdminImportParams, body PostAdminImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminImportResponse, error)
+
+	// GetEventsStreamWithResponse request
+	GetEventsStreamWithResponse(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*GetEventsStreamResponse, error)
+
+	// PostPullRequestAddReviewerWithBodyWithResponse request with any body
+	PostPullRequestAddReviewerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error)
+
+	PostPullRequestAddReviewerWithResponse(ctx context.Context, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error)
+
+	// PostPullRequestCreateWithBodyWithResponse request with any body
+	PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	// PostPullRequestFillReviewersWithResponse request
+	PostPullRequestFillReviewersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostPullRequestFillReviewersResponse, error)
+
+	// GetPullRequestListWithResponse request
+	GetPullRequestListWithResponse(ctx context.Context, params *GetPullRequestListParams, reqEditors ...RequestEditorFn) (*GetPullRequestListResponse, error)
+
+	// PostPullRequestMergeWithBodyWithResponse request with any body
+	PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	// PostPullRequestPreviewAssignmentWithBodyWithResponse request with any body
+	PostPullRequestPreviewAssignmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestPreviewAssignmentResponse, error)
+
+	PostPullRequestPreviewAssignmentWithResponse(ctx context.Context, body PostPullRequestPreviewAssignmentJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestPreviewAssignmentResponse, error)
+
+	// PostPullRequestReassignWithBodyWithResponse request with any body
+	PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	// PostPullRequestRemoveReviewerWithBodyWithResponse request with any body
+	PostPullRequestRemoveReviewerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error)
+
+	PostPullRequestRemoveReviewerWithResponse(ctx context.Context, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error)
+
+	// PostPullRequestVerdictWithBodyWithResponse request with any body
+	PostPullRequestVerdictWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestVerdictResponse, error)
+
+	PostPullRequestVerdictWithResponse(ctx context.Context, body PostPullRequestVerdictJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestVerdictResponse, error)
+
+	// GetStatsFairnessWithResponse request
+	GetStatsFairnessWithResponse(ctx context.Context, params *GetStatsFairnessParams, reqEditors ...RequestEditorFn) (*GetStatsFairnessResponse, error)
+
+	// GetStatsPullRequestsWithResponse request
+	GetStatsPullRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsPullRequestsResponse, error)
+
+	// GetStatsReviewersWithResponse request
+	GetStatsReviewersWithResponse(ctx context.Context, params *GetStatsReviewersParams, reqEditors ...RequestEditorFn) (*GetStatsReviewersResponse, error)
+
+	// GetStatsSlaWithResponse request
+	GetStatsSlaWithResponse(ctx context.Context, params *GetStatsSlaParams, reqEditors ...RequestEditorFn) (*GetStatsSlaResponse, error)
+
+	// PostTeamAddWithBodyWithResponse request with any body
+	PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	// GetTeamGetWithResponse request
+	GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error)
+
+	// PostTeamSetReviewSLAWithBodyWithResponse request with any body
+	PostTeamSetReviewSLAWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetReviewSLAResponse, error)
+
+	PostTeamSetReviewSLAWithResponse(ctx context.Context, body PostTeamSetReviewSLAJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetReviewSLAResponse, error)
+
+	// GetUsersDigestWithResponse request
+	GetUsersDigestWithResponse(ctx context.Context, params *GetUsersDigestParams, reqEditors ...RequestEditorFn) (*GetUsersDigestResponse, error)
+
+	// GetUsersGetNotificationPreferencesWithResponse request
+	GetUsersGetNotificationPreferencesWithResponse(ctx context.Context, params *GetUsersGetNotificationPreferencesParams, reqEditors ...RequestEditorFn) (*GetUsersGetNotificationPreferencesResponse, error)
+
+	// GetUsersGetReviewWithResponse request
+	GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error)
+
+	// PostUsersSetIsActiveWithBodyWithResponse request with any body
+	PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	// PostUsersSetMaxOpenReviewsWithBodyWithResponse request with any body
+	PostUsersSetMaxOpenReviewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error)
+
+	PostUsersSetMaxOpenReviewsWithResponse(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error)
+
+	// PostUsersSetNotificationPreferencesWithBodyWithResponse request with any body
+	PostUsersSetNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetNotificationPreferencesResponse, error)
+
+	PostUsersSetNotificationPreferencesWithResponse(ctx context.Context, body PostUsersSetNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetNotificationPreferencesResponse, error)
+
+	// PostUsersSetOutOfOfficeWithBodyWithResponse request with any body
+	PostUsersSetOutOfOfficeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetOutOfOfficeResponse, error)
+
+	PostUsersSetOutOfOfficeWithResponse(ctx context.Context, body PostUsersSetOutOfOfficeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetOutOfOfficeResponse, error)
+}
+
+type GetAdminExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkData
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Changes []BulkChange `json:"changes"`
+		DryRun  bool         `json:"dry_run"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventsStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventsStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventsStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestAddReviewerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr      PullRequest `json:"pr"`
+		Reviews []Review    `json:"reviews"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestAddReviewerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestAddReviewerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestFillReviewersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Filled []FilledPullRequest `json:"filled"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestFillReviewersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestFillReviewersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPullRequestListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		PullRequests []PullRequest `json:"pull_requests"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPullRequestListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPullRequestListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestPreviewAssignmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssignmentPreview
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestPreviewAssignmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestPreviewAssignmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestReassignResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestReassignResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestReassignResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestRemoveReviewerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr      PullRequest `json:"pr"`
+		Reviews []Review    `json:"reviews"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestRemoveReviewerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestRemoveReviewerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestVerdictResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr      PullRequest `json:"pr"`
+		Reviews []Review    `json:"reviews"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestVerdictResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestVerdictResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsFairnessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		From    *time.Time `json:"from,omitempty"`
+		Gini    float32    `json:"gini"`
+		Members []struct {
+			// ActiveDays Время активности в окне в сутках, дробное (36 часов - `1.5`)
+			ActiveDays  float32 `json:"active_days"`
+			Assignments int     `json:"assignments"`
+
+			// Deviation assignments - expected_assignments
+			Deviation           float32 `json:"deviation"`
+			ExpectedAssignments float32 `json:"expected_assignments"`
+			ExpectedShare       float32 `json:"expected_share"`
+			IsActive            bool    `json:"is_active"`
+			UserId              string  `json:"user_id"`
+			Username            string  `json:"username"`
+		} `json:"members"`
+		TeamName         string     `json:"team_name"`
+		To               *time.Time `json:"to,omitempty"`
+		TotalAssignments int        `json:"total_assignments"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsFairnessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsFairnessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsPullRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		AvgReviewersPerPr float32 `json:"avg_reviewers_per_pr"`
+		ByTeam            *[]struct {
+			AvgReviewersPerPr  float32 `json:"avg_reviewers_per_pr"`
+			MergedPullRequests int     `json:"merged_pull_requests"`
+			OpenPullRequests   int     `json:"open_pull_requests"`
+			TeamName           string  `json:"team_name"`
+			TotalPullRequests  int     `json:"total_pull_requests"`
+
+			// TotalReviewers Число назначений ревьюверов
+			TotalReviewers int `json:"total_reviewers"`
+		} `json:"by_team,omitempty"`
+		MergedPullRequests int `json:"merged_pull_requests"`
+		OpenPullRequests   int `json:"open_pull_requests"`
+		TotalPullRequests  int `json:"total_pull_requests"`
+
+		// TotalReviewers Число назначений ревьюверов
+		TotalReviewers int `json:"total_reviewers"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsPullRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsPullRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsReviewersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		union json.RawMessage
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsReviewersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsReviewersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsSlaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		AssignmentToVerdict SLABreakdown `json:"assignment_to_verdict"`
+		From                *time.Time   `json:"from,omitempty"`
+		TimeToFirstReview   SLABreakdown `json:"time_to_first_review"`
+		TimeToMerge         SLABreakdown `json:"time_to_merge"`
+		To                  *time.Time   `json:"to,omitempty"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsSlaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsSlaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamAddResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamAddResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamAddResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Team
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetReviewSLAResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		ReassignAfterSeconds *int   `json:"reassign_after_seconds"`
+		RemindAfterSeconds   *int   `json:"remind_after_seconds"`
+		TeamName             string `json:"team_name"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetReviewSLAResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetReviewSLAResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersDigestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersDigestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersDigestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersGetNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationPreferences
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersGetNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersGetNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersGetReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		MaxOpenReviews *int               `json:"max_open_reviews"`
+		OpenReviews    *int               `json:"open_reviews,omitempty"`
+		PullRequests   []PullRequestShort `json:"pull_requests"`
+		UserId         string             `json:"user_id"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersGetReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersGetReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetIsActiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Filled PR команды, получившие ревьюверов, только при повторной активации
+		Filled *[]FilledPullRequest `json:"filled,omitempty"`
+		User   *User                `json:"user,omitempty"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetIsActiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetIsActiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetMaxOpenReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetMaxOpenReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetMaxOpenReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationPreferences
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetOutOfOfficeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetOutOfOfficeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetOutOfOfficeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAdminExportWithResponse request returning *GetAdminExportResponse
+func (c *ClientWithResponses) GetAdminExportWithResponse(ctx context.Context, params *GetAdminExportParams, reqEditors ...RequestEditorFn) (*GetAdminExportResponse, error) {
+	rsp, err := c.GetAdminExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminExportResponse(rsp)
+}
+
+// PostAdminImportWithBodyWithResponse request with arbitrary body returning *PostAdminImportResponse
+func (c *ClientWithResponses) PostAdminImportWithBodyWithResponse(ctx context.Context, params *PostAdminImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminImportResponse, error) {
+	rsp, err := c.PostAdminImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminImportWithResponse(ctx context.Context, params *PostAdminImportParams, body PostAdminImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminImportResponse, error) {
+	rsp, err := c.PostAdminImport(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminImportResponse(rsp)
+}
+
+// GetEventsStreamWithResponse request returning *GetEventsStreamResponse
+func (c *ClientWithResponses) GetEventsStreamWithResponse(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*GetEventsStreamResponse, error) {
+	rsp, err := c.GetEventsStream(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventsStreamResponse(rsp)
+}
+
+// PostPullRequestAddReviewerWithBodyWithResponse request with arbitrary body returning *PostPullRequestAddReviewerResponse
+func (c *ClientWithResponses) PostPullRequestAddReviewerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error) {
+	rsp, err := c.PostPullRequestAddReviewerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestAddReviewerResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestAddReviewerWithResponse(ctx context.Context, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error) {
+	rsp, err := c.PostPullRequestAddReviewer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestAddReviewerResponse(rsp)
+}
+
+// PostPullRequestCreateWithBodyWithResponse request with arbitrary body returning *PostPullRequestCreateResponse
+func (c *ClientWithResponses) PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+// PostPullRequestFillReviewersWithResponse request returning *PostPullRequestFillReviewersResponse
+func (c *ClientWithResponses) PostPullRequestFillReviewersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostPullRequestFillReviewersResponse, error) {
+	rsp, err := c.PostPullRequestFillReviewers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestFillReviewersResponse(rsp)
+}
+
+// GetPullRequestListWithResponse request returning *GetPullRequestListResponse
+func (c *ClientWithResponses) GetPullRequestListWithResponse(ctx context.Context, params *GetPullRequestListParams, reqEditors ...RequestEditorFn) (*GetPullRequestListResponse, error) {
+	rsp, err := c.GetPullRequestList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPullRequestListResponse(rsp)
+}
+
+// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
+func (c *ClientWithResponses) PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
+	rsp, err := c.PostPullRequestMergeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
+	rsp, err := c.PostPullRequestMerge(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
+// PostPullRequestPreviewAssignmentWithBodyWithResponse request with arbitrary body returning *PostPullRequestPreviewAssignmentResponse
+func (c *ClientWithResponses) PostPullRequestPreviewAssignmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestPreviewAssignmentResponse, error) {
+	rsp, err := c.PostPullRequestPreviewAssignmentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestPreviewAssignmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestPreviewAssignmentWithResponse(ctx context.Context, body PostPullRequestPreviewAssignmentJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestPreviewAssignmentResponse, error) {
+	rsp, err := c.PostPullRequestPreviewAssignment(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestPreviewAssignmentResponse(rsp)
+}
+
+// PostPullRequestReassignWithBodyWithResponse request with arbitrary body returning *PostPullRequestReassignResponse
+func (c *ClientWithResponses) PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassignWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassign(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+// PostPullRequestRemoveReviewerWithBodyWithResponse request with arbitrary body returning *PostPullRequestRemoveReviewerResponse
+func (c *ClientWithResponses) PostPullRequestRemoveReviewerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error) {
+	rsp, err := c.PostPullRequestRemoveReviewerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestRemoveReviewerResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestRemoveReviewerWithResponse(ctx context.Context, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error) {
+	rsp, err := c.PostPullRequestRemoveReviewer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestRemoveReviewerResponse(rsp)
+}
+
+// PostPullRequestVerdictWithBodyWithResponse request with arbitrary body returning *PostPullRequestVerdictResponse
+func (c *ClientWithResponses) PostPullRequestVerdictWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestVerdictResponse, error) {
+	rsp, err := c.PostPullRequestVerdictWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestVerdictResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestVerdictWithResponse(ctx context.Context, body PostPullRequestVerdictJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestVerdictResponse, error) {
+	rsp, err := c.PostPullRequestVerdict(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestVerdictResponse(rsp)
+}
+
+// GetStatsFairnessWithResponse request returning *GetStatsFairnessResponse
+func (c *ClientWithResponses) GetStatsFairnessWithResponse(ctx context.Context, params *GetStatsFairnessParams, reqEditors ...RequestEditorFn) (*GetStatsFairnessResponse, error) {
+	rsp, err := c.GetStatsFairness(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsFairnessResponse(rsp)
+}
+
+// GetStatsPullRequestsWithResponse request returning *GetStatsPullRequestsResponse
+func (c *ClientWithResponses) GetStatsPullRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsPullRequestsResponse, error) {
+	rsp, err := c.GetStatsPullRequests(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsPullRequestsResponse(rsp)
+}
+
+// GetStatsReviewersWithResponse request returning *GetStatsReviewersResponse
+func (c *ClientWithResponses) GetStatsReviewersWithResponse(ctx context.Context, params *GetStatsReviewersParams, reqEditors ...RequestEditorFn) (*GetStatsReviewersResponse, error) {
+	rsp, err := c.GetStatsReviewers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsReviewersResponse(rsp)
+}
+
+// GetStatsSlaWithResponse request returning *GetStatsSlaResponse
+func (c *ClientWithResponses) GetStatsSlaWithResponse(ctx context.Context, params *GetStatsSlaParams, reqEditors ...RequestEditorFn) (*GetStatsSlaResponse, error) {
+	rsp, err := c.GetStatsSla(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsSlaResponse(rsp)
+}
+
+// PostTeamAddWithBodyWithResponse request with arbitrary body returning *PostTeamAddResponse
+func (c *ClientWithResponses) PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAddWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAdd(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+// GetTeamGetWithResponse request returning *GetTeamGetResponse
+func (c *ClientWithResponses) GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error) {
+	rsp, err := c.GetTeamGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamGetResponse(rsp)
+}
+
+// PostTeamSetReviewSLAWithBodyWithResponse request with arbitrary body returning *PostTeamSetReviewSLAResponse
+func (c *ClientWithResponses) PostTeamSetReviewSLAWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetReviewSLAResponse, error) {
+	rsp, err := c.PostTeamSetReviewSLAWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetReviewSLAResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetReviewSLAWithResponse(ctx context.Context, body PostTeamSetReviewSLAJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetReviewSLAResponse, error) {
+	rsp, err := c.PostTeamSetReviewSLA(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetReviewSLAResponse(rsp)
+}
+
+// GetUsersDigestWithResponse request returning *GetUsersDigestResponse
+func (c *ClientWithResponses) GetUsersDigestWithResponse(ctx context.Context, params *GetUsersDigestParams, reqEditors ...RequestEditorFn) (*GetUsersDigestResponse, error) {
+	rsp, err := c.GetUsersDigest(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersDigestResponse(rsp)
+}
+
+// GetUsersGetNotificationPreferencesWithResponse request returning *GetUsersGetNotificationPreferencesResponse
+func (c *ClientWithResponses) GetUsersGetNotificationPreferencesWithResponse(ctx context.Context, params *GetUsersGetNotificationPreferencesParams, reqEditors ...RequestEditorFn) (*GetUsersGetNotificationPreferencesResponse, error) {
+	rsp, err := c.GetUsersGetNotificationPreferences(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersGetNotificationPreferencesResponse(rsp)
+}
+
+// GetUsersGetReviewWithResponse request returning *GetUsersGetReviewResponse
+func (c *ClientWithResponses) GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error) {
+	rsp, err := c.GetUsersGetReview(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersGetReviewResponse(rsp)
+}
+
+// PostUsersSetIsActiveWithBodyWithResponse request with arbitrary body returning *PostUsersSetIsActiveResponse
+func (c *ClientWithResponses) PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActiveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActive(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+// PostUsersSetMaxOpenReviewsWithBodyWithResponse request with arbitrary body returning *PostUsersSetMaxOpenReviewsResponse
+func (c *ClientWithResponses) PostUsersSetMaxOpenReviewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error) {
+	rsp, err := c.PostUsersSetMaxOpenReviewsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetMaxOpenReviewsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetMaxOpenReviewsWithResponse(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error) {
+	rsp, err := c.PostUsersSetMaxOpenReviews(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetMaxOpenReviewsResponse(rsp)
+}
+
+// PostUsersSetNotificationPreferencesWithBodyWithResponse request with arbitrary body returning *PostUsersSetNotificationPreferencesResponse
+func (c *ClientWithResponses) PostUsersSetNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetNotificationPreferencesResponse, error) {
+	rsp, err := c.PostUsersSetNotificationPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetNotificationPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetNotificationPreferencesWithResponse(ctx context.Context, body PostUsersSetNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetNotificationPreferencesResponse, error) {
+	rsp, err := c.PostUsersSetNotificationPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetNotificationPreferencesResponse(rsp)
+}
+
+// PostUsersSetOutOfOfficeWithBodyWithResponse request with arbitrary body returning *PostUsersSetOutOfOfficeResponse
+func (c *ClientWithResponses) PostUsersSetOutOfOfficeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetOutOfOfficeResponse, error) {
+	rsp, err := c.PostUsersSetOutOfOfficeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetOutOfOfficeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetOutOfOfficeWithResponse(ctx context.Context, body PostUsersSetOutOfOfficeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetOutOfOfficeResponse, error) {
+	rsp, err := c.PostUsersSetOutOfOffice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetOutOfOfficeResponse(rsp)
+}
+
+// ParseGetAdminExportResponse parses an HTTP response from a GetAdminExportWithResponse call
+func ParseGetAdminExportResponse(rsp *http.Response) (*GetAdminExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminImportResponse parses an HTTP response from a PostAdminImportWithResponse call
+func ParsePostAdminImportResponse(rsp *http.Response) (*PostAdminImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Changes []BulkChange `json:"changes"`
+			DryRun  bool         `json:"dry_run"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventsStreamResponse parses an HTTP response from a GetEventsStreamWithResponse call
+func ParseGetEventsStreamResponse(rsp *http.Response) (*GetEventsStreamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventsStreamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestAddReviewerResponse parses an HTTP response from a PostPullRequestAddReviewerWithResponse call
+func ParsePostPullRequestAddReviewerResponse(rsp *http.Response) (*PostPullRequestAddReviewerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestAddReviewerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr      PullRequest `json:"pr"`
+			Reviews []Review    `json:"reviews"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestCreateResponse parses an HTTP response from a PostPullRequestCreateWithResponse call
+func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestFillReviewersResponse parses an HTTP response from a PostPullRequestFillReviewersWithResponse call
+func ParsePostPullRequestFillReviewersResponse(rsp *http.Response) (*PostPullRequestFillReviewersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestFillReviewersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Filled []FilledPullRequest `json:"filled"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPullRequestListResponse parses an HTTP response from a GetPullRequestListWithResponse call
+func ParseGetPullRequestListResponse(rsp *http.Response) (*GetPullRequestListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPullRequestListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			PullRequests []PullRequest `json:"pull_requests"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestMergeResponse parses an HTTP response from a PostPullRequestMergeWithResponse call
+func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestMergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestPreviewAssignmentResponse parses an HTTP response from a PostPullRequestPreviewAssignmentWithResponse call
+func ParsePostPullRequestPreviewAssignmentResponse(rsp *http.Response) (*PostPullRequestPreviewAssignmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestPreviewAssignmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssignmentPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestReassignResponse parses an HTTP response from a PostPullRequestReassignWithResponse call
+func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestReassignResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestReassignResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+
+			// ReplacedBy user_id нового ревьювера
+			ReplacedBy string `json:"replaced_by"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestRemoveReviewerResponse parses an HTTP response from a PostPullRequestRemoveReviewerWithResponse call
+func ParsePostPullRequestRemoveReviewerResponse(rsp *http.Response) (*PostPullRequestRemoveReviewerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestRemoveReviewerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr      PullRequest `json:"pr"`
+			Reviews []Review    `json:"reviews"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestVerdictResponse parses an HTTP response from a PostPullRequestVerdictWithResponse call
+func ParsePostPullRequestVerdictResponse(rsp *http.Response) (*PostPullRequestVerdictResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestVerdictResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr      PullRequest `json:"pr"`
+			Reviews []Review    `json:"reviews"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsFairnessResponse parses an HTTP response from a GetStatsFairnessWithResponse call
+func ParseGetStatsFairnessResponse(rsp *http.Response) (*GetStatsFairnessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsFairnessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			From    *time.Time `json:"from,omitempty"`
+			Gini    float32    `json:"gini"`
+			Members []struct {
+				// ActiveDays Время активности в окне в сутках, дробное (36 часов - `1.5`)
+				ActiveDays  float32 `json:"active_days"`
+				Assignments int     `json:"assignments"`
+
+				// Deviation assignments - expected_assignments
+				Deviation           float32 `json:"deviation"`
+				ExpectedAssignments float32 `json:"expected_assignments"`
+				ExpectedShare       float32 `json:"expected_share"`
+				IsActive            bool    `json:"is_active"`
+				UserId              string  `json:"user_id"`
+				Username            string  `json:"username"`
+			} `json:"members"`
+			TeamName         string     `json:"team_name"`
+			To               *time.Time `json:"to,omitempty"`
+			TotalAssignments int        `json:"total_assignments"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsPullRequestsResponse parses an HTTP response from a GetStatsPullRequestsWithResponse call
+func ParseGetStatsPullRequestsResponse(rsp *http.Response) (*GetStatsPullRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsPullRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AvgReviewersPerPr float32 `json:"avg_reviewers_per_pr"`
+			ByTeam            *[]struct {
+				AvgReviewersPerPr  float32 `json:"avg_reviewers_per_pr"`
+				MergedPullRequests int     `json:"merged_pull_requests"`
+				OpenPullRequests   int     `json:"open_pull_requests"`
+				TeamName           string  `json:"team_name"`
+				TotalPullRequests  int     `json:"total_pull_requests"`
+
+				// TotalReviewers Число назначений ревьюверов
+				TotalReviewers int `json:"total_reviewers"`
+			} `json:"by_team,omitempty"`
+			MergedPullRequests int `json:"merged_pull_requests"`
+			OpenPullRequests   int `json:"open_pull_requests"`
+			TotalPullRequests  int `json:"total_pull_requests"`
+
+			// TotalReviewers Число назначений ревьюверов
+			TotalReviewers int `json:"total_reviewers"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsReviewersResponse parses an HTTP response from a GetStatsReviewersWithResponse call
+func ParseGetStatsReviewersResponse(rsp *http.Response) (*GetStatsReviewersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsReviewersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			union json.RawMessage
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsSlaResponse parses an HTTP response from a GetStatsSlaWithResponse call
+func ParseGetStatsSlaResponse(rsp *http.Response) (*GetStatsSlaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsSlaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AssignmentToVerdict SLABreakdown `json:"assignment_to_verdict"`
+			From                *time.Time   `json:"from,omitempty"`
+			TimeToFirstReview   SLABreakdown `json:"time_to_first_review"`
+			TimeToMerge         SLABreakdown `json:"time_to_merge"`
+			To                  *time.Time   `json:"to,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamAddResponse parses an HTTP response from a PostTeamAddWithResponse call
+func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamAddResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Team *Team `json:"team,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamGetResponse parses an HTTP response from a GetTeamGetWithResponse call
+func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetReviewSLAResponse parses an HTTP response from a PostTeamSetReviewSLAWithResponse call
+func ParsePostTeamSetReviewSLAResponse(rsp *http.Response) (*PostTeamSetReviewSLAResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetReviewSLAResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			ReassignAfterSeconds *int   `json:"reassign_after_seconds"`
+			RemindAfterSeconds   *int   `json:"remind_after_seconds"`
+			TeamName             string `json:"team_name"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersDigestResponse parses an HTTP response from a GetUsersDigestWithResponse call
+func ParseGetUsersDigestResponse(rsp *http.Response) (*GetUsersDigestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersDigestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersGetNotificationPreferencesResponse parses an HTTP response from a GetUsersGetNotificationPreferencesWithResponse call
+func ParseGetUsersGetNotificationPreferencesResponse(rsp *http.Response) (*GetUsersGetNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersGetNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersGetReviewResponse parses an HTTP response from a GetUsersGetReviewWithResponse call
+func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersGetReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			MaxOpenReviews *int               `json:"max_open_reviews"`
+			OpenReviews    *int               `json:"open_reviews,omitempty"`
+			PullRequests   []PullRequestShort `json:"pull_requests"`
+			UserId         string             `json:"user_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetIsActiveResponse parses an HTTP response from a PostUsersSetIsActiveWithResponse call
+func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetIsActiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Filled PR команды, получившие ревьюверов, только при повторной активации
+			Filled *[]FilledPullRequest `json:"filled,omitempty"`
+			User   *User                `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetMaxOpenReviewsResponse parses an HTTP response from a PostUsersSetMaxOpenReviewsWithResponse call
+func ParsePostUsersSetMaxOpenReviewsResponse(rsp *http.Response) (*PostUsersSetMaxOpenReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetMaxOpenReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User *User `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetNotificationPreferencesResponse parses an HTTP response from a PostUsersSetNotificationPreferencesWithResponse call
+func ParsePostUsersSetNotificationPreferencesResponse(rsp *http.Response) (*PostUsersSetNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetOutOfOfficeResponse parses an HTTP response from a PostUsersSetOutOfOfficeWithResponse call
+func ParsePostUsersSetOutOfOfficeResponse(rsp *http.Response) (*PostUsersSetOutOfOfficeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetOutOfOfficeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User *User `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,29 @@
+// Package client is a Go client for the review-assigner REST API. The types
+// and methods in client.gen.go are generated from openapi.yaml by
+// `make update-schema`, don't edit them by hand.
+//
+// Error responses are turned into *Error by CheckResponse, and WithRetry
+// makes the client retry idempotent calls.
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// WithToken sends the API token with every request
+func WithToken(token string) ClientOption {
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// WithOrg picks the organization for tokens that aren't bound to one
+func WithOrg(id int) ClientOption {
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("X-Org-ID", strconv.Itoa(id))
+		return nil
+	})
+}
@@ -0,0 +1,643 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Traunin/review-assigner/internal/api/auth"
+	"github.com/Traunin/review-assigner/internal/api/handlers"
+	"github.com/Traunin/review-assigner/internal/application/dto"
+	"github.com/Traunin/review-assigner/internal/application/services"
+	"github.com/Traunin/review-assigner/internal/domain/entities"
+	domainservices "github.com/Traunin/review-assigner/internal/domain/services"
+	"github.com/Traunin/review-assigner/internal/events"
+	"github.com/Traunin/review-assigner/internal/infrastructure/db/memory"
+	"github.com/Traunin/review-assigner/internal/ratelimit"
+	"github.com/Traunin/review-assigner/pkg/client"
+	"github.com/labstack/echo/v4"
+)
+
+func TestMain(m *testing.M) {
+	// handlers log through the default logger
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	os.Exit(m.Run())
+}
+
+// testServer is the REST API wired to in-memory storage the way serve.go
+// wires it to postgres. Stats and readiness need postgres and aren't served.
+type testServer struct {
+	handler http.Handler
+	auth    services.AuthService
+}
+
+func newTestServer(t *testing.T, limit ratelimit.Limit) *testServer {
+	t.Helper()
+
+	store := memory.NewStore()
+	userRepo := memory.NewUserRepository(store)
+	teamRepo := memory.NewTeamRepository(store)
+	prRepo := memory.NewPullRequestRepository(store)
+	orgRepo := memory.NewOrganizationRepository(store)
+
+	assignmentService := domainservices.NewReviewerAssignmentService(
+		userRepo,
+		prRepo,
+		teamRepo,
+		entities.MaxReviewers,
+		nil,
+	)
+	bus := events.NewBus(16)
+	t.Cleanup(bus.Close)
+
+	server := handlers.NewServer(
+		services.NewTeamService(teamRepo, userRepo, memory.NewReviewReminderRepository(store)),
+		services.NewPullRequestService(prRepo, assignmentService, entities.MaxReviewers),
+		nil,
+		nil,
+		services.NewNotificationService(userRepo, memory.NewNotificationPreferenceRepository(store)),
+		services.NewBulkService(teamRepo, userRepo, prRepo, memory.NewBulkRepository(store)),
+		bus,
+		userRepo,
+		teamRepo,
+		prRepo,
+		nil,
+	)
+	authService := services.NewAuthService(memory.NewTokenRepository(store), userRepo, orgRepo)
+
+	e := echo.New()
+	e.HideBanner = true
+	e.Logger.SetOutput(io.Discard)
+	handlers.RegisterRoutes(e, server, []echo.MiddlewareFunc{
+		auth.Middleware(authService),
+		auth.Tenant(orgRepo),
+		ratelimit.Middleware(ratelimit.NewMemoryStore(), limit, nil),
+	})
+
+	return &testServer{handler: e, auth: authService}
+}
+
+// token issues a secret, member tokens act as userID
+func (s *testServer) token(t *testing.T, role entities.Role, userID string) string {
+	t.Helper()
+
+	cmd := dto.CreateTokenCmd{Name: string(role) + userID, Role: role}
+	if userID != "" {
+		id := entities.UserID(userID)
+		cmd.UserID = &id
+	}
+	created, err := s.auth.CreateToken(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("CreateToken(%s): %v", role, err)
+	}
+	return created.Secret
+}
+
+func newClient(t *testing.T, url string, opts ...client.ClientOption) *client.ClientWithResponses {
+	t.Helper()
+
+	c, err := client.NewClientWithResponses(url, opts...)
+	if err != nil {
+		t.Fatalf("NewClientWithResponses: %v", err)
+	}
+	return c
+}
+
+// noLimit is never reached by a test
+var noLimit = ratelimit.Limit{Rate: 1000, Burst: 1000}
+
+var backend = client.Team{
+	TeamName: "backend",
+	Members: []client.TeamMember{
+		{UserId: "u1", Username: "alice", IsActive: true},
+		{UserId: "u2", Username: "bob", IsActive: true},
+		{UserId: "u3", Username: "carol", IsActive: true},
+	},
+}
+
+func createPR(id, author string) client.PostPullRequestCreateJSONRequestBody {
+	return client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   id,
+		PullRequestName: "change " + id,
+		AuthorId:        author,
+	}
+}
+
+func TestClientTypedErrors(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t, noLimit)
+	httpSrv := httptest.NewServer(srv.handler)
+	defer httpSrv.Close()
+
+	admin := newClient(t, httpSrv.URL, client.WithToken(srv.token(t, entities.RoleAdmin, "")))
+
+	team, err := admin.PostTeamAddWithResponse(ctx, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CheckResponse(team.HTTPResponse, team.Body); err != nil {
+		t.Fatalf("add team: %v", err)
+	}
+
+	// u1 authors pr-1, u2 and u3 are the only candidates and get assigned
+	pr, err := admin.PostPullRequestCreateWithResponse(ctx, createPR("pr-1", "u1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CheckResponse(pr.HTTPResponse, pr.Body); err != nil {
+		t.Fatalf("create pr-1: %v", err)
+	}
+
+	member := newClient(t, httpSrv.URL, client.WithToken(srv.token(t, entities.RoleMember, "u1")))
+	anonymous := newClient(t, httpSrv.URL)
+
+	tests := []struct {
+		name string
+		call func() error
+		want *client.Error
+	}{
+		{
+			name: "team exists",
+			call: func() error {
+				r, err := admin.PostTeamAddWithResponse(ctx, backend)
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			want: client.ErrTeamExists,
+		},
+		{
+			name: "team not found",
+			call: func() error {
+				r, err := admin.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: "frontend"})
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			want: client.ErrNotFound,
+		},
+		{
+			name: "pull request exists",
+			call: func() error {
+				r, err := admin.PostPullRequestCreateWithResponse(ctx, createPR("pr-1", "u1"))
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			want: client.ErrPRExists,
+		},
+		{
+			name: "not assigned",
+			call: func() error {
+				r, err := admin.PostPullRequestReassignWithResponse(ctx, client.PostPullRequestReassignJSONRequestBody{
+					PullRequestId: "pr-1",
+					OldUserId:     "u1",
+				})
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			want: client.ErrNotAssigned,
+		},
+		{
+			name: "no candidate",
+			call: func() error {
+				r, err := admin.PostPullRequestReassignWithResponse(ctx, client.PostPullRequestReassignJSONRequestBody{
+					PullRequestId: "pr-1",
+					OldUserId:     "u2",
+				})
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			want: client.ErrNoCandidate,
+		},
+		{
+			name: "invalid request",
+			call: func() error {
+				r, err := admin.PostPullRequestAddReviewerWithResponse(ctx, client.PostPullRequestAddReviewerJSONRequestBody{})
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			want: client.ErrInvalidRequest,
+		},
+		{
+			name: "max open reviews out of range",
+			call: func() error {
+				max := 1 << 31
+				r, err := admin.PostUsersSetMaxOpenReviewsWithResponse(ctx, client.PostUsersSetMaxOpenReviewsJSONRequestBody{
+					UserId:         "u2",
+					MaxOpenReviews: &max,
+				})
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			want: client.ErrInvalidRequest,
+		},
+		{
+			name: "no token",
+			call: func() error {
+				r, err := anonymous.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: "backend"})
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			want: client.ErrUnauthorized,
+		},
+		{
+			name: "member on an admin route",
+			call: func() error {
+				r, err := member.PostTeamAddWithResponse(ctx, client.Team{TeamName: "frontend"})
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			want: client.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertAPIError(t, tt.call, tt.want)
+		})
+	}
+
+	merge, err := admin.PostPullRequestMergeWithResponse(ctx, client.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: "pr-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CheckResponse(merge.HTTPResponse, merge.Body); err != nil {
+		t.Fatalf("merge pr-1: %v", err)
+	}
+	t.Run("merged", func(t *testing.T) {
+		assertAPIError(t, func() error {
+			r, err := admin.PostPullRequestReassignWithResponse(ctx, client.PostPullRequestReassignJSONRequestBody{
+				PullRequestId: "pr-1",
+				OldUserId:     "u2",
+			})
+			if err != nil {
+				return err
+			}
+			return client.CheckResponse(r.HTTPResponse, r.Body)
+		}, client.ErrPRMerged)
+	})
+}
+
+// assertAPIError checks the typed error, errors.Is only compares codes so
+// the status is checked too
+func assertAPIError(t *testing.T, call func() error, want *client.Error) {
+	t.Helper()
+
+	err := call()
+	if !errors.Is(err, want) {
+		t.Fatalf("got %v, want %v", err, want)
+	}
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode == 0 {
+		t.Fatalf("error %v doesn't carry the response status", err)
+	}
+}
+
+// flaky answers the first failures requests after being armed with status
+// before passing the rest on
+type flaky struct {
+	next     http.Handler
+	status   int
+	failures int32
+	armed    atomic.Bool
+	seen     atomic.Int32
+}
+
+func (f *flaky) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !f.armed.Load() {
+		f.next.ServeHTTP(w, r)
+		return
+	}
+	if f.seen.Add(1) <= f.failures {
+		http.Error(w, http.StatusText(f.status), f.status)
+		return
+	}
+	f.next.ServeHTTP(w, r)
+}
+
+func TestClientRetry(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		status   int
+		failures int32
+		// call is a request that succeeds once it gets through
+		call     func(c *client.ClientWithResponses) error
+		wantOK   bool
+		wantSeen int32
+	}{
+		{
+			name:     "idempotent call retried on 503",
+			status:   http.StatusServiceUnavailable,
+			failures: 2,
+			call: func(c *client.ClientWithResponses) error {
+				r, err := c.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: "backend"})
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			wantOK:   true,
+			wantSeen: 3,
+		},
+		{
+			name:     "gives up after the attempts",
+			status:   http.StatusServiceUnavailable,
+			failures: 5,
+			call: func(c *client.ClientWithResponses) error {
+				r, err := c.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: "backend"})
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			wantSeen: 3,
+		},
+		{
+			name:     "create isn't retried on 503",
+			status:   http.StatusServiceUnavailable,
+			failures: 1,
+			call: func(c *client.ClientWithResponses) error {
+				r, err := c.PostPullRequestCreateWithResponse(ctx, createPR("pr-1", "u1"))
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			wantSeen: 1,
+		},
+		{
+			name:     "create retried on 429",
+			status:   http.StatusTooManyRequests,
+			failures: 1,
+			call: func(c *client.ClientWithResponses) error {
+				r, err := c.PostPullRequestCreateWithResponse(ctx, createPR("pr-1", "u1"))
+				if err != nil {
+					return err
+				}
+				return client.CheckResponse(r.HTTPResponse, r.Body)
+			},
+			wantOK:   true,
+			wantSeen: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestServer(t, noLimit)
+			f := &flaky{next: srv.handler, status: tt.status, failures: tt.failures}
+			httpSrv := httptest.NewServer(f)
+			defer httpSrv.Close()
+
+			c := newClient(t, httpSrv.URL,
+				client.WithToken(srv.token(t, entities.RoleAdmin, "")),
+				client.WithRetry(3, time.Millisecond),
+			)
+			team, err := c.PostTeamAddWithResponse(ctx, backend)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := client.CheckResponse(team.HTTPResponse, team.Body); err != nil {
+				t.Fatalf("add team: %v", err)
+			}
+
+			f.armed.Store(true)
+			err = tt.call(c)
+			var apiErr *client.Error
+			switch {
+			case tt.wantOK && err != nil:
+				t.Errorf("got %v, want success", err)
+			case !tt.wantOK && (!errors.As(err, &apiErr) || apiErr.StatusCode != tt.status):
+				t.Errorf("got %v, want status %d", err, tt.status)
+			}
+			if got := f.seen.Load(); got != tt.wantSeen {
+				t.Errorf("server saw %d requests, want %d", got, tt.wantSeen)
+			}
+		})
+	}
+}
+
+func TestClientRateLimited(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t, ratelimit.Limit{Rate: 10, Burst: 1})
+	httpSrv := httptest.NewServer(srv.handler)
+	defer httpSrv.Close()
+
+	secret := srv.token(t, entities.RoleAdmin, "")
+	c := newClient(t, httpSrv.URL, client.WithToken(secret))
+
+	// the first call takes the only token, whatever it answers
+	if _, err := c.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: "backend"}); err != nil {
+		t.Fatal(err)
+	}
+	assertAPIError(t, func() error {
+		r, err := c.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: "backend"})
+		if err != nil {
+			return err
+		}
+		return client.CheckResponse(r.HTTPResponse, r.Body)
+	}, client.ErrRateLimited)
+
+	// the limiter asks for a retry after a second, by then a token is back
+	retrying := newClient(t, httpSrv.URL, client.WithToken(secret), client.WithRetry(2, time.Millisecond))
+	team, err := retrying.PostTeamAddWithResponse(ctx, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CheckResponse(team.HTTPResponse, team.Body); err != nil {
+		t.Fatalf("add team after being rate limited: %v", err)
+	}
+}
+
+func TestClientReactivationFillsTeam(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t, noLimit)
+	httpSrv := httptest.NewServer(srv.handler)
+	defer httpSrv.Close()
+
+	admin := newClient(t, httpSrv.URL, client.WithToken(srv.token(t, entities.RoleAdmin, "")))
+
+	// nobody but the authors is active, both pull requests start without
+	// reviewers
+	for _, team := range []client.Team{
+		{TeamName: "backend", Members: []client.TeamMember{
+			{UserId: "u1", Username: "alice", IsActive: true},
+			{UserId: "u2", Username: "bob", IsActive: false},
+		}},
+		{TeamName: "frontend", Members: []client.TeamMember{
+			{UserId: "u3", Username: "carol", IsActive: true},
+			{UserId: "u4", Username: "dave", IsActive: false},
+		}},
+	} {
+		r, err := admin.PostTeamAddWithResponse(ctx, team)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.CheckResponse(r.HTTPResponse, r.Body); err != nil {
+			t.Fatalf("add team %s: %v", team.TeamName, err)
+		}
+	}
+	for _, body := range []client.PostPullRequestCreateJSONRequestBody{
+		createPR("pr-1", "u1"),
+		createPR("pr-2", "u3"),
+	} {
+		r, err := admin.PostPullRequestCreateWithResponse(ctx, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.CheckResponse(r.HTTPResponse, r.Body); err != nil {
+			t.Fatalf("create %s: %v", body.PullRequestId, err)
+		}
+	}
+
+	setActive := func(userID string, active bool) *client.PostUsersSetIsActiveResponse {
+		t.Helper()
+		r, err := admin.PostUsersSetIsActiveWithResponse(ctx, client.PostUsersSetIsActiveJSONRequestBody{
+			UserId:   userID,
+			IsActive: active,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.CheckResponse(r.HTTPResponse, r.Body); err != nil {
+			t.Fatalf("set %s active %t: %v", userID, active, err)
+		}
+		return r
+	}
+
+	// only pr-1 is by a backend author
+	r := setActive("u2", true)
+	if r.JSON200.Filled == nil {
+		t.Fatal("reactivation filled nothing")
+	}
+	filled := *r.JSON200.Filled
+	if len(filled) != 1 || filled[0].Pr.PullRequestId != "pr-1" || !slices.Equal(filled[0].Added, []string{"u2"}) {
+		t.Fatalf("filled %+v, want u2 added to pr-1", filled)
+	}
+
+	list, err := admin.GetPullRequestListWithResponse(ctx, &client.GetPullRequestListParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CheckResponse(list.HTTPResponse, list.Body); err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	want := map[string][]string{"pr-1": {"u2"}, "pr-2": {}}
+	for _, pr := range list.JSON200.PullRequests {
+		if !slices.Equal(pr.AssignedReviewers, want[pr.PullRequestId]) {
+			t.Errorf("%s reviewers %v, want %v", pr.PullRequestId, pr.AssignedReviewers, want[pr.PullRequestId])
+		}
+	}
+
+	// only a false to true change fills
+	if r := setActive("u2", true); r.JSON200.Filled != nil {
+		t.Errorf("already active user filled %+v", *r.JSON200.Filled)
+	}
+	if r := setActive("u4", false); r.JSON200.Filled != nil {
+		t.Errorf("deactivation filled %+v", *r.JSON200.Filled)
+	}
+}
+
+func TestClientOutOfOffice(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t, noLimit)
+	httpSrv := httptest.NewServer(srv.handler)
+	defer httpSrv.Close()
+
+	admin := newClient(t, httpSrv.URL, client.WithToken(srv.token(t, entities.RoleAdmin, "")))
+	team, err := admin.PostTeamAddWithResponse(ctx, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CheckResponse(team.HTTPResponse, team.Body); err != nil {
+		t.Fatalf("add team: %v", err)
+	}
+
+	setAway := func(userID string, until *time.Time) *client.PostUsersSetOutOfOfficeResponse {
+		t.Helper()
+		r, err := admin.PostUsersSetOutOfOfficeWithResponse(ctx, client.PostUsersSetOutOfOfficeJSONRequestBody{
+			UserId: userID,
+			Until:  until,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	preview := func() client.AssignmentPreview {
+		t.Helper()
+		r, err := admin.PostPullRequestPreviewAssignmentWithResponse(ctx, client.PostPullRequestPreviewAssignmentJSONRequestBody{
+			AuthorId: "u1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.CheckResponse(r.HTTPResponse, r.Body); err != nil {
+			t.Fatalf("preview: %v", err)
+		}
+		return *r.JSON200
+	}
+
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	r := setAway("u2", &until)
+	if err := client.CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		t.Fatalf("set away: %v", err)
+	}
+	if got := r.JSON200.User.OutOfOfficeUntil; got == nil || !got.Equal(until) {
+		t.Errorf("out_of_office_until %v, want %v", got, until)
+	}
+
+	p := preview()
+	if !slices.Equal(p.Candidates, []string{"u3"}) {
+		t.Errorf("candidates %v, want [u3]", p.Candidates)
+	}
+	wantExcluded := []client.Exclusion{
+		{UserId: "u1", Reason: client.Author},
+		{UserId: "u2", Reason: client.OutOfOffice},
+	}
+	if !slices.Equal(p.Excluded, wantExcluded) {
+		t.Errorf("excluded %v, want %v", p.Excluded, wantExcluded)
+	}
+
+	// null brings the user back
+	r = setAway("u2", nil)
+	if err := client.CheckResponse(r.HTTPResponse, r.Body); err != nil {
+		t.Fatalf("clear away: %v", err)
+	}
+	if r.JSON200.User.OutOfOfficeUntil != nil {
+		t.Errorf("out_of_office_until %v after clearing", r.JSON200.User.OutOfOfficeUntil)
+	}
+	if p := preview(); !slices.Equal(p.Candidates, []string{"u2", "u3"}) {
+		t.Errorf("candidates %v after clearing, want [u2 u3]", p.Candidates)
+	}
+
+	assertAPIError(t, func() error {
+		r := setAway("u9", &until)
+		return client.CheckResponse(r.HTTPResponse, r.Body)
+	}, client.ErrNotFound)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Error is an API error response. Compare it against the sentinels below
+// with errors.Is, which matches on the code.
+type Error struct {
+	// StatusCode is the HTTP status, 0 for the sentinels
+	StatusCode int
+	// Code is empty when the body wasn't an ErrorResponse, e.g. a proxy page
+	Code    ErrorResponseErrorCode
+	Message string
+}
+
+var (
+	ErrTeamExists     = &Error{Code: TEAMEXISTS, Message: "team already exists"}
+	ErrPRExists       = &Error{Code: PREXISTS, Message: "pull request already exists"}
+	ErrPRMerged       = &Error{Code: PRMERGED, Message: "pull request is merged"}
+	ErrNotAssigned    = &Error{Code: NOTASSIGNED, Message: "reviewer is not assigned"}
+	ErrNoCandidate    = &Error{Code: NOCANDIDATE, Message: "no active replacement candidate"}
+	ErrAtCapacity     = &Error{Code: ATCAPACITY, Message: "every replacement candidate is at capacity"}
+	ErrAssigned       = &Error{Code: ALREADYASSIGNED, Message: "reviewer is already assigned"}
+	ErrTooMany        = &Error{Code: TOOMANYREVIEWERS, Message: "pull request has enough reviewers"}
+	ErrInvalidPick    = &Error{Code: INVALIDREVIEWER, Message: "user can't review this pull request"}
+	ErrNotFound       = &Error{Code: NOTFOUND, Message: "resource not found"}
+	ErrInvalidRequest = &Error{Code: INVALIDREQUEST, Message: "invalid request"}
+	ErrUnauthorized   = &Error{Code: UNAUTHORIZED, Message: "unauthorized"}
+	ErrForbidden      = &Error{Code: FORBIDDEN, Message: "forbidden"}
+	ErrRateLimited    = &Error{Code: RATELIMITED, Message: "rate limit exceeded"}
+	ErrInternal       = &Error{Code: INTERNALERROR, Message: "internal error"}
+)
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("unexpected response %d %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code != "" && t.Code == e.Code
+}
+
+// CheckResponse returns nil for 2xx responses and an *Error otherwise. Pass
+// it the HTTPResponse and Body of any ...WithResponse result.
+func CheckResponse(resp *http.Response, body []byte) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var apiErr ErrorResponse
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Error.Code != "" {
+		return &Error{
+			StatusCode: resp.StatusCode,
+			Code:       apiErr.Error.Code,
+			Message:    apiErr.Error.Message,
+		}
+	}
+	return &Error{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+}
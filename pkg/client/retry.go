@@ -0,0 +1,127 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// idempotentPosts are POST endpoints that may be repeated without changing
+// the outcome
+var idempotentPosts = []string{
+	"/pullRequest/merge",
+	"/pullRequest/fillReviewers",
+	"/pullRequest/previewAssignment",
+	"/users/setIsActive",
+	"/users/setMaxOpenReviews",
+	"/users/setOutOfOffice",
+	"/users/setNotificationPreferences",
+	"/team/setReviewSLA",
+}
+
+// WithRetry retries failed requests up to attempts times in total, waiting
+// backoff before the first retry and doubling it after each. Idempotent
+// calls are retried on network errors and 502, 503 or 504; any call is
+// retried on 429, since the server rejected it before handling it, and
+// Retry-After is honored. It wraps the HTTP client set by the options before
+// it, so pass WithHTTPClient first.
+func WithRetry(attempts int, backoff time.Duration) ClientOption {
+	return func(c *Client) error {
+		if attempts < 1 {
+			return errors.New("client: retry attempts must be at least 1")
+		}
+		if backoff <= 0 {
+			return errors.New("client: retry backoff must be positive")
+		}
+
+		next := c.Client
+		if next == nil {
+			next = &http.Client{}
+		}
+		c.Client = &retryDoer{next: next, attempts: attempts, backoff: backoff}
+		return nil
+	}
+}
+
+type retryDoer struct {
+	next     HttpRequestDoer
+	attempts int
+	backoff  time.Duration
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	idempotent := isIdempotent(req)
+	wait := d.backoff
+
+	for attempt := 1; ; attempt++ {
+		resp, err := d.next.Do(req)
+
+		retry := false
+		switch {
+		case err != nil:
+			retry = idempotent && ctx.Err() == nil
+		case resp.StatusCode == http.StatusTooManyRequests:
+			retry = true
+		case resp.StatusCode == http.StatusBadGateway,
+			resp.StatusCode == http.StatusServiceUnavailable,
+			resp.StatusCode == http.StatusGatewayTimeout:
+			retry = idempotent
+		}
+		if !retry || attempt == d.attempts || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		delay := wait
+		if resp != nil {
+			delay = max(delay, retryAfter(resp))
+			// the connection is reused only if the body is read to the end
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		wait *= 2
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+	}
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		for _, path := range idempotentPosts {
+			if strings.HasSuffix(req.URL.Path, path) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// retryAfter reads the Retry-After header in seconds, 0 when absent
+func retryAfter(resp *http.Response) time.Duration {
+	secs, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || secs < 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
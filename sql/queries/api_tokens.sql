@@ -0,0 +1,19 @@
+-- name: CreateAPIToken :one
+INSERT INTO api_tokens (name, token_hash, role, user_id, org_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, name, role, user_id, org_id, created_at, revoked_at;
+
+-- name: GetActiveAPITokenByHash :one
+SELECT id, name, role, user_id, org_id, created_at, revoked_at
+FROM api_tokens
+WHERE token_hash = $1 AND revoked_at IS NULL;
+
+-- name: GetAPITokens :many
+SELECT id, name, role, user_id, org_id, created_at, revoked_at
+FROM api_tokens
+ORDER BY id;
+
+-- name: RevokeAPIToken :execrows
+UPDATE api_tokens
+SET revoked_at = NOW()
+WHERE id = $1 AND revoked_at IS NULL;
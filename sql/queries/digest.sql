@@ -0,0 +1,9 @@
+-- name: ClaimDigestRun :one
+-- returns false when the day's digest was already claimed
+WITH claimed AS (
+    INSERT INTO digest_runs (org_id, run_date)
+    VALUES ($1, $2)
+    ON CONFLICT DO NOTHING
+    RETURNING 1
+)
+SELECT EXISTS (SELECT 1 FROM claimed);
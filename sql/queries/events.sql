@@ -0,0 +1,2 @@
+-- name: PublishEvent :exec
+SELECT pg_notify(@channel::text, @payload::text);
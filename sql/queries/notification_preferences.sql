@@ -0,0 +1,13 @@
+-- name: GetNotificationPreferences :many
+SELECT channel, address, enabled
+FROM notification_preferences
+WHERE org_id = $1 AND user_id = $2
+ORDER BY channel;
+
+-- name: DeleteNotificationPreferences :exec
+DELETE FROM notification_preferences
+WHERE org_id = $1 AND user_id = $2;
+
+-- name: AddNotificationPreference :exec
+INSERT INTO notification_preferences (org_id, user_id, channel, address, enabled)
+VALUES ($1, $2, $3, $4, $5);
@@ -0,0 +1,14 @@
+-- name: CreateOrganization :one
+INSERT INTO organizations (name)
+VALUES ($1)
+RETURNING id, name, created_at;
+
+-- name: GetOrganizationByID :one
+SELECT id, name, created_at
+FROM organizations
+WHERE id = $1;
+
+-- name: GetOrganizations :many
+SELECT id, name, created_at
+FROM organizations
+ORDER BY id;
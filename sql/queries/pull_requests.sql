@@ -1,13 +1,14 @@
 -- name: CreatePullRequest :one
 INSERT INTO pull_requests (
+    org_id,
     pull_request_id, 
     pull_request_name, 
     author_id, 
     status,
     created_at
 )
-VALUES ($1, $2, $3, $4, $5)
-RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at;
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at, org_id;
 
 -- name: GetPullRequestByID :one
 SELECT 
@@ -16,21 +17,22 @@ SELECT
     author_id, 
     status,
     created_at,
-    merged_at
+    merged_at,
+    org_id
 FROM pull_requests
-WHERE pull_request_id = $1;
+WHERE org_id = $1 AND pull_request_id = $2;
 
 -- name: UpdatePRStatus :one
 UPDATE pull_requests
 SET 
-    status = $2,
-    merged_at = $3
-WHERE pull_request_id = $1
-RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at;
+    status = $3,
+    merged_at = $4
+WHERE org_id = $1 AND pull_request_id = $2
+RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at, org_id;
 
 -- name: PRExists :one
 SELECT EXISTS(
-    SELECT 1 FROM pull_requests WHERE pull_request_id = $1
+    SELECT 1 FROM pull_requests WHERE org_id = $1 AND pull_request_id = $2
 );
 
 -- name: GetPRsByAuthor :many
@@ -40,9 +42,10 @@ SELECT
     author_id, 
     status,
     created_at,
-    merged_at
+    merged_at,
+    org_id
 FROM pull_requests
-WHERE author_id = $1
+WHERE org_id = $1 AND author_id = $2
 ORDER BY created_at DESC;
 
 -- name: GetOpenPRs :many
@@ -52,14 +55,30 @@ SELECT
     author_id, 
     status,
     created_at,
-    merged_at
+    merged_at,
+    org_id
 FROM pull_requests
-WHERE status = 'OPEN'
+WHERE org_id = $1 AND status = 'OPEN'
+ORDER BY created_at DESC;
+
+-- name: GetOpenPRsByTeamID :many
+SELECT 
+    pull_request_id, 
+    pull_request_name, 
+    author_id, 
+    status,
+    created_at,
+    merged_at,
+    org_id
+FROM pull_requests pr
+WHERE pr.org_id = $1 AND pr.status = 'OPEN' AND pr.author_id IN (
+    SELECT u.user_id FROM users u WHERE u.org_id = $1 AND u.team_id = $2
+)
 ORDER BY created_at DESC;
 
 -- name: DeletePullRequest :exec
 DELETE FROM pull_requests
-WHERE pull_request_id = $1;
+WHERE org_id = $1 AND pull_request_id = $2;
 
 -- name: GetPullRequests :many
 SELECT 
@@ -68,6 +87,8 @@ SELECT
     author_id, 
     status,
     created_at,
-    merged_at
+    merged_at,
+    org_id
 FROM pull_requests
+WHERE org_id = $1
 ORDER BY created_at DESC;
@@ -0,0 +1,17 @@
+-- name: TakeRateLimitToken :one
+-- returns no rows when the bucket is empty
+INSERT INTO rate_limit_buckets AS b (bucket_key, tat)
+VALUES (@bucket_key, NOW() + make_interval(secs => @interval_seconds::float8))
+ON CONFLICT (bucket_key) DO UPDATE
+SET tat = GREATEST(b.tat, NOW()) + make_interval(secs => @interval_seconds::float8)
+WHERE GREATEST(b.tat, NOW()) - NOW() <= make_interval(secs => @tolerance_seconds::float8)
+RETURNING tat;
+
+-- name: GetRateLimitWait :one
+SELECT EXTRACT(EPOCH FROM GREATEST(tat, NOW()) - NOW())::float8 AS wait_seconds
+FROM rate_limit_buckets
+WHERE bucket_key = $1;
+
+-- name: DeleteIdleRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets
+WHERE tat < NOW() - make_interval(secs => @idle_seconds::float8);
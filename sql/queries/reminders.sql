@@ -0,0 +1,34 @@
+-- name: GetOverdueReviews :many
+-- reviewers of open pull requests who gave no verdict within their team's
+-- reminder threshold, the team is the author's
+SELECT
+    rev.pull_request_id,
+    pr.pull_request_name,
+    pr.author_id,
+    rev.user_id,
+    rev.assigned_at,
+    rev.reminded_at,
+    COALESCE(t.team_name, '')::text AS team_name,
+    COALESCE(t.remind_after_seconds, @default_remind_after_seconds::integer)::integer AS remind_after_seconds,
+    COALESCE(t.reassign_after_seconds, @default_reassign_after_seconds::integer)::integer AS reassign_after_seconds
+FROM reviewers rev
+JOIN pull_requests pr ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+LEFT JOIN users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id
+LEFT JOIN teams t ON t.org_id = u.org_id AND t.id = u.team_id
+WHERE rev.org_id = @org_id
+    AND pr.status = 'OPEN'
+    AND rev.verdict IS NULL
+    AND rev.assigned_at <= NOW() - make_interval(
+        secs => COALESCE(t.remind_after_seconds, @default_remind_after_seconds::integer)
+    )
+ORDER BY rev.assigned_at;
+
+-- name: MarkReviewerReminded :exec
+UPDATE reviewers
+SET reminded_at = $4
+WHERE org_id = $1 AND pull_request_id = $2 AND user_id = $3;
+
+-- name: SetTeamReviewSLA :exec
+UPDATE teams
+SET remind_after_seconds = $3, reassign_after_seconds = $4
+WHERE org_id = $1 AND id = $2;
@@ -1,15 +1,39 @@
 -- name: AddReviewer :exec
-INSERT INTO reviewers (pull_request_id, user_id, assigned_at)
-VALUES ($1, $2, $3);
+INSERT INTO reviewers (org_id, pull_request_id, user_id, assigned_at, selection_seed, assigned_by)
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: RemoveReviewer :exec
-DELETE FROM reviewers
-WHERE pull_request_id = $1 AND user_id = $2;
+-- moves the assignment to reviewer_removals with who removed it
+WITH removed AS (
+    DELETE FROM reviewers r
+    WHERE r.org_id = @org_id AND r.pull_request_id = @pull_request_id AND r.user_id = @user_id
+    RETURNING r.org_id, r.pull_request_id, r.user_id, r.assigned_at, r.assigned_by
+)
+INSERT INTO reviewer_removals (
+    org_id,
+    pull_request_id,
+    user_id,
+    assigned_at,
+    assigned_by,
+    replaced_by,
+    removed_by,
+    removed_at
+)
+SELECT
+    removed.org_id,
+    removed.pull_request_id,
+    removed.user_id,
+    removed.assigned_at,
+    removed.assigned_by,
+    sqlc.narg('replaced_by')::varchar,
+    sqlc.narg('removed_by')::varchar,
+    COALESCE(sqlc.narg('removed_at')::timestamptz, NOW())
+FROM removed;
 
 -- name: GetReviewersByPR :many
-SELECT user_id, assigned_at
+SELECT user_id, assigned_at, verdict, verdict_at, selection_seed, assigned_by
 FROM reviewers
-WHERE pull_request_id = $1
+WHERE org_id = $1 AND pull_request_id = $2
 ORDER BY assigned_at;
 
 -- name: GetPRsByReviewer :many
@@ -19,27 +43,48 @@ SELECT
     pr.author_id,
     pr.status
 FROM pull_requests pr
-JOIN reviewers rev ON pr.pull_request_id = rev.pull_request_id
-WHERE rev.user_id = $1
+JOIN reviewers rev
+    ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+WHERE rev.org_id = $1 AND rev.user_id = $2
 ORDER BY pr.created_at DESC;
 
+-- name: SetReviewerVerdict :exec
+UPDATE reviewers
+SET verdict = $4, verdict_at = $5
+WHERE org_id = $1 AND pull_request_id = $2 AND user_id = $3;
+
 -- name: IsUserReviewer :one
 SELECT EXISTS(
     SELECT 1 
     FROM reviewers 
-    WHERE pull_request_id = $1 AND user_id = $2
+    WHERE org_id = $1 AND pull_request_id = $2 AND user_id = $3
 );
 
 -- name: GetReviewerCount :one
 SELECT COUNT(*)
 FROM reviewers
-WHERE pull_request_id = $1;
+WHERE org_id = $1 AND pull_request_id = $2;
 
 -- name: ReplaceReviewer :exec
 WITH deleted AS (
     DELETE FROM reviewers r
-    WHERE r.pull_request_id = $1 AND r.user_id = $2
+    WHERE r.org_id = $1 AND r.pull_request_id = $2 AND r.user_id = $3
 )
-INSERT INTO reviewers (pull_request_id, user_id, assigned_at)
-VALUES ($1, $3, $4)
+INSERT INTO reviewers (org_id, pull_request_id, user_id, assigned_at)
+VALUES ($1, $2, $4, $5)
 ON CONFLICT DO NOTHING;
+
+-- name: CountOpenReviewsByUser :many
+SELECT rev.user_id, COUNT(*) AS open_reviews
+FROM reviewers rev
+JOIN pull_requests pr
+    ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+WHERE rev.org_id = $1 AND pr.status = 'OPEN'
+GROUP BY rev.user_id;
+
+-- name: CountOpenReviewsForUser :one
+SELECT COUNT(*) AS open_reviews
+FROM reviewers rev
+JOIN pull_requests pr
+    ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+WHERE rev.org_id = $1 AND rev.user_id = $2 AND pr.status = 'OPEN';
@@ -0,0 +1,219 @@
+-- SLA queries return one row per scope: 'overall', then one per 'team'
+-- (the author's team) and one per 'reviewer'. Durations are in seconds,
+-- the window filters pull requests by creation time.
+
+-- name: SLATimeToMerge :many
+WITH samples AS (
+    SELECT
+        pr.pull_request_id,
+        t.team_name,
+        EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::float8 AS seconds
+    FROM pull_requests pr
+    JOIN users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id
+    LEFT JOIN teams t ON t.org_id = u.org_id AND t.id = u.team_id
+    WHERE pr.org_id = @org_id
+        AND pr.status = 'MERGED'
+        AND (sqlc.narg(window_start)::timestamptz IS NULL OR pr.created_at >= sqlc.narg(window_start)::timestamptz)
+        AND (sqlc.narg(window_end)::timestamptz IS NULL OR pr.created_at < sqlc.narg(window_end)::timestamptz)
+)
+SELECT
+    'overall'::text AS scope,
+    ''::text AS key,
+    COUNT(*) AS samples,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds)::float8 AS p50,
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds)::float8 AS p90,
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY seconds)::float8 AS p99
+FROM samples
+HAVING COUNT(*) > 0
+UNION ALL
+SELECT
+    'team',
+    s.team_name,
+    COUNT(*),
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY s.seconds)
+FROM samples s
+WHERE s.team_name IS NOT NULL
+GROUP BY s.team_name
+UNION ALL
+SELECT
+    'reviewer',
+    rev.user_id,
+    COUNT(*),
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY s.seconds)
+FROM samples s
+JOIN reviewers rev ON rev.org_id = @org_id AND rev.pull_request_id = s.pull_request_id
+GROUP BY rev.user_id;
+
+-- name: SLATimeToFirstReview :many
+-- the reviewer breakdown credits whoever responded first
+WITH samples AS (
+    SELECT
+        t.team_name,
+        first_review.user_id,
+        EXTRACT(EPOCH FROM first_review.verdict_at - pr.created_at)::float8 AS seconds
+    FROM pull_requests pr
+    JOIN LATERAL (
+        SELECT r.user_id, r.verdict_at
+        FROM reviewers r
+        WHERE r.org_id = pr.org_id
+            AND r.pull_request_id = pr.pull_request_id
+            AND r.verdict_at IS NOT NULL
+        ORDER BY r.verdict_at
+        LIMIT 1
+    ) first_review ON true
+    JOIN users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id
+    LEFT JOIN teams t ON t.org_id = u.org_id AND t.id = u.team_id
+    WHERE pr.org_id = @org_id
+        AND (sqlc.narg(window_start)::timestamptz IS NULL OR pr.created_at >= sqlc.narg(window_start)::timestamptz)
+        AND (sqlc.narg(window_end)::timestamptz IS NULL OR pr.created_at < sqlc.narg(window_end)::timestamptz)
+)
+SELECT
+    'overall'::text AS scope,
+    ''::text AS key,
+    COUNT(*) AS samples,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds)::float8 AS p50,
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds)::float8 AS p90,
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY seconds)::float8 AS p99
+FROM samples
+HAVING COUNT(*) > 0
+UNION ALL
+SELECT
+    'team',
+    s.team_name,
+    COUNT(*),
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY s.seconds)
+FROM samples s
+WHERE s.team_name IS NOT NULL
+GROUP BY s.team_name
+UNION ALL
+SELECT
+    'reviewer',
+    s.user_id,
+    COUNT(*),
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY s.seconds)
+FROM samples s
+GROUP BY s.user_id;
+
+-- name: SLAAssignmentToVerdict :many
+WITH samples AS (
+    SELECT
+        t.team_name,
+        rev.user_id,
+        EXTRACT(EPOCH FROM rev.verdict_at - rev.assigned_at)::float8 AS seconds
+    FROM reviewers rev
+    JOIN pull_requests pr ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+    JOIN users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id
+    LEFT JOIN teams t ON t.org_id = u.org_id AND t.id = u.team_id
+    WHERE rev.org_id = @org_id
+        AND rev.verdict_at IS NOT NULL
+        AND (sqlc.narg(window_start)::timestamptz IS NULL OR pr.created_at >= sqlc.narg(window_start)::timestamptz)
+        AND (sqlc.narg(window_end)::timestamptz IS NULL OR pr.created_at < sqlc.narg(window_end)::timestamptz)
+)
+SELECT
+    'overall'::text AS scope,
+    ''::text AS key,
+    COUNT(*) AS samples,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds)::float8 AS p50,
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds)::float8 AS p90,
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY seconds)::float8 AS p99
+FROM samples
+HAVING COUNT(*) > 0
+UNION ALL
+SELECT
+    'team',
+    s.team_name,
+    COUNT(*),
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY s.seconds)
+FROM samples s
+WHERE s.team_name IS NOT NULL
+GROUP BY s.team_name
+UNION ALL
+SELECT
+    'reviewer',
+    s.user_id,
+    COUNT(*),
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY s.seconds),
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY s.seconds)
+FROM samples s
+GROUP BY s.user_id;
+
+-- name: ReviewerAssignmentCounts :many
+-- every user of the org, including those never assigned
+SELECT
+    u.user_id,
+    u.username,
+    COALESCE(t.team_name, '')::text AS team_name,
+    u.is_active,
+    COUNT(pr.pull_request_id) AS total,
+    COUNT(pr.pull_request_id) FILTER (WHERE pr.status = 'OPEN') AS open,
+    COUNT(pr.pull_request_id) FILTER (WHERE pr.status = 'MERGED') AS merged
+FROM users u
+LEFT JOIN teams t ON t.org_id = u.org_id AND t.id = u.team_id
+LEFT JOIN reviewers rev ON rev.org_id = u.org_id AND rev.user_id = u.user_id
+LEFT JOIN pull_requests pr ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+WHERE u.org_id = @org_id
+    AND (sqlc.narg(user_id)::text IS NULL OR u.user_id = sqlc.narg(user_id)::text)
+GROUP BY u.user_id, u.username, t.team_name, u.is_active
+ORDER BY u.user_id;
+
+-- name: PullRequestCountsByTeam :many
+-- one row per author team and status, team_name is empty for authors
+-- without a team
+SELECT
+    COALESCE(t.team_name, '')::text AS team_name,
+    pr.status,
+    COUNT(DISTINCT pr.pull_request_id) AS pull_requests,
+    COUNT(rev.user_id) AS reviewers
+FROM pull_requests pr
+LEFT JOIN users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id
+LEFT JOIN teams t ON t.org_id = u.org_id AND t.id = u.team_id
+LEFT JOIN reviewers rev ON rev.org_id = pr.org_id AND rev.pull_request_id = pr.pull_request_id
+WHERE pr.org_id = @org_id
+GROUP BY t.team_name, pr.status
+ORDER BY team_name, pr.status;
+
+-- name: TeamWorkload :many
+-- current team members with the time they were active and the reviews they
+-- were assigned inside the window, an open window end means now
+WITH bounds AS (
+    SELECT
+        COALESCE(sqlc.narg(window_start)::timestamptz, '-infinity'::timestamptz) AS lo,
+        COALESCE(sqlc.narg(window_end)::timestamptz, NOW()) AS hi
+)
+SELECT
+    u.user_id,
+    u.username,
+    u.is_active,
+    COALESCE((
+        SELECT SUM(EXTRACT(EPOCH FROM
+            LEAST(COALESCE(p.active_to, NOW()), b.hi) - GREATEST(p.active_from, b.lo)
+        ))
+        FROM user_activity_periods p
+        WHERE p.org_id = u.org_id
+            AND p.user_id = u.user_id
+            AND p.active_from < b.hi
+            AND COALESCE(p.active_to, NOW()) > b.lo
+    ), 0)::float8 AS active_seconds,
+    (
+        SELECT COUNT(*)
+        FROM reviewers rev
+        WHERE rev.org_id = u.org_id
+            AND rev.user_id = u.user_id
+            AND rev.assigned_at >= b.lo
+            AND rev.assigned_at < b.hi
+    ) AS assignments
+FROM users u
+CROSS JOIN bounds b
+WHERE u.org_id = @org_id AND u.team_id = @team_id
+ORDER BY u.user_id;
@@ -1,37 +1,38 @@
 -- name: CreateTeam :one
-INSERT INTO teams (team_name)
-VALUES ($1)
-RETURNING id, team_name;
+INSERT INTO teams (org_id, team_name)
+VALUES ($1, $2)
+RETURNING id, team_name, org_id;
 
 -- name: GetTeamByName :one
-SELECT id, team_name
+SELECT id, team_name, org_id
 FROM teams
-WHERE team_name = $1;
+WHERE org_id = $1 AND team_name = $2;
 
 -- name: GetTeamByID :one
-SELECT id, team_name
+SELECT id, team_name, org_id
 FROM teams
-WHERE id = $1;
+WHERE org_id = $1 AND id = $2;
 
 -- name: TeamExists :one
 SELECT EXISTS(
-    SELECT 1 FROM teams WHERE team_name = $1
+    SELECT 1 FROM teams WHERE org_id = $1 AND team_name = $2
 );
 
 -- name: GetTeamMemberCount :one
 SELECT COUNT(*) 
 FROM users 
-WHERE team_id = $1;
+WHERE org_id = $1 AND team_id = $2;
 
 -- name: DeleteTeam :exec
 DELETE FROM teams
-WHERE id = $1;
+WHERE org_id = $1 AND id = $2;
 
 -- name: GetTeams :many
-SELECT id, team_name
-FROM teams;
+SELECT id, team_name, org_id
+FROM teams
+WHERE org_id = $1;
 
 -- name: UpdateTeam :exec
 UPDATE teams
-SET team_name = $2
-WHERE id = $1;
+SET team_name = $3
+WHERE org_id = $1 AND id = $2;
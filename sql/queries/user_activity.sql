@@ -0,0 +1,9 @@
+-- name: OpenActivityPeriod :exec
+INSERT INTO user_activity_periods (org_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT (org_id, user_id) WHERE active_to IS NULL DO NOTHING;
+
+-- name: CloseActivityPeriod :exec
+UPDATE user_activity_periods
+SET active_to = NOW()
+WHERE org_id = $1 AND user_id = $2 AND active_to IS NULL;
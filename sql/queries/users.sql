@@ -1,53 +1,64 @@
 -- name: CreateUser :one
-INSERT INTO users (user_id, username, is_active, team_id)
-VALUES ($1, $2, $3, $4)
-RETURNING user_id, username, is_active, team_id;
+INSERT INTO users (org_id, user_id, username, is_active, team_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING user_id, username, is_active, team_id, org_id;
 
 -- name: GetUserByID :one
-SELECT user_id, username, is_active, team_id
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
 FROM users
-WHERE user_id = $1;
+WHERE org_id = $1 AND user_id = $2;
 
 -- name: GetUsers :many
-SELECT user_id, username, is_active, team_id
-FROM users;
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
+FROM users
+WHERE org_id = $1;
 
 -- name: UpdateUserStatus :one
 UPDATE users
-SET is_active = $2
-WHERE user_id = $1
+SET is_active = $3
+WHERE org_id = $1 AND user_id = $2
 RETURNING user_id, username, is_active;
 
 -- name: GetUsersByTeamID :many
-SELECT user_id, username, is_active, team_id
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
 FROM users
-WHERE team_id = $1;
+WHERE org_id = $1 AND team_id = $2;
 
 -- name: GetTeamByUserID :one
-SELECT t.id, t.team_name FROM teams t
-JOIN users u ON u.team_id = t.id
-WHERE u.user_id = $1;
+SELECT t.id, t.team_name, t.org_id FROM teams t
+JOIN users u ON u.org_id = t.org_id AND u.team_id = t.id
+WHERE u.org_id = $1 AND u.user_id = $2;
 
 -- name: GetActiveUsersByTeamID :many
-SELECT user_id, username, is_active, team_id
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
 FROM users
-WHERE team_id = $1 AND is_active = true;
+WHERE org_id = $1 AND team_id = $2 AND is_active = true;
 
 -- name: UserExists :one
 SELECT EXISTS (
-    SELECT 1 FROM users WHERE user_id = $1
+    SELECT 1 FROM users WHERE org_id = $1 AND user_id = $2
 );
 
 -- name: GetActiveUsers :many
-SELECT user_id, username, is_active, team_id
+SELECT user_id, username, is_active, team_id, org_id, max_open_reviews, out_of_office_until
 FROM users
-WHERE is_active = true;
+WHERE org_id = $1 AND is_active = true;
 
 -- name: DeleteUser :exec
 DELETE FROM users
-WHERE user_id = $1;
+WHERE org_id = $1 AND user_id = $2;
 
 -- name: UpdateUser :exec
 UPDATE users
-SET username = $2, is_active = $3, team_id = $4
-WHERE user_id = $1;
+SET username = $3, is_active = $4, team_id = $5
+WHERE org_id = $1 AND user_id = $2;
+
+-- name: SetUserMaxOpenReviews :execrows
+UPDATE users
+SET max_open_reviews = $3
+WHERE org_id = $1 AND user_id = $2;
+
+-- name: SetUserOutOfOffice :execrows
+UPDATE users
+SET out_of_office_until = $3
+WHERE org_id = $1 AND user_id = $2;